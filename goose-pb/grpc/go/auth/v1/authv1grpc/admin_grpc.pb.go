@@ -0,0 +1,662 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: auth/v1/admin.proto
+
+package authv1grpc
+
+import (
+	v1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_SearchUsers_FullMethodName          = "/auth.v1.AdminService/SearchUsers"
+	AdminService_GetUserDetails_FullMethodName       = "/auth.v1.AdminService/GetUserDetails"
+	AdminService_ChangeAccountState_FullMethodName   = "/auth.v1.AdminService/ChangeAccountState"
+	AdminService_ForceLogout_FullMethodName          = "/auth.v1.AdminService/ForceLogout"
+	AdminService_ResetTwoFa_FullMethodName           = "/auth.v1.AdminService/ResetTwoFa"
+	AdminService_TriggerPasswordReset_FullMethodName = "/auth.v1.AdminService/TriggerPasswordReset"
+	AdminService_SearchSecurityEvents_FullMethodName = "/auth.v1.AdminService/SearchSecurityEvents"
+	AdminService_GetAdminActions_FullMethodName      = "/auth.v1.AdminService/GetAdminActions"
+	AdminService_GetRoles_FullMethodName             = "/auth.v1.AdminService/GetRoles"
+	AdminService_GetUserRoles_FullMethodName         = "/auth.v1.AdminService/GetUserRoles"
+	AdminService_SaveRole_FullMethodName             = "/auth.v1.AdminService/SaveRole"
+	AdminService_DeleteRole_FullMethodName           = "/auth.v1.AdminService/DeleteRole"
+	AdminService_AssignRole_FullMethodName           = "/auth.v1.AdminService/AssignRole"
+	AdminService_RevokeRole_FullMethodName           = "/auth.v1.AdminService/RevokeRole"
+	AdminService_CreateInvite_FullMethodName         = "/auth.v1.AdminService/CreateInvite"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	SearchUsers(ctx context.Context, in *v1.SearchUsersRequest, opts ...grpc.CallOption) (*v1.SearchUsersResponse, error)
+	GetUserDetails(ctx context.Context, in *v1.GetUserDetailsRequest, opts ...grpc.CallOption) (*v1.GetUserDetailsResponse, error)
+	ChangeAccountState(ctx context.Context, in *v1.ChangeAccountStateRequest, opts ...grpc.CallOption) (*v1.ChangeAccountStateResponse, error)
+	// revokes all sessions, access tokens and trusted devices of the user
+	ForceLogout(ctx context.Context, in *v1.ForceLogoutRequest, opts ...grpc.CallOption) (*v1.ForceLogoutResponse, error)
+	ResetTwoFa(ctx context.Context, in *v1.ResetTwoFaRequest, opts ...grpc.CallOption) (*v1.ResetTwoFaResponse, error)
+	// requires password reset on next sign in and emails reset code to the user
+	TriggerPasswordReset(ctx context.Context, in *v1.TriggerPasswordResetRequest, opts ...grpc.CallOption) (*v1.TriggerPasswordResetResponse, error)
+	// filters security events of all accounts by user, ip address or event type
+	SearchSecurityEvents(ctx context.Context, in *v1.SearchSecurityEventsRequest, opts ...grpc.CallOption) (*v1.SearchSecurityEventsResponse, error)
+	GetAdminActions(ctx context.Context, in *v1.GetAdminActionsRequest, opts ...grpc.CallOption) (*v1.GetAdminActionsResponse, error)
+	GetRoles(ctx context.Context, in *v1.GetRolesRequest, opts ...grpc.CallOption) (*v1.GetRolesResponse, error)
+	GetUserRoles(ctx context.Context, in *v1.GetUserRolesRequest, opts ...grpc.CallOption) (*v1.GetUserRolesResponse, error)
+	// creates role or replaces description and permissions of existing one
+	SaveRole(ctx context.Context, in *v1.SaveRoleRequest, opts ...grpc.CallOption) (*v1.SaveRoleResponse, error)
+	DeleteRole(ctx context.Context, in *v1.DeleteRoleRequest, opts ...grpc.CallOption) (*v1.DeleteRoleResponse, error)
+	AssignRole(ctx context.Context, in *v1.AssignRoleRequest, opts ...grpc.CallOption) (*v1.AssignRoleResponse, error)
+	RevokeRole(ctx context.Context, in *v1.RevokeRoleRequest, opts ...grpc.CallOption) (*v1.RevokeRoleResponse, error)
+	// creates invite which is not bound by limits configured for users
+	CreateInvite(ctx context.Context, in *v1.AdminCreateInviteRequest, opts ...grpc.CallOption) (*v1.AdminCreateInviteResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SearchUsers(ctx context.Context, in *v1.SearchUsersRequest, opts ...grpc.CallOption) (*v1.SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SearchUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUserDetails(ctx context.Context, in *v1.GetUserDetailsRequest, opts ...grpc.CallOption) (*v1.GetUserDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetUserDetailsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ChangeAccountState(ctx context.Context, in *v1.ChangeAccountStateRequest, opts ...grpc.CallOption) (*v1.ChangeAccountStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ChangeAccountStateResponse)
+	err := c.cc.Invoke(ctx, AdminService_ChangeAccountState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceLogout(ctx context.Context, in *v1.ForceLogoutRequest, opts ...grpc.CallOption) (*v1.ForceLogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ForceLogoutResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForceLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetTwoFa(ctx context.Context, in *v1.ResetTwoFaRequest, opts ...grpc.CallOption) (*v1.ResetTwoFaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ResetTwoFaResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResetTwoFa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TriggerPasswordReset(ctx context.Context, in *v1.TriggerPasswordResetRequest, opts ...grpc.CallOption) (*v1.TriggerPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.TriggerPasswordResetResponse)
+	err := c.cc.Invoke(ctx, AdminService_TriggerPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SearchSecurityEvents(ctx context.Context, in *v1.SearchSecurityEventsRequest, opts ...grpc.CallOption) (*v1.SearchSecurityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SearchSecurityEventsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SearchSecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAdminActions(ctx context.Context, in *v1.GetAdminActionsRequest, opts ...grpc.CallOption) (*v1.GetAdminActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetAdminActionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAdminActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetRoles(ctx context.Context, in *v1.GetRolesRequest, opts ...grpc.CallOption) (*v1.GetRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetRolesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUserRoles(ctx context.Context, in *v1.GetUserRolesRequest, opts ...grpc.CallOption) (*v1.GetUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetUserRolesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SaveRole(ctx context.Context, in *v1.SaveRoleRequest, opts ...grpc.CallOption) (*v1.SaveRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SaveRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SaveRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteRole(ctx context.Context, in *v1.DeleteRoleRequest, opts ...grpc.CallOption) (*v1.DeleteRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AssignRole(ctx context.Context, in *v1.AssignRoleRequest, opts ...grpc.CallOption) (*v1.AssignRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.AssignRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_AssignRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeRole(ctx context.Context, in *v1.RevokeRoleRequest, opts ...grpc.CallOption) (*v1.RevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateInvite(ctx context.Context, in *v1.AdminCreateInviteRequest, opts ...grpc.CallOption) (*v1.AdminCreateInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.AdminCreateInviteResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations should embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	SearchUsers(context.Context, *v1.SearchUsersRequest) (*v1.SearchUsersResponse, error)
+	GetUserDetails(context.Context, *v1.GetUserDetailsRequest) (*v1.GetUserDetailsResponse, error)
+	ChangeAccountState(context.Context, *v1.ChangeAccountStateRequest) (*v1.ChangeAccountStateResponse, error)
+	// revokes all sessions, access tokens and trusted devices of the user
+	ForceLogout(context.Context, *v1.ForceLogoutRequest) (*v1.ForceLogoutResponse, error)
+	ResetTwoFa(context.Context, *v1.ResetTwoFaRequest) (*v1.ResetTwoFaResponse, error)
+	// requires password reset on next sign in and emails reset code to the user
+	TriggerPasswordReset(context.Context, *v1.TriggerPasswordResetRequest) (*v1.TriggerPasswordResetResponse, error)
+	// filters security events of all accounts by user, ip address or event type
+	SearchSecurityEvents(context.Context, *v1.SearchSecurityEventsRequest) (*v1.SearchSecurityEventsResponse, error)
+	GetAdminActions(context.Context, *v1.GetAdminActionsRequest) (*v1.GetAdminActionsResponse, error)
+	GetRoles(context.Context, *v1.GetRolesRequest) (*v1.GetRolesResponse, error)
+	GetUserRoles(context.Context, *v1.GetUserRolesRequest) (*v1.GetUserRolesResponse, error)
+	// creates role or replaces description and permissions of existing one
+	SaveRole(context.Context, *v1.SaveRoleRequest) (*v1.SaveRoleResponse, error)
+	DeleteRole(context.Context, *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error)
+	AssignRole(context.Context, *v1.AssignRoleRequest) (*v1.AssignRoleResponse, error)
+	RevokeRole(context.Context, *v1.RevokeRoleRequest) (*v1.RevokeRoleResponse, error)
+	// creates invite which is not bound by limits configured for users
+	CreateInvite(context.Context, *v1.AdminCreateInviteRequest) (*v1.AdminCreateInviteResponse, error)
+}
+
+// UnimplementedAdminServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SearchUsers(context.Context, *v1.SearchUsersRequest) (*v1.SearchUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUserDetails(context.Context, *v1.GetUserDetailsRequest) (*v1.GetUserDetailsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserDetails not implemented")
+}
+func (UnimplementedAdminServiceServer) ChangeAccountState(context.Context, *v1.ChangeAccountStateRequest) (*v1.ChangeAccountStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeAccountState not implemented")
+}
+func (UnimplementedAdminServiceServer) ForceLogout(context.Context, *v1.ForceLogoutRequest) (*v1.ForceLogoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceLogout not implemented")
+}
+func (UnimplementedAdminServiceServer) ResetTwoFa(context.Context, *v1.ResetTwoFaRequest) (*v1.ResetTwoFaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetTwoFa not implemented")
+}
+func (UnimplementedAdminServiceServer) TriggerPasswordReset(context.Context, *v1.TriggerPasswordResetRequest) (*v1.TriggerPasswordResetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerPasswordReset not implemented")
+}
+func (UnimplementedAdminServiceServer) SearchSecurityEvents(context.Context, *v1.SearchSecurityEventsRequest) (*v1.SearchSecurityEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchSecurityEvents not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAdminActions(context.Context, *v1.GetAdminActionsRequest) (*v1.GetAdminActionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAdminActions not implemented")
+}
+func (UnimplementedAdminServiceServer) GetRoles(context.Context, *v1.GetRolesRequest) (*v1.GetRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoles not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUserRoles(context.Context, *v1.GetUserRolesRequest) (*v1.GetUserRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserRoles not implemented")
+}
+func (UnimplementedAdminServiceServer) SaveRole(context.Context, *v1.SaveRoleRequest) (*v1.SaveRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveRole not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteRole(context.Context, *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (UnimplementedAdminServiceServer) AssignRole(context.Context, *v1.AssignRoleRequest) (*v1.AssignRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeRole(context.Context, *v1.RevokeRoleRequest) (*v1.RevokeRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateInvite(context.Context, *v1.AdminCreateInviteRequest) (*v1.AdminCreateInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchUsers(ctx, req.(*v1.SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUserDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetUserDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserDetails(ctx, req.(*v1.GetUserDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ChangeAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ChangeAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangeAccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ChangeAccountState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangeAccountState(ctx, req.(*v1.ChangeAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ForceLogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForceLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceLogout(ctx, req.(*v1.ForceLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetTwoFa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ResetTwoFaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetTwoFa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResetTwoFa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetTwoFa(ctx, req.(*v1.ResetTwoFaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TriggerPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.TriggerPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TriggerPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TriggerPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TriggerPasswordReset(ctx, req.(*v1.TriggerPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SearchSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SearchSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SearchSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchSecurityEvents(ctx, req.(*v1.SearchSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAdminActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetAdminActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAdminActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAdminActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAdminActions(ctx, req.(*v1.GetAdminActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetRoles(ctx, req.(*v1.GetRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserRoles(ctx, req.(*v1.GetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SaveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SaveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SaveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SaveRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SaveRole(ctx, req.(*v1.SaveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteRole(ctx, req.(*v1.DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AssignRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AssignRole(ctx, req.(*v1.AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeRole(ctx, req.(*v1.RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.AdminCreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateInvite(ctx, req.(*v1.AdminCreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchUsers",
+			Handler:    _AdminService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetUserDetails",
+			Handler:    _AdminService_GetUserDetails_Handler,
+		},
+		{
+			MethodName: "ChangeAccountState",
+			Handler:    _AdminService_ChangeAccountState_Handler,
+		},
+		{
+			MethodName: "ForceLogout",
+			Handler:    _AdminService_ForceLogout_Handler,
+		},
+		{
+			MethodName: "ResetTwoFa",
+			Handler:    _AdminService_ResetTwoFa_Handler,
+		},
+		{
+			MethodName: "TriggerPasswordReset",
+			Handler:    _AdminService_TriggerPasswordReset_Handler,
+		},
+		{
+			MethodName: "SearchSecurityEvents",
+			Handler:    _AdminService_SearchSecurityEvents_Handler,
+		},
+		{
+			MethodName: "GetAdminActions",
+			Handler:    _AdminService_GetAdminActions_Handler,
+		},
+		{
+			MethodName: "GetRoles",
+			Handler:    _AdminService_GetRoles_Handler,
+		},
+		{
+			MethodName: "GetUserRoles",
+			Handler:    _AdminService_GetUserRoles_Handler,
+		},
+		{
+			MethodName: "SaveRole",
+			Handler:    _AdminService_SaveRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _AdminService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _AdminService_AssignRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _AdminService_RevokeRole_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _AdminService_CreateInvite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/admin.proto",
+}
@@ -0,0 +1,1409 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: auth/v1/auth.proto
+
+package authv1grpc
+
+import (
+	v1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuthService_SignUp_FullMethodName                      = "/auth.v1.AuthService/SignUp"
+	AuthService_SignIn_FullMethodName                      = "/auth.v1.AuthService/SignIn"
+	AuthService_PingSession_FullMethodName                 = "/auth.v1.AuthService/PingSession"
+	AuthService_GetActiveSessions_FullMethodName           = "/auth.v1.AuthService/GetActiveSessions"
+	AuthService_DeleteSession_FullMethodName               = "/auth.v1.AuthService/DeleteSession"
+	AuthService_CreateAccessToken_FullMethodName           = "/auth.v1.AuthService/CreateAccessToken"
+	AuthService_GetAccessTokens_FullMethodName             = "/auth.v1.AuthService/GetAccessTokens"
+	AuthService_RevokeAccessToken_FullMethodName           = "/auth.v1.AuthService/RevokeAccessToken"
+	AuthService_GetSecurityEvents_FullMethodName           = "/auth.v1.AuthService/GetSecurityEvents"
+	AuthService_DeleteAllSessions_FullMethodName           = "/auth.v1.AuthService/DeleteAllSessions"
+	AuthService_DeactivateAccount_FullMethodName           = "/auth.v1.AuthService/DeactivateAccount"
+	AuthService_DisableTwoFa_FullMethodName                = "/auth.v1.AuthService/DisableTwoFa"
+	AuthService_RequestReauthenticationCode_FullMethodName = "/auth.v1.AuthService/RequestReauthenticationCode"
+	AuthService_Reauthenticate_FullMethodName              = "/auth.v1.AuthService/Reauthenticate"
+	AuthService_AnswerLoginConfirmation_FullMethodName     = "/auth.v1.AuthService/AnswerLoginConfirmation"
+	AuthService_RequestPasswordReset_FullMethodName        = "/auth.v1.AuthService/RequestPasswordReset"
+	AuthService_ResetPassword_FullMethodName               = "/auth.v1.AuthService/ResetPassword"
+	AuthService_RequestDataExport_FullMethodName           = "/auth.v1.AuthService/RequestDataExport"
+	AuthService_DownloadDataExport_FullMethodName          = "/auth.v1.AuthService/DownloadDataExport"
+	AuthService_RequestAccountReactivation_FullMethodName  = "/auth.v1.AuthService/RequestAccountReactivation"
+	AuthService_ReactivateAccount_FullMethodName           = "/auth.v1.AuthService/ReactivateAccount"
+	AuthService_RequestMagicLink_FullMethodName            = "/auth.v1.AuthService/RequestMagicLink"
+	AuthService_SignInWithMagicLink_FullMethodName         = "/auth.v1.AuthService/SignInWithMagicLink"
+	AuthService_WatchQRLogin_FullMethodName                = "/auth.v1.AuthService/WatchQRLogin"
+	AuthService_VerifyTwoFa_FullMethodName                 = "/auth.v1.AuthService/VerifyTwoFa"
+	AuthService_GetTrustedDevices_FullMethodName           = "/auth.v1.AuthService/GetTrustedDevices"
+	AuthService_RevokeTrustedDevice_FullMethodName         = "/auth.v1.AuthService/RevokeTrustedDevice"
+	AuthService_RevokeAllTrustedDevices_FullMethodName     = "/auth.v1.AuthService/RevokeAllTrustedDevices"
+	AuthService_RequestAccountDeletion_FullMethodName      = "/auth.v1.AuthService/RequestAccountDeletion"
+	AuthService_CancelAccountDeletion_FullMethodName       = "/auth.v1.AuthService/CancelAccountDeletion"
+	AuthService_CreateInvite_FullMethodName                = "/auth.v1.AuthService/CreateInvite"
+	AuthService_GetInvites_FullMethodName                  = "/auth.v1.AuthService/GetInvites"
+	AuthService_RevokeInvite_FullMethodName                = "/auth.v1.AuthService/RevokeInvite"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Session-scoped RPCs are called within session passed in metadata:
+// x-user-id and x-session-id identify the session, and sessions bound to a client key
+// also require x-session-proof-nonce, x-session-proof-timestamp (unix seconds) and
+// x-session-proof-signature signing "<session id>\n<full rpc method>\n<timestamp>\n<nonce>".
+// Some of them also accept personal access token with required scope passed as "authorization: Bearer <token>"
+type AuthServiceClient interface {
+	SignUp(ctx context.Context, in *v1.SignUpRequest, opts ...grpc.CallOption) (*v1.SignUpResponse, error)
+	SignIn(ctx context.Context, in *v1.SignInRequest, opts ...grpc.CallOption) (*v1.SignInResponse, error)
+	// extends caller's session and updates its last seen time
+	PingSession(ctx context.Context, in *v1.PingSessionRequest, opts ...grpc.CallOption) (*v1.PingSessionResponse, error)
+	GetActiveSessions(ctx context.Context, in *v1.GetActiveSessionsRequest, opts ...grpc.CallOption) (*v1.GetActiveSessionsResponse, error)
+	DeleteSession(ctx context.Context, in *v1.DeleteSessionRequest, opts ...grpc.CallOption) (*v1.DeleteSessionResponse, error)
+	// issues personal access token for bots and integrations, requires recent authentication
+	CreateAccessToken(ctx context.Context, in *v1.CreateAccessTokenRequest, opts ...grpc.CallOption) (*v1.CreateAccessTokenResponse, error)
+	GetAccessTokens(ctx context.Context, in *v1.GetAccessTokensRequest, opts ...grpc.CallOption) (*v1.GetAccessTokensResponse, error)
+	RevokeAccessToken(ctx context.Context, in *v1.RevokeAccessTokenRequest, opts ...grpc.CallOption) (*v1.RevokeAccessTokenResponse, error)
+	// returns page of caller's login history and security events
+	GetSecurityEvents(ctx context.Context, in *v1.GetSecurityEventsRequest, opts ...grpc.CallOption) (*v1.GetSecurityEventsResponse, error)
+	// signs out from all sessions except the caller's one, requires recent authentication
+	DeleteAllSessions(ctx context.Context, in *v1.DeleteAllSessionsRequest, opts ...grpc.CallOption) (*v1.DeleteAllSessionsResponse, error)
+	// requires recent authentication
+	DeactivateAccount(ctx context.Context, in *v1.DeactivateAccountRequest, opts ...grpc.CallOption) (*v1.DeactivateAccountResponse, error)
+	// requires recent authentication
+	DisableTwoFa(ctx context.Context, in *v1.DisableTwoFaRequest, opts ...grpc.CallOption) (*v1.DisableTwoFaResponse, error)
+	// sends 2FA code confirming user's identity through user's 2FA channel
+	RequestReauthenticationCode(ctx context.Context, in *v1.RequestReauthenticationCodeRequest, opts ...grpc.CallOption) (*v1.RequestReauthenticationCodeResponse, error)
+	// marks caller's session as recently authenticated, which is required by sensitive RPCs.
+	// They fail with FAILED_PRECONDITION and REAUTHENTICATION_REQUIRED reason otherwise
+	Reauthenticate(ctx context.Context, in *v1.ReauthenticateRequest, opts ...grpc.CallOption) (*v1.ReauthenticateResponse, error)
+	// answers "Was this you?" question sent when account is signed in from new device
+	AnswerLoginConfirmation(ctx context.Context, in *v1.AnswerLoginConfirmationRequest, opts ...grpc.CallOption) (*v1.AnswerLoginConfirmationResponse, error)
+	// sends password reset code to user's email. It succeeds for unknown emails as well
+	RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*v1.RequestPasswordResetResponse, error)
+	// sets new password and signs out from all sessions
+	ResetPassword(ctx context.Context, in *v1.ResetPasswordRequest, opts ...grpc.CallOption) (*v1.ResetPasswordResponse, error)
+	// starts collecting user's personal data, download link is emailed once archive is ready.
+	// Requires recent authentication
+	RequestDataExport(ctx context.Context, in *v1.RequestDataExportRequest, opts ...grpc.CallOption) (*v1.RequestDataExportResponse, error)
+	// streams data export archive in chunks
+	DownloadDataExport(ctx context.Context, in *v1.DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.DownloadDataExportResponse], error)
+	// sends code restoring deactivated or locked account to user's email. It succeeds for unknown emails as well
+	RequestAccountReactivation(ctx context.Context, in *v1.RequestAccountReactivationRequest, opts ...grpc.CallOption) (*v1.RequestAccountReactivationResponse, error)
+	// restores deactivated or locked account, user signs in afterwards
+	ReactivateAccount(ctx context.Context, in *v1.ReactivateAccountRequest, opts ...grpc.CallOption) (*v1.ReactivateAccountResponse, error)
+	// emails passwordless sign in link and code, succeeds for unknown emails as well
+	RequestMagicLink(ctx context.Context, in *v1.RequestMagicLinkRequest, opts ...grpc.CallOption) (*v1.RequestMagicLinkResponse, error)
+	SignInWithMagicLink(ctx context.Context, in *v1.SignInWithMagicLinkRequest, opts ...grpc.CallOption) (*v1.SignInWithMagicLinkResponse, error)
+	// streams fresh QR login tokens until one of them is accepted by another device, then sends created session
+	WatchQRLogin(ctx context.Context, in *v1.WatchQRLoginRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.WatchQRLoginResponse], error)
+	// completes sign in of account with 2FA enabled, optionally trusting the device
+	VerifyTwoFa(ctx context.Context, in *v1.VerifyTwoFaRequest, opts ...grpc.CallOption) (*v1.VerifyTwoFaResponse, error)
+	GetTrustedDevices(ctx context.Context, in *v1.GetTrustedDevicesRequest, opts ...grpc.CallOption) (*v1.GetTrustedDevicesResponse, error)
+	// makes device go through 2FA on next sign in
+	RevokeTrustedDevice(ctx context.Context, in *v1.RevokeTrustedDeviceRequest, opts ...grpc.CallOption) (*v1.RevokeTrustedDeviceResponse, error)
+	RevokeAllTrustedDevices(ctx context.Context, in *v1.RevokeAllTrustedDevicesRequest, opts ...grpc.CallOption) (*v1.RevokeAllTrustedDevicesResponse, error)
+	// requires recent authentication. Schedules erasure of account after grace period
+	// and signs out all other sessions
+	RequestAccountDeletion(ctx context.Context, in *v1.RequestAccountDeletionRequest, opts ...grpc.CallOption) (*v1.RequestAccountDeletionResponse, error)
+	// keeps account if grace period is not over yet
+	CancelAccountDeletion(ctx context.Context, in *v1.CancelAccountDeletionRequest, opts ...grpc.CallOption) (*v1.CancelAccountDeletionResponse, error)
+	// invite must stay within limits configured for users, admins exceed them with AdminService.CreateInvite
+	CreateInvite(ctx context.Context, in *v1.CreateInviteRequest, opts ...grpc.CallOption) (*v1.CreateInviteResponse, error)
+	// lists invites created by caller including used up, expired and revoked ones
+	GetInvites(ctx context.Context, in *v1.GetInvitesRequest, opts ...grpc.CallOption) (*v1.GetInvitesResponse, error)
+	// revokes invite created by caller, users who already signed up with it are not affected
+	RevokeInvite(ctx context.Context, in *v1.RevokeInviteRequest, opts ...grpc.CallOption) (*v1.RevokeInviteResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) SignUp(ctx context.Context, in *v1.SignUpRequest, opts ...grpc.CallOption) (*v1.SignUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SignUpResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignIn(ctx context.Context, in *v1.SignInRequest, opts ...grpc.CallOption) (*v1.SignInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SignInResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) PingSession(ctx context.Context, in *v1.PingSessionRequest, opts ...grpc.CallOption) (*v1.PingSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.PingSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_PingSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetActiveSessions(ctx context.Context, in *v1.GetActiveSessionsRequest, opts ...grpc.CallOption) (*v1.GetActiveSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetActiveSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetActiveSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteSession(ctx context.Context, in *v1.DeleteSessionRequest, opts ...grpc.CallOption) (*v1.DeleteSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateAccessToken(ctx context.Context, in *v1.CreateAccessTokenRequest, opts ...grpc.CallOption) (*v1.CreateAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetAccessTokens(ctx context.Context, in *v1.GetAccessTokensRequest, opts ...grpc.CallOption) (*v1.GetAccessTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetAccessTokensResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAccessToken(ctx context.Context, in *v1.RevokeAccessTokenRequest, opts ...grpc.CallOption) (*v1.RevokeAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeAccessTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetSecurityEvents(ctx context.Context, in *v1.GetSecurityEventsRequest, opts ...grpc.CallOption) (*v1.GetSecurityEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetSecurityEventsResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetSecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteAllSessions(ctx context.Context, in *v1.DeleteAllSessionsRequest, opts ...grpc.CallOption) (*v1.DeleteAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DeleteAllSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeleteAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeactivateAccount(ctx context.Context, in *v1.DeactivateAccountRequest, opts ...grpc.CallOption) (*v1.DeactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DeactivateAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_DeactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DisableTwoFa(ctx context.Context, in *v1.DisableTwoFaRequest, opts ...grpc.CallOption) (*v1.DisableTwoFaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.DisableTwoFaResponse)
+	err := c.cc.Invoke(ctx, AuthService_DisableTwoFa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestReauthenticationCode(ctx context.Context, in *v1.RequestReauthenticationCodeRequest, opts ...grpc.CallOption) (*v1.RequestReauthenticationCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestReauthenticationCodeResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestReauthenticationCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Reauthenticate(ctx context.Context, in *v1.ReauthenticateRequest, opts ...grpc.CallOption) (*v1.ReauthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ReauthenticateResponse)
+	err := c.cc.Invoke(ctx, AuthService_Reauthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AnswerLoginConfirmation(ctx context.Context, in *v1.AnswerLoginConfirmationRequest, opts ...grpc.CallOption) (*v1.AnswerLoginConfirmationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.AnswerLoginConfirmationResponse)
+	err := c.cc.Invoke(ctx, AuthService_AnswerLoginConfirmation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...grpc.CallOption) (*v1.RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetPassword(ctx context.Context, in *v1.ResetPasswordRequest, opts ...grpc.CallOption) (*v1.ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestDataExport(ctx context.Context, in *v1.RequestDataExportRequest, opts ...grpc.CallOption) (*v1.RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DownloadDataExport(ctx context.Context, in *v1.DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.DownloadDataExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[0], AuthService_DownloadDataExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1.DownloadDataExportRequest, v1.DownloadDataExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_DownloadDataExportClient = grpc.ServerStreamingClient[v1.DownloadDataExportResponse]
+
+func (c *authServiceClient) RequestAccountReactivation(ctx context.Context, in *v1.RequestAccountReactivationRequest, opts ...grpc.CallOption) (*v1.RequestAccountReactivationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestAccountReactivationResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestAccountReactivation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ReactivateAccount(ctx context.Context, in *v1.ReactivateAccountRequest, opts ...grpc.CallOption) (*v1.ReactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ReactivateAccountResponse)
+	err := c.cc.Invoke(ctx, AuthService_ReactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestMagicLink(ctx context.Context, in *v1.RequestMagicLinkRequest, opts ...grpc.CallOption) (*v1.RequestMagicLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestMagicLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignInWithMagicLink(ctx context.Context, in *v1.SignInWithMagicLinkRequest, opts ...grpc.CallOption) (*v1.SignInWithMagicLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.SignInWithMagicLinkResponse)
+	err := c.cc.Invoke(ctx, AuthService_SignInWithMagicLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) WatchQRLogin(ctx context.Context, in *v1.WatchQRLoginRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.WatchQRLoginResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuthService_ServiceDesc.Streams[1], AuthService_WatchQRLogin_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1.WatchQRLoginRequest, v1.WatchQRLoginResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_WatchQRLoginClient = grpc.ServerStreamingClient[v1.WatchQRLoginResponse]
+
+func (c *authServiceClient) VerifyTwoFa(ctx context.Context, in *v1.VerifyTwoFaRequest, opts ...grpc.CallOption) (*v1.VerifyTwoFaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.VerifyTwoFaResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyTwoFa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetTrustedDevices(ctx context.Context, in *v1.GetTrustedDevicesRequest, opts ...grpc.CallOption) (*v1.GetTrustedDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetTrustedDevicesResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetTrustedDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeTrustedDevice(ctx context.Context, in *v1.RevokeTrustedDeviceRequest, opts ...grpc.CallOption) (*v1.RevokeTrustedDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeTrustedDeviceResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeTrustedDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAllTrustedDevices(ctx context.Context, in *v1.RevokeAllTrustedDevicesRequest, opts ...grpc.CallOption) (*v1.RevokeAllTrustedDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeAllTrustedDevicesResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAllTrustedDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestAccountDeletion(ctx context.Context, in *v1.RequestAccountDeletionRequest, opts ...grpc.CallOption) (*v1.RequestAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RequestAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RequestAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CancelAccountDeletion(ctx context.Context, in *v1.CancelAccountDeletionRequest, opts ...grpc.CallOption) (*v1.CancelAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.CancelAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, AuthService_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateInvite(ctx context.Context, in *v1.CreateInviteRequest, opts ...grpc.CallOption) (*v1.CreateInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.CreateInviteResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetInvites(ctx context.Context, in *v1.GetInvitesRequest, opts ...grpc.CallOption) (*v1.GetInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetInvitesResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeInvite(ctx context.Context, in *v1.RevokeInviteRequest, opts ...grpc.CallOption) (*v1.RevokeInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations should embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// Session-scoped RPCs are called within session passed in metadata:
+// x-user-id and x-session-id identify the session, and sessions bound to a client key
+// also require x-session-proof-nonce, x-session-proof-timestamp (unix seconds) and
+// x-session-proof-signature signing "<session id>\n<full rpc method>\n<timestamp>\n<nonce>".
+// Some of them also accept personal access token with required scope passed as "authorization: Bearer <token>"
+type AuthServiceServer interface {
+	SignUp(context.Context, *v1.SignUpRequest) (*v1.SignUpResponse, error)
+	SignIn(context.Context, *v1.SignInRequest) (*v1.SignInResponse, error)
+	// extends caller's session and updates its last seen time
+	PingSession(context.Context, *v1.PingSessionRequest) (*v1.PingSessionResponse, error)
+	GetActiveSessions(context.Context, *v1.GetActiveSessionsRequest) (*v1.GetActiveSessionsResponse, error)
+	DeleteSession(context.Context, *v1.DeleteSessionRequest) (*v1.DeleteSessionResponse, error)
+	// issues personal access token for bots and integrations, requires recent authentication
+	CreateAccessToken(context.Context, *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error)
+	GetAccessTokens(context.Context, *v1.GetAccessTokensRequest) (*v1.GetAccessTokensResponse, error)
+	RevokeAccessToken(context.Context, *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error)
+	// returns page of caller's login history and security events
+	GetSecurityEvents(context.Context, *v1.GetSecurityEventsRequest) (*v1.GetSecurityEventsResponse, error)
+	// signs out from all sessions except the caller's one, requires recent authentication
+	DeleteAllSessions(context.Context, *v1.DeleteAllSessionsRequest) (*v1.DeleteAllSessionsResponse, error)
+	// requires recent authentication
+	DeactivateAccount(context.Context, *v1.DeactivateAccountRequest) (*v1.DeactivateAccountResponse, error)
+	// requires recent authentication
+	DisableTwoFa(context.Context, *v1.DisableTwoFaRequest) (*v1.DisableTwoFaResponse, error)
+	// sends 2FA code confirming user's identity through user's 2FA channel
+	RequestReauthenticationCode(context.Context, *v1.RequestReauthenticationCodeRequest) (*v1.RequestReauthenticationCodeResponse, error)
+	// marks caller's session as recently authenticated, which is required by sensitive RPCs.
+	// They fail with FAILED_PRECONDITION and REAUTHENTICATION_REQUIRED reason otherwise
+	Reauthenticate(context.Context, *v1.ReauthenticateRequest) (*v1.ReauthenticateResponse, error)
+	// answers "Was this you?" question sent when account is signed in from new device
+	AnswerLoginConfirmation(context.Context, *v1.AnswerLoginConfirmationRequest) (*v1.AnswerLoginConfirmationResponse, error)
+	// sends password reset code to user's email. It succeeds for unknown emails as well
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetResponse, error)
+	// sets new password and signs out from all sessions
+	ResetPassword(context.Context, *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error)
+	// starts collecting user's personal data, download link is emailed once archive is ready.
+	// Requires recent authentication
+	RequestDataExport(context.Context, *v1.RequestDataExportRequest) (*v1.RequestDataExportResponse, error)
+	// streams data export archive in chunks
+	DownloadDataExport(*v1.DownloadDataExportRequest, grpc.ServerStreamingServer[v1.DownloadDataExportResponse]) error
+	// sends code restoring deactivated or locked account to user's email. It succeeds for unknown emails as well
+	RequestAccountReactivation(context.Context, *v1.RequestAccountReactivationRequest) (*v1.RequestAccountReactivationResponse, error)
+	// restores deactivated or locked account, user signs in afterwards
+	ReactivateAccount(context.Context, *v1.ReactivateAccountRequest) (*v1.ReactivateAccountResponse, error)
+	// emails passwordless sign in link and code, succeeds for unknown emails as well
+	RequestMagicLink(context.Context, *v1.RequestMagicLinkRequest) (*v1.RequestMagicLinkResponse, error)
+	SignInWithMagicLink(context.Context, *v1.SignInWithMagicLinkRequest) (*v1.SignInWithMagicLinkResponse, error)
+	// streams fresh QR login tokens until one of them is accepted by another device, then sends created session
+	WatchQRLogin(*v1.WatchQRLoginRequest, grpc.ServerStreamingServer[v1.WatchQRLoginResponse]) error
+	// completes sign in of account with 2FA enabled, optionally trusting the device
+	VerifyTwoFa(context.Context, *v1.VerifyTwoFaRequest) (*v1.VerifyTwoFaResponse, error)
+	GetTrustedDevices(context.Context, *v1.GetTrustedDevicesRequest) (*v1.GetTrustedDevicesResponse, error)
+	// makes device go through 2FA on next sign in
+	RevokeTrustedDevice(context.Context, *v1.RevokeTrustedDeviceRequest) (*v1.RevokeTrustedDeviceResponse, error)
+	RevokeAllTrustedDevices(context.Context, *v1.RevokeAllTrustedDevicesRequest) (*v1.RevokeAllTrustedDevicesResponse, error)
+	// requires recent authentication. Schedules erasure of account after grace period
+	// and signs out all other sessions
+	RequestAccountDeletion(context.Context, *v1.RequestAccountDeletionRequest) (*v1.RequestAccountDeletionResponse, error)
+	// keeps account if grace period is not over yet
+	CancelAccountDeletion(context.Context, *v1.CancelAccountDeletionRequest) (*v1.CancelAccountDeletionResponse, error)
+	// invite must stay within limits configured for users, admins exceed them with AdminService.CreateInvite
+	CreateInvite(context.Context, *v1.CreateInviteRequest) (*v1.CreateInviteResponse, error)
+	// lists invites created by caller including used up, expired and revoked ones
+	GetInvites(context.Context, *v1.GetInvitesRequest) (*v1.GetInvitesResponse, error)
+	// revokes invite created by caller, users who already signed up with it are not affected
+	RevokeInvite(context.Context, *v1.RevokeInviteRequest) (*v1.RevokeInviteResponse, error)
+}
+
+// UnimplementedAuthServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) SignUp(context.Context, *v1.SignUpRequest) (*v1.SignUpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignUp not implemented")
+}
+func (UnimplementedAuthServiceServer) SignIn(context.Context, *v1.SignInRequest) (*v1.SignInResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignIn not implemented")
+}
+func (UnimplementedAuthServiceServer) PingSession(context.Context, *v1.PingSessionRequest) (*v1.PingSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PingSession not implemented")
+}
+func (UnimplementedAuthServiceServer) GetActiveSessions(context.Context, *v1.GetActiveSessionsRequest) (*v1.GetActiveSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteSession(context.Context, *v1.DeleteSessionRequest) (*v1.DeleteSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateAccessToken(context.Context, *v1.CreateAccessTokenRequest) (*v1.CreateAccessTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedAuthServiceServer) GetAccessTokens(context.Context, *v1.GetAccessTokensRequest) (*v1.GetAccessTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccessTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAccessToken(context.Context, *v1.RevokeAccessTokenRequest) (*v1.RevokeAccessTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedAuthServiceServer) GetSecurityEvents(context.Context, *v1.GetSecurityEventsRequest) (*v1.GetSecurityEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecurityEvents not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteAllSessions(context.Context, *v1.DeleteAllSessionsRequest) (*v1.DeleteAllSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAllSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) DeactivateAccount(context.Context, *v1.DeactivateAccountRequest) (*v1.DeactivateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) DisableTwoFa(context.Context, *v1.DisableTwoFaRequest) (*v1.DisableTwoFaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableTwoFa not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestReauthenticationCode(context.Context, *v1.RequestReauthenticationCodeRequest) (*v1.RequestReauthenticationCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestReauthenticationCode not implemented")
+}
+func (UnimplementedAuthServiceServer) Reauthenticate(context.Context, *v1.ReauthenticateRequest) (*v1.ReauthenticateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reauthenticate not implemented")
+}
+func (UnimplementedAuthServiceServer) AnswerLoginConfirmation(context.Context, *v1.AnswerLoginConfirmationRequest) (*v1.AnswerLoginConfirmationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnswerLoginConfirmation not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestDataExport(context.Context, *v1.RequestDataExportRequest) (*v1.RequestDataExportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedAuthServiceServer) DownloadDataExport(*v1.DownloadDataExportRequest, grpc.ServerStreamingServer[v1.DownloadDataExportResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestAccountReactivation(context.Context, *v1.RequestAccountReactivationRequest) (*v1.RequestAccountReactivationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestAccountReactivation not implemented")
+}
+func (UnimplementedAuthServiceServer) ReactivateAccount(context.Context, *v1.ReactivateAccountRequest) (*v1.ReactivateAccountResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReactivateAccount not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestMagicLink(context.Context, *v1.RequestMagicLinkRequest) (*v1.RequestMagicLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) SignInWithMagicLink(context.Context, *v1.SignInWithMagicLinkRequest) (*v1.SignInWithMagicLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignInWithMagicLink not implemented")
+}
+func (UnimplementedAuthServiceServer) WatchQRLogin(*v1.WatchQRLoginRequest, grpc.ServerStreamingServer[v1.WatchQRLoginResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchQRLogin not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyTwoFa(context.Context, *v1.VerifyTwoFaRequest) (*v1.VerifyTwoFaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyTwoFa not implemented")
+}
+func (UnimplementedAuthServiceServer) GetTrustedDevices(context.Context, *v1.GetTrustedDevicesRequest) (*v1.GetTrustedDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrustedDevices not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeTrustedDevice(context.Context, *v1.RevokeTrustedDeviceRequest) (*v1.RevokeTrustedDeviceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeTrustedDevice not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAllTrustedDevices(context.Context, *v1.RevokeAllTrustedDevicesRequest) (*v1.RevokeAllTrustedDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllTrustedDevices not implemented")
+}
+func (UnimplementedAuthServiceServer) RequestAccountDeletion(context.Context, *v1.RequestAccountDeletionRequest) (*v1.RequestAccountDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedAuthServiceServer) CancelAccountDeletion(context.Context, *v1.CancelAccountDeletionRequest) (*v1.CancelAccountDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateInvite(context.Context, *v1.CreateInviteRequest) (*v1.CreateInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedAuthServiceServer) GetInvites(context.Context, *v1.GetInvitesRequest) (*v1.GetInvitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvites not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeInvite(context.Context, *v1.RevokeInviteRequest) (*v1.RevokeInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignUp(ctx, req.(*v1.SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignIn(ctx, req.(*v1.SignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_PingSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PingSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).PingSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_PingSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).PingSession(ctx, req.(*v1.PingSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetActiveSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetActiveSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetActiveSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetActiveSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetActiveSessions(ctx, req.(*v1.GetActiveSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteSession(ctx, req.(*v1.DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateAccessToken(ctx, req.(*v1.CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetAccessTokens(ctx, req.(*v1.GetAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAccessToken(ctx, req.(*v1.RevokeAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetSecurityEvents(ctx, req.(*v1.GetSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeleteAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteAllSessions(ctx, req.(*v1.DeleteAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeactivateAccount(ctx, req.(*v1.DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DisableTwoFa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DisableTwoFaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DisableTwoFa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DisableTwoFa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DisableTwoFa(ctx, req.(*v1.DisableTwoFaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestReauthenticationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestReauthenticationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestReauthenticationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestReauthenticationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestReauthenticationCode(ctx, req.(*v1.RequestReauthenticationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ReauthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Reauthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Reauthenticate(ctx, req.(*v1.ReauthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AnswerLoginConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.AnswerLoginConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AnswerLoginConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AnswerLoginConfirmation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AnswerLoginConfirmation(ctx, req.(*v1.AnswerLoginConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*v1.ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestDataExport(ctx, req.(*v1.RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).DownloadDataExport(m, &grpc.GenericServerStream[v1.DownloadDataExportRequest, v1.DownloadDataExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_DownloadDataExportServer = grpc.ServerStreamingServer[v1.DownloadDataExportResponse]
+
+func _AuthService_RequestAccountReactivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestAccountReactivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestAccountReactivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestAccountReactivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestAccountReactivation(ctx, req.(*v1.RequestAccountReactivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ReactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ReactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ReactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ReactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ReactivateAccount(ctx, req.(*v1.ReactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestMagicLink(ctx, req.(*v1.RequestMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignInWithMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.SignInWithMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignInWithMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SignInWithMagicLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignInWithMagicLink(ctx, req.(*v1.SignInWithMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_WatchQRLogin_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v1.WatchQRLoginRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuthServiceServer).WatchQRLogin(m, &grpc.GenericServerStream[v1.WatchQRLoginRequest, v1.WatchQRLoginResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuthService_WatchQRLoginServer = grpc.ServerStreamingServer[v1.WatchQRLoginResponse]
+
+func _AuthService_VerifyTwoFa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.VerifyTwoFaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyTwoFa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyTwoFa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyTwoFa(ctx, req.(*v1.VerifyTwoFaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetTrustedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetTrustedDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetTrustedDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetTrustedDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetTrustedDevices(ctx, req.(*v1.GetTrustedDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeTrustedDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeTrustedDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeTrustedDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeTrustedDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeTrustedDevice(ctx, req.(*v1.RevokeTrustedDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAllTrustedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeAllTrustedDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAllTrustedDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAllTrustedDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAllTrustedDevices(ctx, req.(*v1.RevokeAllTrustedDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RequestAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestAccountDeletion(ctx, req.(*v1.RequestAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CancelAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CancelAccountDeletion(ctx, req.(*v1.CancelAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateInvite(ctx, req.(*v1.CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetInvites(ctx, req.(*v1.GetInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeInvite(ctx, req.(*v1.RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUp",
+			Handler:    _AuthService_SignUp_Handler,
+		},
+		{
+			MethodName: "SignIn",
+			Handler:    _AuthService_SignIn_Handler,
+		},
+		{
+			MethodName: "PingSession",
+			Handler:    _AuthService_PingSession_Handler,
+		},
+		{
+			MethodName: "GetActiveSessions",
+			Handler:    _AuthService_GetActiveSessions_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _AuthService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _AuthService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "GetAccessTokens",
+			Handler:    _AuthService_GetAccessTokens_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _AuthService_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "GetSecurityEvents",
+			Handler:    _AuthService_GetSecurityEvents_Handler,
+		},
+		{
+			MethodName: "DeleteAllSessions",
+			Handler:    _AuthService_DeleteAllSessions_Handler,
+		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _AuthService_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "DisableTwoFa",
+			Handler:    _AuthService_DisableTwoFa_Handler,
+		},
+		{
+			MethodName: "RequestReauthenticationCode",
+			Handler:    _AuthService_RequestReauthenticationCode_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _AuthService_Reauthenticate_Handler,
+		},
+		{
+			MethodName: "AnswerLoginConfirmation",
+			Handler:    _AuthService_AnswerLoginConfirmation_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AuthService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _AuthService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "RequestAccountReactivation",
+			Handler:    _AuthService_RequestAccountReactivation_Handler,
+		},
+		{
+			MethodName: "ReactivateAccount",
+			Handler:    _AuthService_ReactivateAccount_Handler,
+		},
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _AuthService_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "SignInWithMagicLink",
+			Handler:    _AuthService_SignInWithMagicLink_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFa",
+			Handler:    _AuthService_VerifyTwoFa_Handler,
+		},
+		{
+			MethodName: "GetTrustedDevices",
+			Handler:    _AuthService_GetTrustedDevices_Handler,
+		},
+		{
+			MethodName: "RevokeTrustedDevice",
+			Handler:    _AuthService_RevokeTrustedDevice_Handler,
+		},
+		{
+			MethodName: "RevokeAllTrustedDevices",
+			Handler:    _AuthService_RevokeAllTrustedDevices_Handler,
+		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _AuthService_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _AuthService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _AuthService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "GetInvites",
+			Handler:    _AuthService_GetInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _AuthService_RevokeInvite_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _AuthService_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchQRLogin",
+			Handler:       _AuthService_WatchQRLogin_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "auth/v1/auth.proto",
+}
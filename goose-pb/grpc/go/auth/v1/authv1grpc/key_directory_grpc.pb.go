@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: auth/v1/key_directory.proto
+
+package authv1grpc
+
+import (
+	v1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KeyDirectoryService_RegisterDeviceKeys_FullMethodName   = "/auth.v1.KeyDirectoryService/RegisterDeviceKeys"
+	KeyDirectoryService_RotateSignedPrekey_FullMethodName   = "/auth.v1.KeyDirectoryService/RotateSignedPrekey"
+	KeyDirectoryService_UploadOneTimePrekeys_FullMethodName = "/auth.v1.KeyDirectoryService/UploadOneTimePrekeys"
+	KeyDirectoryService_GetPrekeyBundles_FullMethodName     = "/auth.v1.KeyDirectoryService/GetPrekeyBundles"
+	KeyDirectoryService_GetIdentityKeys_FullMethodName      = "/auth.v1.KeyDirectoryService/GetIdentityKeys"
+	KeyDirectoryService_GetDeviceKeysStatus_FullMethodName  = "/auth.v1.KeyDirectoryService/GetDeviceKeysStatus"
+	KeyDirectoryService_RemoveDeviceKeys_FullMethodName     = "/auth.v1.KeyDirectoryService/RemoveDeviceKeys"
+)
+
+// KeyDirectoryServiceClient is the client API for KeyDirectoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// KeyDirectoryService publishes public keys of user's devices for end-to-end encryption.
+// Devices of the caller are managed, while bundles and identity keys of any user may be fetched
+type KeyDirectoryServiceClient interface {
+	// publishes device's identity key along with initial prekeys
+	RegisterDeviceKeys(ctx context.Context, in *v1.RegisterDeviceKeysRequest, opts ...grpc.CallOption) (*v1.RegisterDeviceKeysResponse, error)
+	RotateSignedPrekey(ctx context.Context, in *v1.RotateSignedPrekeyRequest, opts ...grpc.CallOption) (*v1.RotateSignedPrekeyResponse, error)
+	UploadOneTimePrekeys(ctx context.Context, in *v1.UploadOneTimePrekeysRequest, opts ...grpc.CallOption) (*v1.UploadOneTimePrekeysResponse, error)
+	// returns bundle for every device of user, one-time prekeys in bundles are consumed.
+	// Device whose one-time prekeys run low is sent "prekeys_low" notification
+	GetPrekeyBundles(ctx context.Context, in *v1.GetPrekeyBundlesRequest, opts ...grpc.CallOption) (*v1.GetPrekeyBundlesResponse, error)
+	GetIdentityKeys(ctx context.Context, in *v1.GetIdentityKeysRequest, opts ...grpc.CallOption) (*v1.GetIdentityKeysResponse, error)
+	// tells device whether it should upload more prekeys or rotate signed one
+	GetDeviceKeysStatus(ctx context.Context, in *v1.GetDeviceKeysStatusRequest, opts ...grpc.CallOption) (*v1.GetDeviceKeysStatusResponse, error)
+	RemoveDeviceKeys(ctx context.Context, in *v1.RemoveDeviceKeysRequest, opts ...grpc.CallOption) (*v1.RemoveDeviceKeysResponse, error)
+}
+
+type keyDirectoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeyDirectoryServiceClient(cc grpc.ClientConnInterface) KeyDirectoryServiceClient {
+	return &keyDirectoryServiceClient{cc}
+}
+
+func (c *keyDirectoryServiceClient) RegisterDeviceKeys(ctx context.Context, in *v1.RegisterDeviceKeysRequest, opts ...grpc.CallOption) (*v1.RegisterDeviceKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RegisterDeviceKeysResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_RegisterDeviceKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) RotateSignedPrekey(ctx context.Context, in *v1.RotateSignedPrekeyRequest, opts ...grpc.CallOption) (*v1.RotateSignedPrekeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RotateSignedPrekeyResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_RotateSignedPrekey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) UploadOneTimePrekeys(ctx context.Context, in *v1.UploadOneTimePrekeysRequest, opts ...grpc.CallOption) (*v1.UploadOneTimePrekeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.UploadOneTimePrekeysResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_UploadOneTimePrekeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) GetPrekeyBundles(ctx context.Context, in *v1.GetPrekeyBundlesRequest, opts ...grpc.CallOption) (*v1.GetPrekeyBundlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetPrekeyBundlesResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_GetPrekeyBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) GetIdentityKeys(ctx context.Context, in *v1.GetIdentityKeysRequest, opts ...grpc.CallOption) (*v1.GetIdentityKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetIdentityKeysResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_GetIdentityKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) GetDeviceKeysStatus(ctx context.Context, in *v1.GetDeviceKeysStatusRequest, opts ...grpc.CallOption) (*v1.GetDeviceKeysStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetDeviceKeysStatusResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_GetDeviceKeysStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyDirectoryServiceClient) RemoveDeviceKeys(ctx context.Context, in *v1.RemoveDeviceKeysRequest, opts ...grpc.CallOption) (*v1.RemoveDeviceKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RemoveDeviceKeysResponse)
+	err := c.cc.Invoke(ctx, KeyDirectoryService_RemoveDeviceKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyDirectoryServiceServer is the server API for KeyDirectoryService service.
+// All implementations should embed UnimplementedKeyDirectoryServiceServer
+// for forward compatibility.
+//
+// KeyDirectoryService publishes public keys of user's devices for end-to-end encryption.
+// Devices of the caller are managed, while bundles and identity keys of any user may be fetched
+type KeyDirectoryServiceServer interface {
+	// publishes device's identity key along with initial prekeys
+	RegisterDeviceKeys(context.Context, *v1.RegisterDeviceKeysRequest) (*v1.RegisterDeviceKeysResponse, error)
+	RotateSignedPrekey(context.Context, *v1.RotateSignedPrekeyRequest) (*v1.RotateSignedPrekeyResponse, error)
+	UploadOneTimePrekeys(context.Context, *v1.UploadOneTimePrekeysRequest) (*v1.UploadOneTimePrekeysResponse, error)
+	// returns bundle for every device of user, one-time prekeys in bundles are consumed.
+	// Device whose one-time prekeys run low is sent "prekeys_low" notification
+	GetPrekeyBundles(context.Context, *v1.GetPrekeyBundlesRequest) (*v1.GetPrekeyBundlesResponse, error)
+	GetIdentityKeys(context.Context, *v1.GetIdentityKeysRequest) (*v1.GetIdentityKeysResponse, error)
+	// tells device whether it should upload more prekeys or rotate signed one
+	GetDeviceKeysStatus(context.Context, *v1.GetDeviceKeysStatusRequest) (*v1.GetDeviceKeysStatusResponse, error)
+	RemoveDeviceKeys(context.Context, *v1.RemoveDeviceKeysRequest) (*v1.RemoveDeviceKeysResponse, error)
+}
+
+// UnimplementedKeyDirectoryServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKeyDirectoryServiceServer struct{}
+
+func (UnimplementedKeyDirectoryServiceServer) RegisterDeviceKeys(context.Context, *v1.RegisterDeviceKeysRequest) (*v1.RegisterDeviceKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterDeviceKeys not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) RotateSignedPrekey(context.Context, *v1.RotateSignedPrekeyRequest) (*v1.RotateSignedPrekeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateSignedPrekey not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) UploadOneTimePrekeys(context.Context, *v1.UploadOneTimePrekeysRequest) (*v1.UploadOneTimePrekeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadOneTimePrekeys not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) GetPrekeyBundles(context.Context, *v1.GetPrekeyBundlesRequest) (*v1.GetPrekeyBundlesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrekeyBundles not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) GetIdentityKeys(context.Context, *v1.GetIdentityKeysRequest) (*v1.GetIdentityKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIdentityKeys not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) GetDeviceKeysStatus(context.Context, *v1.GetDeviceKeysStatusRequest) (*v1.GetDeviceKeysStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDeviceKeysStatus not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) RemoveDeviceKeys(context.Context, *v1.RemoveDeviceKeysRequest) (*v1.RemoveDeviceKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveDeviceKeys not implemented")
+}
+func (UnimplementedKeyDirectoryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeKeyDirectoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyDirectoryServiceServer will
+// result in compilation errors.
+type UnsafeKeyDirectoryServiceServer interface {
+	mustEmbedUnimplementedKeyDirectoryServiceServer()
+}
+
+func RegisterKeyDirectoryServiceServer(s grpc.ServiceRegistrar, srv KeyDirectoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedKeyDirectoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KeyDirectoryService_ServiceDesc, srv)
+}
+
+func _KeyDirectoryService_RegisterDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RegisterDeviceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).RegisterDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_RegisterDeviceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).RegisterDeviceKeys(ctx, req.(*v1.RegisterDeviceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_RotateSignedPrekey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RotateSignedPrekeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).RotateSignedPrekey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_RotateSignedPrekey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).RotateSignedPrekey(ctx, req.(*v1.RotateSignedPrekeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_UploadOneTimePrekeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UploadOneTimePrekeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).UploadOneTimePrekeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_UploadOneTimePrekeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).UploadOneTimePrekeys(ctx, req.(*v1.UploadOneTimePrekeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_GetPrekeyBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetPrekeyBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).GetPrekeyBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_GetPrekeyBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).GetPrekeyBundles(ctx, req.(*v1.GetPrekeyBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_GetIdentityKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetIdentityKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).GetIdentityKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_GetIdentityKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).GetIdentityKeys(ctx, req.(*v1.GetIdentityKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_GetDeviceKeysStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetDeviceKeysStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).GetDeviceKeysStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_GetDeviceKeysStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).GetDeviceKeysStatus(ctx, req.(*v1.GetDeviceKeysStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyDirectoryService_RemoveDeviceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RemoveDeviceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyDirectoryServiceServer).RemoveDeviceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyDirectoryService_RemoveDeviceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyDirectoryServiceServer).RemoveDeviceKeys(ctx, req.(*v1.RemoveDeviceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KeyDirectoryService_ServiceDesc is the grpc.ServiceDesc for KeyDirectoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KeyDirectoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.KeyDirectoryService",
+	HandlerType: (*KeyDirectoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDeviceKeys",
+			Handler:    _KeyDirectoryService_RegisterDeviceKeys_Handler,
+		},
+		{
+			MethodName: "RotateSignedPrekey",
+			Handler:    _KeyDirectoryService_RotateSignedPrekey_Handler,
+		},
+		{
+			MethodName: "UploadOneTimePrekeys",
+			Handler:    _KeyDirectoryService_UploadOneTimePrekeys_Handler,
+		},
+		{
+			MethodName: "GetPrekeyBundles",
+			Handler:    _KeyDirectoryService_GetPrekeyBundles_Handler,
+		},
+		{
+			MethodName: "GetIdentityKeys",
+			Handler:    _KeyDirectoryService_GetIdentityKeys_Handler,
+		},
+		{
+			MethodName: "GetDeviceKeysStatus",
+			Handler:    _KeyDirectoryService_GetDeviceKeysStatus_Handler,
+		},
+		{
+			MethodName: "RemoveDeviceKeys",
+			Handler:    _KeyDirectoryService_RemoveDeviceKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/key_directory.proto",
+}
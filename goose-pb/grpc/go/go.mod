@@ -0,0 +1,8 @@
+module buf.build/gen/go/co3n/goose-proto/grpc/go
+
+go 1.24
+
+require (
+	buf.build/gen/go/co3n/goose-proto/protocolbuffers/go v1.36.11-20260118192846-29625ecf5663.1
+	google.golang.org/grpc v1.70.0
+)
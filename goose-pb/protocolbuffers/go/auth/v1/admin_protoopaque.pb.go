@@ -0,0 +1,2701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: auth/v1/admin.proto
+
+//go:build protoopaque
+
+package authv1
+
+import (
+	v1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/users/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// account as seen by support staff
+type AdminUser struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User              *v1.User               `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_State             string                 `protobuf:"bytes,2,opt,name=state,proto3"`
+	xxx_hidden_StateReason       string                 `protobuf:"bytes,3,opt,name=state_reason,json=stateReason,proto3"`
+	xxx_hidden_StateChangedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=state_changed_at,json=stateChangedAt,proto3"`
+	xxx_hidden_StateUntil        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=state_until,json=stateUntil,proto3"`
+	xxx_hidden_MustResetPassword bool                   `protobuf:"varint,6,opt,name=must_reset_password,json=mustResetPassword,proto3"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_auth_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdminUser) GetUser() *v1.User {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *AdminUser) GetState() string {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return ""
+}
+
+func (x *AdminUser) GetStateReason() string {
+	if x != nil {
+		return x.xxx_hidden_StateReason
+	}
+	return ""
+}
+
+func (x *AdminUser) GetStateChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StateChangedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetStateUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_StateUntil
+	}
+	return nil
+}
+
+func (x *AdminUser) GetMustResetPassword() bool {
+	if x != nil {
+		return x.xxx_hidden_MustResetPassword
+	}
+	return false
+}
+
+func (x *AdminUser) SetUser(v *v1.User) {
+	x.xxx_hidden_User = v
+}
+
+func (x *AdminUser) SetState(v string) {
+	x.xxx_hidden_State = v
+}
+
+func (x *AdminUser) SetStateReason(v string) {
+	x.xxx_hidden_StateReason = v
+}
+
+func (x *AdminUser) SetStateChangedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StateChangedAt = v
+}
+
+func (x *AdminUser) SetStateUntil(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StateUntil = v
+}
+
+func (x *AdminUser) SetMustResetPassword(v bool) {
+	x.xxx_hidden_MustResetPassword = v
+}
+
+func (x *AdminUser) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *AdminUser) HasStateChangedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StateChangedAt != nil
+}
+
+func (x *AdminUser) HasStateUntil() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StateUntil != nil
+}
+
+func (x *AdminUser) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+func (x *AdminUser) ClearStateChangedAt() {
+	x.xxx_hidden_StateChangedAt = nil
+}
+
+func (x *AdminUser) ClearStateUntil() {
+	x.xxx_hidden_StateUntil = nil
+}
+
+type AdminUser_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *v1.User
+	// active, deactivated, locked, suspended, banned or pending_deletion
+	State          string
+	StateReason    string
+	StateChangedAt *timestamppb.Timestamp
+	// set for temporary states, account is active again once it passes
+	StateUntil        *timestamppb.Timestamp
+	MustResetPassword bool
+}
+
+func (b0 AdminUser_builder) Build() *AdminUser {
+	m0 := &AdminUser{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_State = b.State
+	x.xxx_hidden_StateReason = b.StateReason
+	x.xxx_hidden_StateChangedAt = b.StateChangedAt
+	x.xxx_hidden_StateUntil = b.StateUntil
+	x.xxx_hidden_MustResetPassword = b.MustResetPassword
+	return m0
+}
+
+type AdminAction struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_AdminId      int64                  `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3"`
+	xxx_hidden_TargetUserId int64                  `protobuf:"varint,3,opt,name=target_user_id,json=targetUserId,proto3"`
+	xxx_hidden_Action       string                 `protobuf:"bytes,4,opt,name=action,proto3"`
+	xxx_hidden_Details      map[string]string      `protobuf:"bytes,5,rep,name=details,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AdminAction) Reset() {
+	*x = AdminAction{}
+	mi := &file_auth_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAction) ProtoMessage() {}
+
+func (x *AdminAction) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdminAction) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *AdminAction) GetAdminId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AdminId
+	}
+	return 0
+}
+
+func (x *AdminAction) GetTargetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TargetUserId
+	}
+	return 0
+}
+
+func (x *AdminAction) GetAction() string {
+	if x != nil {
+		return x.xxx_hidden_Action
+	}
+	return ""
+}
+
+func (x *AdminAction) GetDetails() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Details
+	}
+	return nil
+}
+
+func (x *AdminAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminAction) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *AdminAction) SetAdminId(v int64) {
+	x.xxx_hidden_AdminId = v
+}
+
+func (x *AdminAction) SetTargetUserId(v int64) {
+	x.xxx_hidden_TargetUserId = v
+}
+
+func (x *AdminAction) SetAction(v string) {
+	x.xxx_hidden_Action = v
+}
+
+func (x *AdminAction) SetDetails(v map[string]string) {
+	x.xxx_hidden_Details = v
+}
+
+func (x *AdminAction) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *AdminAction) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *AdminAction) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type AdminAction_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      int64
+	AdminId int64
+	// zero if action did not target particular account
+	TargetUserId int64
+	Action       string
+	Details      map[string]string
+	CreatedAt    *timestamppb.Timestamp
+}
+
+func (b0 AdminAction_builder) Build() *AdminAction {
+	m0 := &AdminAction{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_AdminId = b.AdminId
+	x.xxx_hidden_TargetUserId = b.TargetUserId
+	x.xxx_hidden_Action = b.Action
+	x.xxx_hidden_Details = b.Details
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+type Role struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	xxx_hidden_Permissions []string               `protobuf:"bytes,3,rep,name=permissions,proto3"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_auth_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.xxx_hidden_Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Role) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *Role) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *Role) SetPermissions(v []string) {
+	x.xxx_hidden_Permissions = v
+}
+
+func (x *Role) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Role) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Role) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type Role_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+	Permissions []string
+	CreatedAt   *timestamppb.Timestamp
+}
+
+func (b0 Role_builder) Build() *Role {
+	m0 := &Role{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Permissions = b.Permissions
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+type SearchUsersRequest struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Query string                 `protobuf:"bytes,1,opt,name=query,proto3"`
+	xxx_hidden_Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.xxx_hidden_Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = v
+}
+
+func (x *SearchUsersRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type SearchUsersRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// matched against user id, email and username prefix
+	Query string
+	Limit int32
+}
+
+func (b0 SearchUsersRequest_builder) Build() *SearchUsersRequest {
+	m0 := &SearchUsersRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Query = b.Query
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type SearchUsersResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Users *[]*AdminUser          `protobuf:"bytes,1,rep,name=users,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		if x.xxx_hidden_Users != nil {
+			return *x.xxx_hidden_Users
+		}
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) SetUsers(v []*AdminUser) {
+	x.xxx_hidden_Users = &v
+}
+
+type SearchUsersResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Users []*AdminUser
+}
+
+func (b0 SearchUsersResponse_builder) Build() *SearchUsersResponse {
+	m0 := &SearchUsersResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Users = &b.Users
+	return m0
+}
+
+type GetUserDetailsRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsRequest) Reset() {
+	*x = GetUserDetailsRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsRequest) ProtoMessage() {}
+
+func (x *GetUserDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserDetailsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *GetUserDetailsRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+type GetUserDetailsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 GetUserDetailsRequest_builder) Build() *GetUserDetailsRequest {
+	m0 := &GetUserDetailsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type GetUserDetailsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User     *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3"`
+	xxx_hidden_Sessions *[]*AuthSession        `protobuf:"bytes,2,rep,name=sessions,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetUserDetailsResponse) Reset() {
+	*x = GetUserDetailsResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailsResponse) ProtoMessage() {}
+
+func (x *GetUserDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserDetailsResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *GetUserDetailsResponse) GetSessions() []*AuthSession {
+	if x != nil {
+		if x.xxx_hidden_Sessions != nil {
+			return *x.xxx_hidden_Sessions
+		}
+	}
+	return nil
+}
+
+func (x *GetUserDetailsResponse) SetUser(v *AdminUser) {
+	x.xxx_hidden_User = v
+}
+
+func (x *GetUserDetailsResponse) SetSessions(v []*AuthSession) {
+	x.xxx_hidden_Sessions = &v
+}
+
+func (x *GetUserDetailsResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *GetUserDetailsResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+type GetUserDetailsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User     *AdminUser
+	Sessions []*AuthSession
+}
+
+func (b0 GetUserDetailsResponse_builder) Build() *GetUserDetailsResponse {
+	m0 := &GetUserDetailsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	x.xxx_hidden_Sessions = &b.Sessions
+	return m0
+}
+
+type ChangeAccountStateRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_State  string                 `protobuf:"bytes,2,opt,name=state,proto3"`
+	xxx_hidden_Reason string                 `protobuf:"bytes,3,opt,name=reason,proto3"`
+	xxx_hidden_Until  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeAccountStateRequest) Reset() {
+	*x = ChangeAccountStateRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAccountStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAccountStateRequest) ProtoMessage() {}
+
+func (x *ChangeAccountStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ChangeAccountStateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *ChangeAccountStateRequest) GetState() string {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return ""
+}
+
+func (x *ChangeAccountStateRequest) GetReason() string {
+	if x != nil {
+		return x.xxx_hidden_Reason
+	}
+	return ""
+}
+
+func (x *ChangeAccountStateRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Until
+	}
+	return nil
+}
+
+func (x *ChangeAccountStateRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *ChangeAccountStateRequest) SetState(v string) {
+	x.xxx_hidden_State = v
+}
+
+func (x *ChangeAccountStateRequest) SetReason(v string) {
+	x.xxx_hidden_Reason = v
+}
+
+func (x *ChangeAccountStateRequest) SetUntil(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Until = v
+}
+
+func (x *ChangeAccountStateRequest) HasUntil() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Until != nil
+}
+
+func (x *ChangeAccountStateRequest) ClearUntil() {
+	x.xxx_hidden_Until = nil
+}
+
+type ChangeAccountStateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+	// active, suspended or banned
+	State string
+	// required unless account is activated
+	Reason string
+	// account is active again once it passes, permanent if empty
+	Until *timestamppb.Timestamp
+}
+
+func (b0 ChangeAccountStateRequest_builder) Build() *ChangeAccountStateRequest {
+	m0 := &ChangeAccountStateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_State = b.State
+	x.xxx_hidden_Reason = b.Reason
+	x.xxx_hidden_Until = b.Until
+	return m0
+}
+
+type ChangeAccountStateResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_User *AdminUser             `protobuf:"bytes,1,opt,name=user,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeAccountStateResponse) Reset() {
+	*x = ChangeAccountStateResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAccountStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAccountStateResponse) ProtoMessage() {}
+
+func (x *ChangeAccountStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ChangeAccountStateResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.xxx_hidden_User
+	}
+	return nil
+}
+
+func (x *ChangeAccountStateResponse) SetUser(v *AdminUser) {
+	x.xxx_hidden_User = v
+}
+
+func (x *ChangeAccountStateResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_User != nil
+}
+
+func (x *ChangeAccountStateResponse) ClearUser() {
+	x.xxx_hidden_User = nil
+}
+
+type ChangeAccountStateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User *AdminUser
+}
+
+func (b0 ChangeAccountStateResponse_builder) Build() *ChangeAccountStateResponse {
+	m0 := &ChangeAccountStateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_User = b.User
+	return m0
+}
+
+type ForceLogoutRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ForceLogoutRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *ForceLogoutRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+type ForceLogoutRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 ForceLogoutRequest_builder) Build() *ForceLogoutRequest {
+	m0 := &ForceLogoutRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type ForceLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ForceLogoutResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ForceLogoutResponse_builder) Build() *ForceLogoutResponse {
+	m0 := &ForceLogoutResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ResetTwoFaRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResetTwoFaRequest) Reset() {
+	*x = ResetTwoFaRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTwoFaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTwoFaRequest) ProtoMessage() {}
+
+func (x *ResetTwoFaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ResetTwoFaRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *ResetTwoFaRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+type ResetTwoFaRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 ResetTwoFaRequest_builder) Build() *ResetTwoFaRequest {
+	m0 := &ResetTwoFaRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type ResetTwoFaResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetTwoFaResponse) Reset() {
+	*x = ResetTwoFaResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTwoFaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTwoFaResponse) ProtoMessage() {}
+
+func (x *ResetTwoFaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ResetTwoFaResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ResetTwoFaResponse_builder) Build() *ResetTwoFaResponse {
+	m0 := &ResetTwoFaResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type TriggerPasswordResetRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TriggerPasswordResetRequest) Reset() {
+	*x = TriggerPasswordResetRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerPasswordResetRequest) ProtoMessage() {}
+
+func (x *TriggerPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TriggerPasswordResetRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TriggerPasswordResetRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+type TriggerPasswordResetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 TriggerPasswordResetRequest_builder) Build() *TriggerPasswordResetRequest {
+	m0 := &TriggerPasswordResetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type TriggerPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerPasswordResetResponse) Reset() {
+	*x = TriggerPasswordResetResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerPasswordResetResponse) ProtoMessage() {}
+
+func (x *TriggerPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type TriggerPasswordResetResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 TriggerPasswordResetResponse_builder) Build() *TriggerPasswordResetResponse {
+	m0 := &TriggerPasswordResetResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SearchSecurityEventsRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_IpAddr   string                 `protobuf:"bytes,2,opt,name=ip_addr,json=ipAddr,proto3"`
+	xxx_hidden_Types    []string               `protobuf:"bytes,3,rep,name=types,proto3"`
+	xxx_hidden_BeforeId int64                  `protobuf:"varint,4,opt,name=before_id,json=beforeId,proto3"`
+	xxx_hidden_Limit    int32                  `protobuf:"varint,5,opt,name=limit,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SearchSecurityEventsRequest) Reset() {
+	*x = SearchSecurityEventsRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecurityEventsRequest) ProtoMessage() {}
+
+func (x *SearchSecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchSecurityEventsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *SearchSecurityEventsRequest) GetIpAddr() string {
+	if x != nil {
+		return x.xxx_hidden_IpAddr
+	}
+	return ""
+}
+
+func (x *SearchSecurityEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.xxx_hidden_Types
+	}
+	return nil
+}
+
+func (x *SearchSecurityEventsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.xxx_hidden_BeforeId
+	}
+	return 0
+}
+
+func (x *SearchSecurityEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SearchSecurityEventsRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *SearchSecurityEventsRequest) SetIpAddr(v string) {
+	x.xxx_hidden_IpAddr = v
+}
+
+func (x *SearchSecurityEventsRequest) SetTypes(v []string) {
+	x.xxx_hidden_Types = v
+}
+
+func (x *SearchSecurityEventsRequest) SetBeforeId(v int64) {
+	x.xxx_hidden_BeforeId = v
+}
+
+func (x *SearchSecurityEventsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type SearchSecurityEventsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+	IpAddr string
+	Types  []string
+	// next_cursor of previous page, zero for the first page
+	BeforeId int64
+	Limit    int32
+}
+
+func (b0 SearchSecurityEventsRequest_builder) Build() *SearchSecurityEventsRequest {
+	m0 := &SearchSecurityEventsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_IpAddr = b.IpAddr
+	x.xxx_hidden_Types = b.Types
+	x.xxx_hidden_BeforeId = b.BeforeId
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type SearchSecurityEventsResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Events     *[]*SecurityEvent      `protobuf:"bytes,1,rep,name=events,proto3"`
+	xxx_hidden_NextCursor int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchSecurityEventsResponse) Reset() {
+	*x = SearchSecurityEventsResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSecurityEventsResponse) ProtoMessage() {}
+
+func (x *SearchSecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchSecurityEventsResponse) GetEvents() []*SecurityEvent {
+	if x != nil {
+		if x.xxx_hidden_Events != nil {
+			return *x.xxx_hidden_Events
+		}
+	}
+	return nil
+}
+
+func (x *SearchSecurityEventsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.xxx_hidden_NextCursor
+	}
+	return 0
+}
+
+func (x *SearchSecurityEventsResponse) SetEvents(v []*SecurityEvent) {
+	x.xxx_hidden_Events = &v
+}
+
+func (x *SearchSecurityEventsResponse) SetNextCursor(v int64) {
+	x.xxx_hidden_NextCursor = v
+}
+
+type SearchSecurityEventsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// events from newest to oldest
+	Events []*SecurityEvent
+	// zero if there are no more events
+	NextCursor int64
+}
+
+func (b0 SearchSecurityEventsResponse_builder) Build() *SearchSecurityEventsResponse {
+	m0 := &SearchSecurityEventsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Events = &b.Events
+	x.xxx_hidden_NextCursor = b.NextCursor
+	return m0
+}
+
+type GetAdminActionsRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_AdminId      int64                  `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3"`
+	xxx_hidden_TargetUserId int64                  `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3"`
+	xxx_hidden_Actions      []string               `protobuf:"bytes,3,rep,name=actions,proto3"`
+	xxx_hidden_BeforeId     int64                  `protobuf:"varint,4,opt,name=before_id,json=beforeId,proto3"`
+	xxx_hidden_Limit        int32                  `protobuf:"varint,5,opt,name=limit,proto3"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAdminActionsRequest) Reset() {
+	*x = GetAdminActionsRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminActionsRequest) ProtoMessage() {}
+
+func (x *GetAdminActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAdminActionsRequest) GetAdminId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AdminId
+	}
+	return 0
+}
+
+func (x *GetAdminActionsRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_TargetUserId
+	}
+	return 0
+}
+
+func (x *GetAdminActionsRequest) GetActions() []string {
+	if x != nil {
+		return x.xxx_hidden_Actions
+	}
+	return nil
+}
+
+func (x *GetAdminActionsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.xxx_hidden_BeforeId
+	}
+	return 0
+}
+
+func (x *GetAdminActionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *GetAdminActionsRequest) SetAdminId(v int64) {
+	x.xxx_hidden_AdminId = v
+}
+
+func (x *GetAdminActionsRequest) SetTargetUserId(v int64) {
+	x.xxx_hidden_TargetUserId = v
+}
+
+func (x *GetAdminActionsRequest) SetActions(v []string) {
+	x.xxx_hidden_Actions = v
+}
+
+func (x *GetAdminActionsRequest) SetBeforeId(v int64) {
+	x.xxx_hidden_BeforeId = v
+}
+
+func (x *GetAdminActionsRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type GetAdminActionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AdminId      int64
+	TargetUserId int64
+	Actions      []string
+	// next_cursor of previous page, zero for the first page
+	BeforeId int64
+	Limit    int32
+}
+
+func (b0 GetAdminActionsRequest_builder) Build() *GetAdminActionsRequest {
+	m0 := &GetAdminActionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_AdminId = b.AdminId
+	x.xxx_hidden_TargetUserId = b.TargetUserId
+	x.xxx_hidden_Actions = b.Actions
+	x.xxx_hidden_BeforeId = b.BeforeId
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type GetAdminActionsResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Actions    *[]*AdminAction        `protobuf:"bytes,1,rep,name=actions,proto3"`
+	xxx_hidden_NextCursor int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetAdminActionsResponse) Reset() {
+	*x = GetAdminActionsResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminActionsResponse) ProtoMessage() {}
+
+func (x *GetAdminActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAdminActionsResponse) GetActions() []*AdminAction {
+	if x != nil {
+		if x.xxx_hidden_Actions != nil {
+			return *x.xxx_hidden_Actions
+		}
+	}
+	return nil
+}
+
+func (x *GetAdminActionsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.xxx_hidden_NextCursor
+	}
+	return 0
+}
+
+func (x *GetAdminActionsResponse) SetActions(v []*AdminAction) {
+	x.xxx_hidden_Actions = &v
+}
+
+func (x *GetAdminActionsResponse) SetNextCursor(v int64) {
+	x.xxx_hidden_NextCursor = v
+}
+
+type GetAdminActionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// actions from newest to oldest
+	Actions []*AdminAction
+	// zero if there are no more actions
+	NextCursor int64
+}
+
+func (b0 GetAdminActionsResponse_builder) Build() *GetAdminActionsResponse {
+	m0 := &GetAdminActionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Actions = &b.Actions
+	x.xxx_hidden_NextCursor = b.NextCursor
+	return m0
+}
+
+type GetRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRolesRequest) Reset() {
+	*x = GetRolesRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesRequest) ProtoMessage() {}
+
+func (x *GetRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetRolesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetRolesRequest_builder) Build() *GetRolesRequest {
+	m0 := &GetRolesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetRolesResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Roles *[]*Role               `protobuf:"bytes,1,rep,name=roles,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRolesResponse) Reset() {
+	*x = GetRolesResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRolesResponse) ProtoMessage() {}
+
+func (x *GetRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		if x.xxx_hidden_Roles != nil {
+			return *x.xxx_hidden_Roles
+		}
+	}
+	return nil
+}
+
+func (x *GetRolesResponse) SetRoles(v []*Role) {
+	x.xxx_hidden_Roles = &v
+}
+
+type GetRolesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roles []*Role
+}
+
+func (b0 GetRolesResponse_builder) Build() *GetRolesResponse {
+	m0 := &GetRolesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Roles = &b.Roles
+	return m0
+}
+
+type GetUserRolesRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetUserRolesRequest) Reset() {
+	*x = GetUserRolesRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesRequest) ProtoMessage() {}
+
+func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserRolesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *GetUserRolesRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+type GetUserRolesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 GetUserRolesRequest_builder) Build() *GetUserRolesRequest {
+	m0 := &GetUserRolesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	return m0
+}
+
+type GetUserRolesResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Roles *[]*Role               `protobuf:"bytes,1,rep,name=roles,proto3"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserRolesResponse) Reset() {
+	*x = GetUserRolesResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesResponse) ProtoMessage() {}
+
+func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		if x.xxx_hidden_Roles != nil {
+			return *x.xxx_hidden_Roles
+		}
+	}
+	return nil
+}
+
+func (x *GetUserRolesResponse) SetRoles(v []*Role) {
+	x.xxx_hidden_Roles = &v
+}
+
+type GetUserRolesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roles []*Role
+}
+
+func (b0 GetUserRolesResponse_builder) Build() *GetUserRolesResponse {
+	m0 := &GetUserRolesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Roles = &b.Roles
+	return m0
+}
+
+type SaveRoleRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	xxx_hidden_Description string                 `protobuf:"bytes,2,opt,name=description,proto3"`
+	xxx_hidden_Permissions []string               `protobuf:"bytes,3,rep,name=permissions,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SaveRoleRequest) Reset() {
+	*x = SaveRoleRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRoleRequest) ProtoMessage() {}
+
+func (x *SaveRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SaveRoleRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *SaveRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.xxx_hidden_Description
+	}
+	return ""
+}
+
+func (x *SaveRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.xxx_hidden_Permissions
+	}
+	return nil
+}
+
+func (x *SaveRoleRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *SaveRoleRequest) SetDescription(v string) {
+	x.xxx_hidden_Description = v
+}
+
+func (x *SaveRoleRequest) SetPermissions(v []string) {
+	x.xxx_hidden_Permissions = v
+}
+
+type SaveRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Description string
+	Permissions []string
+}
+
+func (b0 SaveRoleRequest_builder) Build() *SaveRoleRequest {
+	m0 := &SaveRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Description = b.Description
+	x.xxx_hidden_Permissions = b.Permissions
+	return m0
+}
+
+type SaveRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role *Role                  `protobuf:"bytes,1,opt,name=role,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveRoleResponse) Reset() {
+	*x = SaveRoleResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRoleResponse) ProtoMessage() {}
+
+func (x *SaveRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SaveRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.xxx_hidden_Role
+	}
+	return nil
+}
+
+func (x *SaveRoleResponse) SetRole(v *Role) {
+	x.xxx_hidden_Role = v
+}
+
+func (x *SaveRoleResponse) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Role != nil
+}
+
+func (x *SaveRoleResponse) ClearRole() {
+	x.xxx_hidden_Role = nil
+}
+
+type SaveRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *Role
+}
+
+func (b0 SaveRoleResponse_builder) Build() *SaveRoleResponse {
+	m0 := &SaveRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Role = b.Role
+	return m0
+}
+
+type DeleteRoleRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name string                 `protobuf:"bytes,1,opt,name=name,proto3"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *DeleteRoleRequest) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+type DeleteRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+}
+
+func (b0 DeleteRoleRequest_builder) Build() *DeleteRoleRequest {
+	m0 := &DeleteRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	return m0
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteRoleResponse_builder) Build() *DeleteRoleResponse {
+	m0 := &DeleteRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type AssignRoleRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_RoleName string                 `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AssignRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *AssignRoleRequest) GetRoleName() string {
+	if x != nil {
+		return x.xxx_hidden_RoleName
+	}
+	return ""
+}
+
+func (x *AssignRoleRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *AssignRoleRequest) SetRoleName(v string) {
+	x.xxx_hidden_RoleName = v
+}
+
+type AssignRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId   int64
+	RoleName string
+}
+
+func (b0 AssignRoleRequest_builder) Build() *AssignRoleRequest {
+	m0 := &AssignRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_RoleName = b.RoleName
+	return m0
+}
+
+type AssignRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type AssignRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 AssignRoleResponse_builder) Build() *AssignRoleResponse {
+	m0 := &AssignRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RevokeRoleRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_RoleName string                 `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *RevokeRoleRequest) GetRoleName() string {
+	if x != nil {
+		return x.xxx_hidden_RoleName
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *RevokeRoleRequest) SetRoleName(v string) {
+	x.xxx_hidden_RoleName = v
+}
+
+type RevokeRoleRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId   int64
+	RoleName string
+}
+
+func (b0 RevokeRoleRequest_builder) Build() *RevokeRoleRequest {
+	m0 := &RevokeRoleRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_RoleName = b.RoleName
+	return m0
+}
+
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeRoleResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeRoleResponse_builder) Build() *RevokeRoleResponse {
+	m0 := &RevokeRoleResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// AdminService is used by support staff. Every RPC is called within admin's recently authenticated
+// session passed in metadata the same way as for AuthService, and requires permission granted by admin's roles.
+// Every action is recorded in admin audit log
+type AdminCreateInviteRequest struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Email     string                 `protobuf:"bytes,1,opt,name=email,proto3"`
+	xxx_hidden_MaxUses   int32                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3"`
+	xxx_hidden_ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdminCreateInviteRequest) Reset() {
+	*x = AdminCreateInviteRequest{}
+	mi := &file_auth_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateInviteRequest) ProtoMessage() {}
+
+func (x *AdminCreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdminCreateInviteRequest) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *AdminCreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.xxx_hidden_MaxUses
+	}
+	return 0
+}
+
+func (x *AdminCreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *AdminCreateInviteRequest) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *AdminCreateInviteRequest) SetMaxUses(v int32) {
+	x.xxx_hidden_MaxUses = v
+}
+
+func (x *AdminCreateInviteRequest) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *AdminCreateInviteRequest) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *AdminCreateInviteRequest) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type AdminCreateInviteRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email     string
+	MaxUses   int32
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 AdminCreateInviteRequest_builder) Build() *AdminCreateInviteRequest {
+	m0 := &AdminCreateInviteRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_MaxUses = b.MaxUses
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type AdminCreateInviteResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Invite *Invite                `protobuf:"bytes,1,opt,name=invite,proto3"`
+	xxx_hidden_Code   string                 `protobuf:"bytes,2,opt,name=code,proto3"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminCreateInviteResponse) Reset() {
+	*x = AdminCreateInviteResponse{}
+	mi := &file_auth_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateInviteResponse) ProtoMessage() {}
+
+func (x *AdminCreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AdminCreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.xxx_hidden_Invite
+	}
+	return nil
+}
+
+func (x *AdminCreateInviteResponse) GetCode() string {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return ""
+}
+
+func (x *AdminCreateInviteResponse) SetInvite(v *Invite) {
+	x.xxx_hidden_Invite = v
+}
+
+func (x *AdminCreateInviteResponse) SetCode(v string) {
+	x.xxx_hidden_Code = v
+}
+
+func (x *AdminCreateInviteResponse) HasInvite() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Invite != nil
+}
+
+func (x *AdminCreateInviteResponse) ClearInvite() {
+	x.xxx_hidden_Invite = nil
+}
+
+type AdminCreateInviteResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invite *Invite
+	// plain invite code, it is returned only once
+	Code string
+}
+
+func (b0 AdminCreateInviteResponse_builder) Build() *AdminCreateInviteResponse {
+	m0 := &AdminCreateInviteResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Invite = b.Invite
+	x.xxx_hidden_Code = b.Code
+	return m0
+}
+
+var File_auth_v1_admin_proto protoreflect.FileDescriptor
+
+const file_auth_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x13auth/v1/admin.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12auth/v1/auth.proto\x1a\x13users/v1/user.proto\"\x9b\x02\n" +
+	"\tAdminUser\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12!\n" +
+	"\fstate_reason\x18\x03 \x01(\tR\vstateReason\x12D\n" +
+	"\x10state_changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0estateChangedAt\x12;\n" +
+	"\vstate_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"stateUntil\x12.\n" +
+	"\x13must_reset_password\x18\x06 \x01(\bR\x11mustResetPassword\"\xaa\x02\n" +
+	"\vAdminAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\x03R\aadminId\x12$\n" +
+	"\x0etarget_user_id\x18\x03 \x01(\x03R\ftargetUserId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12;\n" +
+	"\adetails\x18\x05 \x03(\v2!.auth.v1.AdminAction.DetailsEntryR\adetails\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"?\n" +
+	"\x13SearchUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.auth.v1.AdminUserR\x05users\"0\n" +
+	"\x15GetUserDetailsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"r\n" +
+	"\x16GetUserDetailsResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.auth.v1.AdminUserR\x04user\x120\n" +
+	"\bsessions\x18\x02 \x03(\v2\x14.auth.v1.AuthSessionR\bsessions\"\x94\x01\n" +
+	"\x19ChangeAccountStateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"D\n" +
+	"\x1aChangeAccountStateResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.auth.v1.AdminUserR\x04user\"-\n" +
+	"\x12ForceLogoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x15\n" +
+	"\x13ForceLogoutResponse\",\n" +
+	"\x11ResetTwoFaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x14\n" +
+	"\x12ResetTwoFaResponse\"6\n" +
+	"\x1bTriggerPasswordResetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x1e\n" +
+	"\x1cTriggerPasswordResetResponse\"\x98\x01\n" +
+	"\x1bSearchSecurityEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\aip_addr\x18\x02 \x01(\tR\x06ipAddr\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x1b\n" +
+	"\tbefore_id\x18\x04 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"o\n" +
+	"\x1cSearchSecurityEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.auth.v1.SecurityEventR\x06events\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\xa6\x01\n" +
+	"\x16GetAdminActionsRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\x03R\aadminId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\x03R\ftargetUserId\x12\x18\n" +
+	"\aactions\x18\x03 \x03(\tR\aactions\x12\x1b\n" +
+	"\tbefore_id\x18\x04 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"j\n" +
+	"\x17GetAdminActionsResponse\x12.\n" +
+	"\aactions\x18\x01 \x03(\v2\x14.auth.v1.AdminActionR\aactions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\x11\n" +
+	"\x0fGetRolesRequest\"7\n" +
+	"\x10GetRolesResponse\x12#\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.auth.v1.RoleR\x05roles\".\n" +
+	"\x13GetUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\";\n" +
+	"\x14GetUserRolesResponse\x12#\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.auth.v1.RoleR\x05roles\"i\n" +
+	"\x0fSaveRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"5\n" +
+	"\x10SaveRoleResponse\x12!\n" +
+	"\x04role\x18\x01 \x01(\v2\r.auth.v1.RoleR\x04role\"'\n" +
+	"\x11DeleteRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x14\n" +
+	"\x12DeleteRoleResponse\"I\n" +
+	"\x11AssignRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\trole_name\x18\x02 \x01(\tR\broleName\"\x14\n" +
+	"\x12AssignRoleResponse\"I\n" +
+	"\x11RevokeRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\trole_name\x18\x02 \x01(\tR\broleName\"\x14\n" +
+	"\x12RevokeRoleResponse\"\x86\x01\n" +
+	"\x18AdminCreateInviteRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x05R\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"X\n" +
+	"\x19AdminCreateInviteResponse\x12'\n" +
+	"\x06invite\x18\x01 \x01(\v2\x0f.auth.v1.InviteR\x06invite\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code2\xb6\t\n" +
+	"\fAdminService\x12H\n" +
+	"\vSearchUsers\x12\x1b.auth.v1.SearchUsersRequest\x1a\x1c.auth.v1.SearchUsersResponse\x12Q\n" +
+	"\x0eGetUserDetails\x12\x1e.auth.v1.GetUserDetailsRequest\x1a\x1f.auth.v1.GetUserDetailsResponse\x12]\n" +
+	"\x12ChangeAccountState\x12\".auth.v1.ChangeAccountStateRequest\x1a#.auth.v1.ChangeAccountStateResponse\x12H\n" +
+	"\vForceLogout\x12\x1b.auth.v1.ForceLogoutRequest\x1a\x1c.auth.v1.ForceLogoutResponse\x12E\n" +
+	"\n" +
+	"ResetTwoFa\x12\x1a.auth.v1.ResetTwoFaRequest\x1a\x1b.auth.v1.ResetTwoFaResponse\x12c\n" +
+	"\x14TriggerPasswordReset\x12$.auth.v1.TriggerPasswordResetRequest\x1a%.auth.v1.TriggerPasswordResetResponse\x12c\n" +
+	"\x14SearchSecurityEvents\x12$.auth.v1.SearchSecurityEventsRequest\x1a%.auth.v1.SearchSecurityEventsResponse\x12T\n" +
+	"\x0fGetAdminActions\x12\x1f.auth.v1.GetAdminActionsRequest\x1a .auth.v1.GetAdminActionsResponse\x12?\n" +
+	"\bGetRoles\x12\x18.auth.v1.GetRolesRequest\x1a\x19.auth.v1.GetRolesResponse\x12K\n" +
+	"\fGetUserRoles\x12\x1c.auth.v1.GetUserRolesRequest\x1a\x1d.auth.v1.GetUserRolesResponse\x12?\n" +
+	"\bSaveRole\x12\x18.auth.v1.SaveRoleRequest\x1a\x19.auth.v1.SaveRoleResponse\x12E\n" +
+	"\n" +
+	"DeleteRole\x12\x1a.auth.v1.DeleteRoleRequest\x1a\x1b.auth.v1.DeleteRoleResponse\x12E\n" +
+	"\n" +
+	"AssignRole\x12\x1a.auth.v1.AssignRoleRequest\x1a\x1b.auth.v1.AssignRoleResponse\x12E\n" +
+	"\n" +
+	"RevokeRole\x12\x1a.auth.v1.RevokeRoleRequest\x1a\x1b.auth.v1.RevokeRoleResponse\x12U\n" +
+	"\fCreateInvite\x12!.auth.v1.AdminCreateInviteRequest\x1a\".auth.v1.AdminCreateInviteResponseBEZCbuf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1;authv1b\x06proto3"
+
+var file_auth_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_auth_v1_admin_proto_goTypes = []any{
+	(*AdminUser)(nil),                    // 0: auth.v1.AdminUser
+	(*AdminAction)(nil),                  // 1: auth.v1.AdminAction
+	(*Role)(nil),                         // 2: auth.v1.Role
+	(*SearchUsersRequest)(nil),           // 3: auth.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),          // 4: auth.v1.SearchUsersResponse
+	(*GetUserDetailsRequest)(nil),        // 5: auth.v1.GetUserDetailsRequest
+	(*GetUserDetailsResponse)(nil),       // 6: auth.v1.GetUserDetailsResponse
+	(*ChangeAccountStateRequest)(nil),    // 7: auth.v1.ChangeAccountStateRequest
+	(*ChangeAccountStateResponse)(nil),   // 8: auth.v1.ChangeAccountStateResponse
+	(*ForceLogoutRequest)(nil),           // 9: auth.v1.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),          // 10: auth.v1.ForceLogoutResponse
+	(*ResetTwoFaRequest)(nil),            // 11: auth.v1.ResetTwoFaRequest
+	(*ResetTwoFaResponse)(nil),           // 12: auth.v1.ResetTwoFaResponse
+	(*TriggerPasswordResetRequest)(nil),  // 13: auth.v1.TriggerPasswordResetRequest
+	(*TriggerPasswordResetResponse)(nil), // 14: auth.v1.TriggerPasswordResetResponse
+	(*SearchSecurityEventsRequest)(nil),  // 15: auth.v1.SearchSecurityEventsRequest
+	(*SearchSecurityEventsResponse)(nil), // 16: auth.v1.SearchSecurityEventsResponse
+	(*GetAdminActionsRequest)(nil),       // 17: auth.v1.GetAdminActionsRequest
+	(*GetAdminActionsResponse)(nil),      // 18: auth.v1.GetAdminActionsResponse
+	(*GetRolesRequest)(nil),              // 19: auth.v1.GetRolesRequest
+	(*GetRolesResponse)(nil),             // 20: auth.v1.GetRolesResponse
+	(*GetUserRolesRequest)(nil),          // 21: auth.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),         // 22: auth.v1.GetUserRolesResponse
+	(*SaveRoleRequest)(nil),              // 23: auth.v1.SaveRoleRequest
+	(*SaveRoleResponse)(nil),             // 24: auth.v1.SaveRoleResponse
+	(*DeleteRoleRequest)(nil),            // 25: auth.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),           // 26: auth.v1.DeleteRoleResponse
+	(*AssignRoleRequest)(nil),            // 27: auth.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),           // 28: auth.v1.AssignRoleResponse
+	(*RevokeRoleRequest)(nil),            // 29: auth.v1.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),           // 30: auth.v1.RevokeRoleResponse
+	(*AdminCreateInviteRequest)(nil),     // 31: auth.v1.AdminCreateInviteRequest
+	(*AdminCreateInviteResponse)(nil),    // 32: auth.v1.AdminCreateInviteResponse
+	nil,                                  // 33: auth.v1.AdminAction.DetailsEntry
+	(*v1.User)(nil),                      // 34: users.v1.User
+	(*timestamppb.Timestamp)(nil),        // 35: google.protobuf.Timestamp
+	(*AuthSession)(nil),                  // 36: auth.v1.AuthSession
+	(*SecurityEvent)(nil),                // 37: auth.v1.SecurityEvent
+	(*Invite)(nil),                       // 38: auth.v1.Invite
+}
+var file_auth_v1_admin_proto_depIdxs = []int32{
+	34, // 0: auth.v1.AdminUser.user:type_name -> users.v1.User
+	35, // 1: auth.v1.AdminUser.state_changed_at:type_name -> google.protobuf.Timestamp
+	35, // 2: auth.v1.AdminUser.state_until:type_name -> google.protobuf.Timestamp
+	33, // 3: auth.v1.AdminAction.details:type_name -> auth.v1.AdminAction.DetailsEntry
+	35, // 4: auth.v1.AdminAction.created_at:type_name -> google.protobuf.Timestamp
+	35, // 5: auth.v1.Role.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: auth.v1.SearchUsersResponse.users:type_name -> auth.v1.AdminUser
+	0,  // 7: auth.v1.GetUserDetailsResponse.user:type_name -> auth.v1.AdminUser
+	36, // 8: auth.v1.GetUserDetailsResponse.sessions:type_name -> auth.v1.AuthSession
+	35, // 9: auth.v1.ChangeAccountStateRequest.until:type_name -> google.protobuf.Timestamp
+	0,  // 10: auth.v1.ChangeAccountStateResponse.user:type_name -> auth.v1.AdminUser
+	37, // 11: auth.v1.SearchSecurityEventsResponse.events:type_name -> auth.v1.SecurityEvent
+	1,  // 12: auth.v1.GetAdminActionsResponse.actions:type_name -> auth.v1.AdminAction
+	2,  // 13: auth.v1.GetRolesResponse.roles:type_name -> auth.v1.Role
+	2,  // 14: auth.v1.GetUserRolesResponse.roles:type_name -> auth.v1.Role
+	2,  // 15: auth.v1.SaveRoleResponse.role:type_name -> auth.v1.Role
+	35, // 16: auth.v1.AdminCreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	38, // 17: auth.v1.AdminCreateInviteResponse.invite:type_name -> auth.v1.Invite
+	3,  // 18: auth.v1.AdminService.SearchUsers:input_type -> auth.v1.SearchUsersRequest
+	5,  // 19: auth.v1.AdminService.GetUserDetails:input_type -> auth.v1.GetUserDetailsRequest
+	7,  // 20: auth.v1.AdminService.ChangeAccountState:input_type -> auth.v1.ChangeAccountStateRequest
+	9,  // 21: auth.v1.AdminService.ForceLogout:input_type -> auth.v1.ForceLogoutRequest
+	11, // 22: auth.v1.AdminService.ResetTwoFa:input_type -> auth.v1.ResetTwoFaRequest
+	13, // 23: auth.v1.AdminService.TriggerPasswordReset:input_type -> auth.v1.TriggerPasswordResetRequest
+	15, // 24: auth.v1.AdminService.SearchSecurityEvents:input_type -> auth.v1.SearchSecurityEventsRequest
+	17, // 25: auth.v1.AdminService.GetAdminActions:input_type -> auth.v1.GetAdminActionsRequest
+	19, // 26: auth.v1.AdminService.GetRoles:input_type -> auth.v1.GetRolesRequest
+	21, // 27: auth.v1.AdminService.GetUserRoles:input_type -> auth.v1.GetUserRolesRequest
+	23, // 28: auth.v1.AdminService.SaveRole:input_type -> auth.v1.SaveRoleRequest
+	25, // 29: auth.v1.AdminService.DeleteRole:input_type -> auth.v1.DeleteRoleRequest
+	27, // 30: auth.v1.AdminService.AssignRole:input_type -> auth.v1.AssignRoleRequest
+	29, // 31: auth.v1.AdminService.RevokeRole:input_type -> auth.v1.RevokeRoleRequest
+	31, // 32: auth.v1.AdminService.CreateInvite:input_type -> auth.v1.AdminCreateInviteRequest
+	4,  // 33: auth.v1.AdminService.SearchUsers:output_type -> auth.v1.SearchUsersResponse
+	6,  // 34: auth.v1.AdminService.GetUserDetails:output_type -> auth.v1.GetUserDetailsResponse
+	8,  // 35: auth.v1.AdminService.ChangeAccountState:output_type -> auth.v1.ChangeAccountStateResponse
+	10, // 36: auth.v1.AdminService.ForceLogout:output_type -> auth.v1.ForceLogoutResponse
+	12, // 37: auth.v1.AdminService.ResetTwoFa:output_type -> auth.v1.ResetTwoFaResponse
+	14, // 38: auth.v1.AdminService.TriggerPasswordReset:output_type -> auth.v1.TriggerPasswordResetResponse
+	16, // 39: auth.v1.AdminService.SearchSecurityEvents:output_type -> auth.v1.SearchSecurityEventsResponse
+	18, // 40: auth.v1.AdminService.GetAdminActions:output_type -> auth.v1.GetAdminActionsResponse
+	20, // 41: auth.v1.AdminService.GetRoles:output_type -> auth.v1.GetRolesResponse
+	22, // 42: auth.v1.AdminService.GetUserRoles:output_type -> auth.v1.GetUserRolesResponse
+	24, // 43: auth.v1.AdminService.SaveRole:output_type -> auth.v1.SaveRoleResponse
+	26, // 44: auth.v1.AdminService.DeleteRole:output_type -> auth.v1.DeleteRoleResponse
+	28, // 45: auth.v1.AdminService.AssignRole:output_type -> auth.v1.AssignRoleResponse
+	30, // 46: auth.v1.AdminService.RevokeRole:output_type -> auth.v1.RevokeRoleResponse
+	32, // 47: auth.v1.AdminService.CreateInvite:output_type -> auth.v1.AdminCreateInviteResponse
+	33, // [33:48] is the sub-list for method output_type
+	18, // [18:33] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_admin_proto_init() }
+func file_auth_v1_admin_proto_init() {
+	if File_auth_v1_admin_proto != nil {
+		return
+	}
+	file_auth_v1_auth_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_admin_proto_rawDesc), len(file_auth_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_admin_proto_goTypes,
+		DependencyIndexes: file_auth_v1_admin_proto_depIdxs,
+		MessageInfos:      file_auth_v1_admin_proto_msgTypes,
+	}.Build()
+	File_auth_v1_admin_proto = out.File
+	file_auth_v1_admin_proto_goTypes = nil
+	file_auth_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,6278 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: auth/v1/auth.proto
+
+//go:build !protoopaque
+
+package authv1
+
+import (
+	v1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/users/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReauthenticateRequest_Method int32
+
+const (
+	ReauthenticateRequest_METHOD_UNSPECIFIED ReauthenticateRequest_Method = 0
+	ReauthenticateRequest_METHOD_PASSWORD    ReauthenticateRequest_Method = 1
+	ReauthenticateRequest_METHOD_TWO_FA      ReauthenticateRequest_Method = 2
+)
+
+// Enum value maps for ReauthenticateRequest_Method.
+var (
+	ReauthenticateRequest_Method_name = map[int32]string{
+		0: "METHOD_UNSPECIFIED",
+		1: "METHOD_PASSWORD",
+		2: "METHOD_TWO_FA",
+	}
+	ReauthenticateRequest_Method_value = map[string]int32{
+		"METHOD_UNSPECIFIED": 0,
+		"METHOD_PASSWORD":    1,
+		"METHOD_TWO_FA":      2,
+	}
+)
+
+func (x ReauthenticateRequest_Method) Enum() *ReauthenticateRequest_Method {
+	p := new(ReauthenticateRequest_Method)
+	*p = x
+	return p
+}
+
+func (x ReauthenticateRequest_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReauthenticateRequest_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (ReauthenticateRequest_Method) Type() protoreflect.EnumType {
+	return &file_auth_v1_auth_proto_enumTypes[0]
+}
+
+func (x ReauthenticateRequest_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type AnswerLoginConfirmationResponse_Answer int32
+
+const (
+	AnswerLoginConfirmationResponse_ANSWER_UNSPECIFIED AnswerLoginConfirmationResponse_Answer = 0
+	// sign in is confirmed by the account owner
+	AnswerLoginConfirmationResponse_ANSWER_APPROVE AnswerLoginConfirmationResponse_Answer = 1
+	// session is revoked along with other sessions and password must be reset
+	AnswerLoginConfirmationResponse_ANSWER_DENY AnswerLoginConfirmationResponse_Answer = 2
+)
+
+// Enum value maps for AnswerLoginConfirmationResponse_Answer.
+var (
+	AnswerLoginConfirmationResponse_Answer_name = map[int32]string{
+		0: "ANSWER_UNSPECIFIED",
+		1: "ANSWER_APPROVE",
+		2: "ANSWER_DENY",
+	}
+	AnswerLoginConfirmationResponse_Answer_value = map[string]int32{
+		"ANSWER_UNSPECIFIED": 0,
+		"ANSWER_APPROVE":     1,
+		"ANSWER_DENY":        2,
+	}
+)
+
+func (x AnswerLoginConfirmationResponse_Answer) Enum() *AnswerLoginConfirmationResponse_Answer {
+	p := new(AnswerLoginConfirmationResponse_Answer)
+	*p = x
+	return p
+}
+
+func (x AnswerLoginConfirmationResponse_Answer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnswerLoginConfirmationResponse_Answer) Descriptor() protoreflect.EnumDescriptor {
+	return file_auth_v1_auth_proto_enumTypes[1].Descriptor()
+}
+
+func (AnswerLoginConfirmationResponse_Answer) Type() protoreflect.EnumType {
+	return &file_auth_v1_auth_proto_enumTypes[1]
+}
+
+func (x AnswerLoginConfirmationResponse_Answer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type SignUpRequest struct {
+	state            protoimpl.MessageState `protogen:"hybrid.v1"`
+	Username         string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password         string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Email            string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	ConfirmationCode string                 `protobuf:"bytes,4,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	IpAddr           string                 `protobuf:"bytes,5,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo       string                 `protobuf:"bytes,6,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	FirstName        string                 `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName         string                 `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	PhotoUrl         string                 `protobuf:"bytes,9,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	AboutMe          string                 `protobuf:"bytes,10,opt,name=about_me,json=aboutMe,proto3" json:"about_me,omitempty"`
+	BirthDate        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	// required if registration is invite-only
+	InviteCode    string `protobuf:"bytes,12,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUpRequest) Reset() {
+	*x = SignUpRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpRequest) ProtoMessage() {}
+
+func (x *SignUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignUpRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetAboutMe() string {
+	if x != nil {
+		return x.AboutMe
+	}
+	return ""
+}
+
+func (x *SignUpRequest) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *SignUpRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *SignUpRequest) SetUsername(v string) {
+	x.Username = v
+}
+
+func (x *SignUpRequest) SetPassword(v string) {
+	x.Password = v
+}
+
+func (x *SignUpRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *SignUpRequest) SetConfirmationCode(v string) {
+	x.ConfirmationCode = v
+}
+
+func (x *SignUpRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *SignUpRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *SignUpRequest) SetFirstName(v string) {
+	x.FirstName = v
+}
+
+func (x *SignUpRequest) SetLastName(v string) {
+	x.LastName = v
+}
+
+func (x *SignUpRequest) SetPhotoUrl(v string) {
+	x.PhotoUrl = v
+}
+
+func (x *SignUpRequest) SetAboutMe(v string) {
+	x.AboutMe = v
+}
+
+func (x *SignUpRequest) SetBirthDate(v *timestamppb.Timestamp) {
+	x.BirthDate = v
+}
+
+func (x *SignUpRequest) SetInviteCode(v string) {
+	x.InviteCode = v
+}
+
+func (x *SignUpRequest) HasBirthDate() bool {
+	if x == nil {
+		return false
+	}
+	return x.BirthDate != nil
+}
+
+func (x *SignUpRequest) ClearBirthDate() {
+	x.BirthDate = nil
+}
+
+type SignUpRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Username         string
+	Password         string
+	Email            string
+	ConfirmationCode string
+	IpAddr           string
+	DeviceInfo       string
+	FirstName        string
+	LastName         string
+	PhotoUrl         string
+	AboutMe          string
+	BirthDate        *timestamppb.Timestamp
+	// required if registration is invite-only
+	InviteCode string
+}
+
+func (b0 SignUpRequest_builder) Build() *SignUpRequest {
+	m0 := &SignUpRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Username = b.Username
+	x.Password = b.Password
+	x.Email = b.Email
+	x.ConfirmationCode = b.ConfirmationCode
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	x.FirstName = b.FirstName
+	x.LastName = b.LastName
+	x.PhotoUrl = b.PhotoUrl
+	x.AboutMe = b.AboutMe
+	x.BirthDate = b.BirthDate
+	x.InviteCode = b.InviteCode
+	return m0
+}
+
+type SignUpResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	User          *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session       *AuthSession           `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUpResponse) Reset() {
+	*x = SignUpResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpResponse) ProtoMessage() {}
+
+func (x *SignUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignUpResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SignUpResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SignUpResponse) SetUser(v *v1.User) {
+	x.User = v
+}
+
+func (x *SignUpResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *SignUpResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.User != nil
+}
+
+func (x *SignUpResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *SignUpResponse) ClearUser() {
+	x.User = nil
+}
+
+func (x *SignUpResponse) ClearSession() {
+	x.Session = nil
+}
+
+type SignUpResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *v1.User
+	Session *AuthSession
+}
+
+func (b0 SignUpResponse_builder) Build() *SignUpResponse {
+	m0 := &SignUpResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.User = b.User
+	x.Session = b.Session
+	return m0
+}
+
+type AuthSession struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsLongLived   bool                   `protobuf:"varint,5,opt,name=is_long_lived,json=isLongLived,proto3" json:"is_long_lived,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	IpAddr        string                 `protobuf:"bytes,7,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo    string                 `protobuf:"bytes,8,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthSession) Reset() {
+	*x = AuthSession{}
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthSession) ProtoMessage() {}
+
+func (x *AuthSession) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AuthSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthSession) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthSession) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *AuthSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuthSession) GetIsLongLived() bool {
+	if x != nil {
+		return x.IsLongLived
+	}
+	return false
+}
+
+func (x *AuthSession) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AuthSession) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *AuthSession) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *AuthSession) SetId(v string) {
+	x.Id = v
+}
+
+func (x *AuthSession) SetUserId(v int64) {
+	x.UserId = v
+}
+
+func (x *AuthSession) SetLastSeenAt(v *timestamppb.Timestamp) {
+	x.LastSeenAt = v
+}
+
+func (x *AuthSession) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *AuthSession) SetIsLongLived(v bool) {
+	x.IsLongLived = v
+}
+
+func (x *AuthSession) SetLocation(v string) {
+	x.Location = v
+}
+
+func (x *AuthSession) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *AuthSession) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *AuthSession) HasLastSeenAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.LastSeenAt != nil
+}
+
+func (x *AuthSession) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *AuthSession) ClearLastSeenAt() {
+	x.LastSeenAt = nil
+}
+
+func (x *AuthSession) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+type AuthSession_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id          string
+	UserId      int64
+	LastSeenAt  *timestamppb.Timestamp
+	CreatedAt   *timestamppb.Timestamp
+	IsLongLived bool
+	Location    string
+	IpAddr      string
+	DeviceInfo  string
+}
+
+func (b0 AuthSession_builder) Build() *AuthSession {
+	m0 := &AuthSession{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.UserId = b.UserId
+	x.LastSeenAt = b.LastSeenAt
+	x.CreatedAt = b.CreatedAt
+	x.IsLongLived = b.IsLongLived
+	x.Location = b.Location
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	return m0
+}
+
+type SignInRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// login can be email or username
+	Login         string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	RememberMe    bool   `protobuf:"varint,3,opt,name=remember_me,json=rememberMe,proto3" json:"remember_me,omitempty"`
+	IpAddr        string `protobuf:"bytes,4,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo    string `protobuf:"bytes,5,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInRequest) Reset() {
+	*x = SignInRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInRequest) ProtoMessage() {}
+
+func (x *SignInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SignInRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SignInRequest) GetRememberMe() bool {
+	if x != nil {
+		return x.RememberMe
+	}
+	return false
+}
+
+func (x *SignInRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *SignInRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *SignInRequest) SetLogin(v string) {
+	x.Login = v
+}
+
+func (x *SignInRequest) SetPassword(v string) {
+	x.Password = v
+}
+
+func (x *SignInRequest) SetRememberMe(v bool) {
+	x.RememberMe = v
+}
+
+func (x *SignInRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *SignInRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+type SignInRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// login can be email or username
+	Login      string
+	Password   string
+	RememberMe bool
+	IpAddr     string
+	DeviceInfo string
+}
+
+func (b0 SignInRequest_builder) Build() *SignInRequest {
+	m0 := &SignInRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Login = b.Login
+	x.Password = b.Password
+	x.RememberMe = b.RememberMe
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	return m0
+}
+
+type SignInResponse struct {
+	state            protoimpl.MessageState `protogen:"hybrid.v1"`
+	User             *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session          *AuthSession           `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	ConfirmationCode string                 `protobuf:"bytes,3,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SignInResponse) Reset() {
+	*x = SignInResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInResponse) ProtoMessage() {}
+
+func (x *SignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *SignInResponse) SetUser(v *v1.User) {
+	x.User = v
+}
+
+func (x *SignInResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *SignInResponse) SetConfirmationCode(v string) {
+	x.ConfirmationCode = v
+}
+
+func (x *SignInResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.User != nil
+}
+
+func (x *SignInResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *SignInResponse) ClearUser() {
+	x.User = nil
+}
+
+func (x *SignInResponse) ClearSession() {
+	x.Session = nil
+}
+
+type SignInResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User             *v1.User
+	Session          *AuthSession
+	ConfirmationCode string
+}
+
+func (b0 SignInResponse_builder) Build() *SignInResponse {
+	m0 := &SignInResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.User = b.User
+	x.Session = b.Session
+	x.ConfirmationCode = b.ConfirmationCode
+	return m0
+}
+
+type PingSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingSessionRequest) Reset() {
+	*x = PingSessionRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingSessionRequest) ProtoMessage() {}
+
+func (x *PingSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type PingSessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 PingSessionRequest_builder) Build() *PingSessionRequest {
+	m0 := &PingSessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type PingSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Session       *AuthSession           `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingSessionResponse) Reset() {
+	*x = PingSessionResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingSessionResponse) ProtoMessage() {}
+
+func (x *PingSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PingSessionResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *PingSessionResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *PingSessionResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *PingSessionResponse) ClearSession() {
+	x.Session = nil
+}
+
+type PingSessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Session *AuthSession
+}
+
+func (b0 PingSessionResponse_builder) Build() *PingSessionResponse {
+	m0 := &PingSessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Session = b.Session
+	return m0
+}
+
+type GetActiveSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveSessionsRequest) Reset() {
+	*x = GetActiveSessionsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveSessionsRequest) ProtoMessage() {}
+
+func (x *GetActiveSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetActiveSessionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetActiveSessionsRequest_builder) Build() *GetActiveSessionsRequest {
+	m0 := &GetActiveSessionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetActiveSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Sessions      []*AuthSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveSessionsResponse) Reset() {
+	*x = GetActiveSessionsResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveSessionsResponse) ProtoMessage() {}
+
+func (x *GetActiveSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetActiveSessionsResponse) GetSessions() []*AuthSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *GetActiveSessionsResponse) SetSessions(v []*AuthSession) {
+	x.Sessions = v
+}
+
+type GetActiveSessionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Sessions []*AuthSession
+}
+
+func (b0 GetActiveSessionsResponse_builder) Build() *GetActiveSessionsResponse {
+	m0 := &GetActiveSessionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Sessions = b.Sessions
+	return m0
+}
+
+type DeleteSessionRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// id of the session to delete, it must belong to the caller
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DeleteSessionRequest) SetSessionId(v string) {
+	x.SessionId = v
+}
+
+type DeleteSessionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// id of the session to delete, it must belong to the caller
+	SessionId string
+}
+
+func (b0 DeleteSessionRequest_builder) Build() *DeleteSessionRequest {
+	m0 := &DeleteSessionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.SessionId = b.SessionId
+	return m0
+}
+
+type DeleteSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionResponse) Reset() {
+	*x = DeleteSessionResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionResponse) ProtoMessage() {}
+
+func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteSessionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteSessionResponse_builder) Build() *DeleteSessionResponse {
+	m0 := &DeleteSessionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type AccessToken struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// beginning of the token which helps to identify it
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessToken) Reset() {
+	*x = AccessToken{}
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessToken) ProtoMessage() {}
+
+func (x *AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AccessToken) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccessToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessToken) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AccessToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AccessToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccessToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *AccessToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AccessToken) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *AccessToken) SetName(v string) {
+	x.Name = v
+}
+
+func (x *AccessToken) SetPrefix(v string) {
+	x.Prefix = v
+}
+
+func (x *AccessToken) SetScopes(v []string) {
+	x.Scopes = v
+}
+
+func (x *AccessToken) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *AccessToken) SetLastUsedAt(v *timestamppb.Timestamp) {
+	x.LastUsedAt = v
+}
+
+func (x *AccessToken) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *AccessToken) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *AccessToken) HasLastUsedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.LastUsedAt != nil
+}
+
+func (x *AccessToken) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *AccessToken) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *AccessToken) ClearLastUsedAt() {
+	x.LastUsedAt = nil
+}
+
+func (x *AccessToken) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type AccessToken_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   int64
+	Name string
+	// beginning of the token which helps to identify it
+	Prefix     string
+	Scopes     []string
+	CreatedAt  *timestamppb.Timestamp
+	LastUsedAt *timestamppb.Timestamp
+	ExpiresAt  *timestamppb.Timestamp
+}
+
+func (b0 AccessToken_builder) Build() *AccessToken {
+	m0 := &AccessToken{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Name = b.Name
+	x.Prefix = b.Prefix
+	x.Scopes = b.Scopes
+	x.CreatedAt = b.CreatedAt
+	x.LastUsedAt = b.LastUsedAt
+	x.ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// profile:read, profile:write, sessions:read, sessions:write or security_events:read
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenRequest) Reset() {
+	*x = CreateAccessTokenRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenRequest) SetName(v string) {
+	x.Name = v
+}
+
+func (x *CreateAccessTokenRequest) SetScopes(v []string) {
+	x.Scopes = v
+}
+
+func (x *CreateAccessTokenRequest) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *CreateAccessTokenRequest) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *CreateAccessTokenRequest) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type CreateAccessTokenRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name string
+	// profile:read, profile:write, sessions:read, sessions:write or security_events:read
+	Scopes    []string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 CreateAccessTokenRequest_builder) Build() *CreateAccessTokenRequest {
+	m0 := &CreateAccessTokenRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Scopes = b.Scopes
+	x.ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateAccessTokenResponse struct {
+	state       protoimpl.MessageState `protogen:"hybrid.v1"`
+	AccessToken *AccessToken           `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// plain token, it is not possible to obtain it again
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenResponse) Reset() {
+	*x = CreateAccessTokenResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenResponse) ProtoMessage() {}
+
+func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateAccessTokenResponse) GetAccessToken() *AccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+func (x *CreateAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenResponse) SetAccessToken(v *AccessToken) {
+	x.AccessToken = v
+}
+
+func (x *CreateAccessTokenResponse) SetToken(v string) {
+	x.Token = v
+}
+
+func (x *CreateAccessTokenResponse) HasAccessToken() bool {
+	if x == nil {
+		return false
+	}
+	return x.AccessToken != nil
+}
+
+func (x *CreateAccessTokenResponse) ClearAccessToken() {
+	x.AccessToken = nil
+}
+
+type CreateAccessTokenResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AccessToken *AccessToken
+	// plain token, it is not possible to obtain it again
+	Token string
+}
+
+func (b0 CreateAccessTokenResponse_builder) Build() *CreateAccessTokenResponse {
+	m0 := &CreateAccessTokenResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.AccessToken = b.AccessToken
+	x.Token = b.Token
+	return m0
+}
+
+type GetAccessTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessTokensRequest) Reset() {
+	*x = GetAccessTokensRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessTokensRequest) ProtoMessage() {}
+
+func (x *GetAccessTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetAccessTokensRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetAccessTokensRequest_builder) Build() *GetAccessTokensRequest {
+	m0 := &GetAccessTokensRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetAccessTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	AccessTokens  []*AccessToken         `protobuf:"bytes,1,rep,name=access_tokens,json=accessTokens,proto3" json:"access_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessTokensResponse) Reset() {
+	*x = GetAccessTokensResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessTokensResponse) ProtoMessage() {}
+
+func (x *GetAccessTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetAccessTokensResponse) GetAccessTokens() []*AccessToken {
+	if x != nil {
+		return x.AccessTokens
+	}
+	return nil
+}
+
+func (x *GetAccessTokensResponse) SetAccessTokens(v []*AccessToken) {
+	x.AccessTokens = v
+}
+
+type GetAccessTokensResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	AccessTokens []*AccessToken
+}
+
+func (b0 GetAccessTokensResponse_builder) Build() *GetAccessTokensResponse {
+	m0 := &GetAccessTokensResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.AccessTokens = b.AccessTokens
+	return m0
+}
+
+type RevokeAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	TokenId       int64                  `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessTokenRequest) Reset() {
+	*x = RevokeAccessTokenRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenRequest) ProtoMessage() {}
+
+func (x *RevokeAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeAccessTokenRequest) GetTokenId() int64 {
+	if x != nil {
+		return x.TokenId
+	}
+	return 0
+}
+
+func (x *RevokeAccessTokenRequest) SetTokenId(v int64) {
+	x.TokenId = v
+}
+
+type RevokeAccessTokenRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TokenId int64
+}
+
+func (b0 RevokeAccessTokenRequest_builder) Build() *RevokeAccessTokenRequest {
+	m0 := &RevokeAccessTokenRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.TokenId = b.TokenId
+	return m0
+}
+
+type RevokeAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccessTokenResponse) Reset() {
+	*x = RevokeAccessTokenResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessTokenResponse) ProtoMessage() {}
+
+func (x *RevokeAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeAccessTokenResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeAccessTokenResponse_builder) Build() *RevokeAccessTokenResponse {
+	m0 := &RevokeAccessTokenResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SecurityEvent struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// zero if action targeted unknown account
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// e.g sign_in_succeeded, two_fa_disabled, session_revoked
+	Type       string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IpAddr     string                 `protobuf:"bytes,5,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo string                 `protobuf:"bytes,6,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	Location   string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	// event specific data e.g failure reason or revoked session id
+	Details       map[string]string `protobuf:"bytes,8,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent) ProtoMessage() {}
+
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecurityEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecurityEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SecurityEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecurityEvent) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *SecurityEvent) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *SecurityEvent) SetUserId(v int64) {
+	x.UserId = v
+}
+
+func (x *SecurityEvent) SetType(v string) {
+	x.Type = v
+}
+
+func (x *SecurityEvent) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *SecurityEvent) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *SecurityEvent) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *SecurityEvent) SetLocation(v string) {
+	x.Location = v
+}
+
+func (x *SecurityEvent) SetDetails(v map[string]string) {
+	x.Details = v
+}
+
+func (x *SecurityEvent) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *SecurityEvent) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+type SecurityEvent_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+	// zero if action targeted unknown account
+	UserId int64
+	// e.g sign_in_succeeded, two_fa_disabled, session_revoked
+	Type       string
+	CreatedAt  *timestamppb.Timestamp
+	IpAddr     string
+	DeviceInfo string
+	Location   string
+	// event specific data e.g failure reason or revoked session id
+	Details map[string]string
+}
+
+func (b0 SecurityEvent_builder) Build() *SecurityEvent {
+	m0 := &SecurityEvent{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.UserId = b.UserId
+	x.Type = b.Type
+	x.CreatedAt = b.CreatedAt
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	x.Location = b.Location
+	x.Details = b.Details
+	return m0
+}
+
+type GetSecurityEventsRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// next_cursor of previous page, zero for the first page
+	BeforeId int64 `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	// page size, default is used if zero
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityEventsRequest) Reset() {
+	*x = GetSecurityEventsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityEventsRequest) ProtoMessage() {}
+
+func (x *GetSecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetSecurityEventsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetSecurityEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSecurityEventsRequest) SetBeforeId(v int64) {
+	x.BeforeId = v
+}
+
+func (x *GetSecurityEventsRequest) SetLimit(v int32) {
+	x.Limit = v
+}
+
+type GetSecurityEventsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// next_cursor of previous page, zero for the first page
+	BeforeId int64
+	// page size, default is used if zero
+	Limit int32
+}
+
+func (b0 GetSecurityEventsRequest_builder) Build() *GetSecurityEventsRequest {
+	m0 := &GetSecurityEventsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.BeforeId = b.BeforeId
+	x.Limit = b.Limit
+	return m0
+}
+
+type GetSecurityEventsResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// events from newest to oldest
+	Events []*SecurityEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// zero if there are no more events
+	NextCursor    int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityEventsResponse) Reset() {
+	*x = GetSecurityEventsResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityEventsResponse) ProtoMessage() {}
+
+func (x *GetSecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetSecurityEventsResponse) GetEvents() []*SecurityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetSecurityEventsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetSecurityEventsResponse) SetEvents(v []*SecurityEvent) {
+	x.Events = v
+}
+
+func (x *GetSecurityEventsResponse) SetNextCursor(v int64) {
+	x.NextCursor = v
+}
+
+type GetSecurityEventsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// events from newest to oldest
+	Events []*SecurityEvent
+	// zero if there are no more events
+	NextCursor int64
+}
+
+func (b0 GetSecurityEventsResponse_builder) Build() *GetSecurityEventsResponse {
+	m0 := &GetSecurityEventsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Events = b.Events
+	x.NextCursor = b.NextCursor
+	return m0
+}
+
+type DeleteAllSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAllSessionsRequest) Reset() {
+	*x = DeleteAllSessionsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAllSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAllSessionsRequest) ProtoMessage() {}
+
+func (x *DeleteAllSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteAllSessionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteAllSessionsRequest_builder) Build() *DeleteAllSessionsRequest {
+	m0 := &DeleteAllSessionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DeleteAllSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAllSessionsResponse) Reset() {
+	*x = DeleteAllSessionsResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAllSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAllSessionsResponse) ProtoMessage() {}
+
+func (x *DeleteAllSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteAllSessionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteAllSessionsResponse_builder) Build() *DeleteAllSessionsResponse {
+	m0 := &DeleteAllSessionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeactivateAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeactivateAccountRequest_builder) Build() *DeactivateAccountRequest {
+	m0 := &DeactivateAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DeactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeactivateAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeactivateAccountResponse_builder) Build() *DeactivateAccountResponse {
+	m0 := &DeactivateAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type Invite struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// visible beginning of the code which helps to tell invites apart
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// the only email which may sign up with the invite, any email may if empty
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Invite) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invite) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Invite) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Invite) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *Invite) SetPrefix(v string) {
+	x.Prefix = v
+}
+
+func (x *Invite) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *Invite) SetMaxUses(v int32) {
+	x.MaxUses = v
+}
+
+func (x *Invite) SetUses(v int32) {
+	x.Uses = v
+}
+
+func (x *Invite) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *Invite) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *Invite) SetRevokedAt(v *timestamppb.Timestamp) {
+	x.RevokedAt = v
+}
+
+func (x *Invite) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *Invite) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *Invite) HasRevokedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.RevokedAt != nil
+}
+
+func (x *Invite) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *Invite) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+func (x *Invite) ClearRevokedAt() {
+	x.RevokedAt = nil
+}
+
+type Invite_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id int64
+	// visible beginning of the code which helps to tell invites apart
+	Prefix string
+	// the only email which may sign up with the invite, any email may if empty
+	Email     string
+	MaxUses   int32
+	Uses      int32
+	CreatedAt *timestamppb.Timestamp
+	ExpiresAt *timestamppb.Timestamp
+	RevokedAt *timestamppb.Timestamp
+}
+
+func (b0 Invite_builder) Build() *Invite {
+	m0 := &Invite{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Prefix = b.Prefix
+	x.Email = b.Email
+	x.MaxUses = b.MaxUses
+	x.Uses = b.Uses
+	x.CreatedAt = b.CreatedAt
+	x.ExpiresAt = b.ExpiresAt
+	x.RevokedAt = b.RevokedAt
+	return m0
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateInviteRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *CreateInviteRequest) SetMaxUses(v int32) {
+	x.MaxUses = v
+}
+
+func (x *CreateInviteRequest) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *CreateInviteRequest) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *CreateInviteRequest) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type CreateInviteRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email     string
+	MaxUses   int32
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 CreateInviteRequest_builder) Build() *CreateInviteRequest {
+	m0 := &CreateInviteRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	x.MaxUses = b.MaxUses
+	x.ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type CreateInviteResponse struct {
+	state  protoimpl.MessageState `protogen:"hybrid.v1"`
+	Invite *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	// plain invite code, it is returned only once
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *CreateInviteResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateInviteResponse) SetInvite(v *Invite) {
+	x.Invite = v
+}
+
+func (x *CreateInviteResponse) SetCode(v string) {
+	x.Code = v
+}
+
+func (x *CreateInviteResponse) HasInvite() bool {
+	if x == nil {
+		return false
+	}
+	return x.Invite != nil
+}
+
+func (x *CreateInviteResponse) ClearInvite() {
+	x.Invite = nil
+}
+
+type CreateInviteResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invite *Invite
+	// plain invite code, it is returned only once
+	Code string
+}
+
+func (b0 CreateInviteResponse_builder) Build() *CreateInviteResponse {
+	m0 := &CreateInviteResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Invite = b.Invite
+	x.Code = b.Code
+	return m0
+}
+
+type GetInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesRequest) Reset() {
+	*x = GetInvitesRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesRequest) ProtoMessage() {}
+
+func (x *GetInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetInvitesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetInvitesRequest_builder) Build() *GetInvitesRequest {
+	m0 := &GetInvitesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitesResponse) Reset() {
+	*x = GetInvitesResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitesResponse) ProtoMessage() {}
+
+func (x *GetInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *GetInvitesResponse) SetInvites(v []*Invite) {
+	x.Invites = v
+}
+
+type GetInvitesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Invites []*Invite
+}
+
+func (b0 GetInvitesResponse_builder) Build() *GetInvitesResponse {
+	m0 := &GetInvitesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Invites = b.Invites
+	return m0
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	InviteId      int64                  `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeInviteRequest) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+func (x *RevokeInviteRequest) SetInviteId(v int64) {
+	x.InviteId = v
+}
+
+type RevokeInviteRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	InviteId int64
+}
+
+func (b0 RevokeInviteRequest_builder) Build() *RevokeInviteRequest {
+	m0 := &RevokeInviteRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.InviteId = b.InviteId
+	return m0
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeInviteResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeInviteResponse_builder) Build() *RevokeInviteResponse {
+	m0 := &RevokeInviteResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionRequest) Reset() {
+	*x = RequestAccountDeletionRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionRequest) ProtoMessage() {}
+
+func (x *RequestAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestAccountDeletionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestAccountDeletionRequest_builder) Build() *RequestAccountDeletionRequest {
+	m0 := &RequestAccountDeletionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestAccountDeletionResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// account is erased at this time unless deletion is cancelled
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionResponse) Reset() {
+	*x = RequestAccountDeletionResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionResponse) ProtoMessage() {}
+
+func (x *RequestAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestAccountDeletionResponse) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *RequestAccountDeletionResponse) SetScheduledAt(v *timestamppb.Timestamp) {
+	x.ScheduledAt = v
+}
+
+func (x *RequestAccountDeletionResponse) HasScheduledAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ScheduledAt != nil
+}
+
+func (x *RequestAccountDeletionResponse) ClearScheduledAt() {
+	x.ScheduledAt = nil
+}
+
+type RequestAccountDeletionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// account is erased at this time unless deletion is cancelled
+	ScheduledAt *timestamppb.Timestamp
+}
+
+func (b0 RequestAccountDeletionResponse_builder) Build() *RequestAccountDeletionResponse {
+	m0 := &RequestAccountDeletionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.ScheduledAt = b.ScheduledAt
+	return m0
+}
+
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type CancelAccountDeletionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 CancelAccountDeletionRequest_builder) Build() *CancelAccountDeletionRequest {
+	m0 := &CancelAccountDeletionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CancelAccountDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionResponse) Reset() {
+	*x = CancelAccountDeletionResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type CancelAccountDeletionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 CancelAccountDeletionResponse_builder) Build() *CancelAccountDeletionResponse {
+	m0 := &CancelAccountDeletionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DisableTwoFaRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFaRequest) Reset() {
+	*x = DisableTwoFaRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFaRequest) ProtoMessage() {}
+
+func (x *DisableTwoFaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DisableTwoFaRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DisableTwoFaRequest_builder) Build() *DisableTwoFaRequest {
+	m0 := &DisableTwoFaRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DisableTwoFaResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFaResponse) Reset() {
+	*x = DisableTwoFaResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFaResponse) ProtoMessage() {}
+
+func (x *DisableTwoFaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DisableTwoFaResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DisableTwoFaResponse_builder) Build() *DisableTwoFaResponse {
+	m0 := &DisableTwoFaResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestReauthenticationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReauthenticationCodeRequest) Reset() {
+	*x = RequestReauthenticationCodeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReauthenticationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReauthenticationCodeRequest) ProtoMessage() {}
+
+func (x *RequestReauthenticationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestReauthenticationCodeRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestReauthenticationCodeRequest_builder) Build() *RequestReauthenticationCodeRequest {
+	m0 := &RequestReauthenticationCodeRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestReauthenticationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReauthenticationCodeResponse) Reset() {
+	*x = RequestReauthenticationCodeResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReauthenticationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReauthenticationCodeResponse) ProtoMessage() {}
+
+func (x *RequestReauthenticationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestReauthenticationCodeResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestReauthenticationCodeResponse_builder) Build() *RequestReauthenticationCodeResponse {
+	m0 := &RequestReauthenticationCodeResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ReauthenticateRequest struct {
+	state  protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Method ReauthenticateRequest_Method `protobuf:"varint,1,opt,name=method,proto3,enum=auth.v1.ReauthenticateRequest_Method" json:"method,omitempty"`
+	// required if password method is used
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// 2FA code, obtained with RequestReauthenticationCode unless TOTP app is used
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateRequest) Reset() {
+	*x = ReauthenticateRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateRequest) ProtoMessage() {}
+
+func (x *ReauthenticateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReauthenticateRequest) GetMethod() ReauthenticateRequest_Method {
+	if x != nil {
+		return x.Method
+	}
+	return ReauthenticateRequest_METHOD_UNSPECIFIED
+}
+
+func (x *ReauthenticateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReauthenticateRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReauthenticateRequest) SetMethod(v ReauthenticateRequest_Method) {
+	x.Method = v
+}
+
+func (x *ReauthenticateRequest) SetPassword(v string) {
+	x.Password = v
+}
+
+func (x *ReauthenticateRequest) SetCode(v string) {
+	x.Code = v
+}
+
+type ReauthenticateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Method ReauthenticateRequest_Method
+	// required if password method is used
+	Password string
+	// 2FA code, obtained with RequestReauthenticationCode unless TOTP app is used
+	Code string
+}
+
+func (b0 ReauthenticateRequest_builder) Build() *ReauthenticateRequest {
+	m0 := &ReauthenticateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Method = b.Method
+	x.Password = b.Password
+	x.Code = b.Code
+	return m0
+}
+
+type ReauthenticateResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Session       *AuthSession           `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateResponse) Reset() {
+	*x = ReauthenticateResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateResponse) ProtoMessage() {}
+
+func (x *ReauthenticateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReauthenticateResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *ReauthenticateResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *ReauthenticateResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *ReauthenticateResponse) ClearSession() {
+	x.Session = nil
+}
+
+type ReauthenticateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Session *AuthSession
+}
+
+func (b0 ReauthenticateResponse_builder) Build() *ReauthenticateResponse {
+	m0 := &ReauthenticateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Session = b.Session
+	return m0
+}
+
+type AnswerLoginConfirmationRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// token from "Was this you?" link of new device sign in email
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerLoginConfirmationRequest) Reset() {
+	*x = AnswerLoginConfirmationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerLoginConfirmationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerLoginConfirmationRequest) ProtoMessage() {}
+
+func (x *AnswerLoginConfirmationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AnswerLoginConfirmationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AnswerLoginConfirmationRequest) SetToken(v string) {
+	x.Token = v
+}
+
+type AnswerLoginConfirmationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// token from "Was this you?" link of new device sign in email
+	Token string
+}
+
+func (b0 AnswerLoginConfirmationRequest_builder) Build() *AnswerLoginConfirmationRequest {
+	m0 := &AnswerLoginConfirmationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Token = b.Token
+	return m0
+}
+
+type AnswerLoginConfirmationResponse struct {
+	state         protoimpl.MessageState                 `protogen:"hybrid.v1"`
+	Answer        AnswerLoginConfirmationResponse_Answer `protobuf:"varint,1,opt,name=answer,proto3,enum=auth.v1.AnswerLoginConfirmationResponse_Answer" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerLoginConfirmationResponse) Reset() {
+	*x = AnswerLoginConfirmationResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerLoginConfirmationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerLoginConfirmationResponse) ProtoMessage() {}
+
+func (x *AnswerLoginConfirmationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AnswerLoginConfirmationResponse) GetAnswer() AnswerLoginConfirmationResponse_Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return AnswerLoginConfirmationResponse_ANSWER_UNSPECIFIED
+}
+
+func (x *AnswerLoginConfirmationResponse) SetAnswer(v AnswerLoginConfirmationResponse_Answer) {
+	x.Answer = v
+}
+
+type AnswerLoginConfirmationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Answer AnswerLoginConfirmationResponse_Answer
+}
+
+func (b0 AnswerLoginConfirmationResponse_builder) Build() *AnswerLoginConfirmationResponse {
+	m0 := &AnswerLoginConfirmationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Answer = b.Answer
+	return m0
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+type RequestPasswordResetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+}
+
+func (b0 RequestPasswordResetRequest_builder) Build() *RequestPasswordResetRequest {
+	m0 := &RequestPasswordResetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	return m0
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestPasswordResetResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestPasswordResetResponse_builder) Build() *RequestPasswordResetResponse {
+	m0 := &RequestPasswordResetResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// code sent to user's email by RequestPasswordReset
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	NewPassword   string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ResetPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *ResetPasswordRequest) SetCode(v string) {
+	x.Code = v
+}
+
+func (x *ResetPasswordRequest) SetNewPassword(v string) {
+	x.NewPassword = v
+}
+
+type ResetPasswordRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+	// code sent to user's email by RequestPasswordReset
+	Code        string
+	NewPassword string
+}
+
+func (b0 ResetPasswordRequest_builder) Build() *ResetPasswordRequest {
+	m0 := &ResetPasswordRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	x.Code = b.Code
+	x.NewPassword = b.NewPassword
+	return m0
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ResetPasswordResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ResetPasswordResponse_builder) Build() *ResetPasswordResponse {
+	m0 := &ResetPasswordResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type DataExport struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pending, ready, failed or expired
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_auth_v1_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DataExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataExport) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DataExport) SetId(v string) {
+	x.Id = v
+}
+
+func (x *DataExport) SetStatus(v string) {
+	x.Status = v
+}
+
+func (x *DataExport) SetRequestedAt(v *timestamppb.Timestamp) {
+	x.RequestedAt = v
+}
+
+func (x *DataExport) SetCompletedAt(v *timestamppb.Timestamp) {
+	x.CompletedAt = v
+}
+
+func (x *DataExport) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *DataExport) HasRequestedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.RequestedAt != nil
+}
+
+func (x *DataExport) HasCompletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CompletedAt != nil
+}
+
+func (x *DataExport) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *DataExport) ClearRequestedAt() {
+	x.RequestedAt = nil
+}
+
+func (x *DataExport) ClearCompletedAt() {
+	x.CompletedAt = nil
+}
+
+func (x *DataExport) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type DataExport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+	// pending, ready, failed or expired
+	Status      string
+	RequestedAt *timestamppb.Timestamp
+	CompletedAt *timestamppb.Timestamp
+	ExpiresAt   *timestamppb.Timestamp
+}
+
+func (b0 DataExport_builder) Build() *DataExport {
+	m0 := &DataExport{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Status = b.Status
+	x.RequestedAt = b.RequestedAt
+	x.CompletedAt = b.CompletedAt
+	x.ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestDataExportRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestDataExportRequest_builder) Build() *RequestDataExportRequest {
+	m0 := &RequestDataExportRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DataExport    *DataExport            `protobuf:"bytes,1,opt,name=data_export,json=dataExport,proto3" json:"data_export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportResponse) ProtoMessage() {}
+
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestDataExportResponse) GetDataExport() *DataExport {
+	if x != nil {
+		return x.DataExport
+	}
+	return nil
+}
+
+func (x *RequestDataExportResponse) SetDataExport(v *DataExport) {
+	x.DataExport = v
+}
+
+func (x *RequestDataExportResponse) HasDataExport() bool {
+	if x == nil {
+		return false
+	}
+	return x.DataExport != nil
+}
+
+func (x *RequestDataExportResponse) ClearDataExport() {
+	x.DataExport = nil
+}
+
+type RequestDataExportResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DataExport *DataExport
+}
+
+func (b0 RequestDataExportResponse_builder) Build() *RequestDataExportResponse {
+	m0 := &RequestDataExportResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DataExport = b.DataExport
+	return m0
+}
+
+type DownloadDataExportRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// token from the link of data export ready email
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadDataExportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DownloadDataExportRequest) SetToken(v string) {
+	x.Token = v
+}
+
+type DownloadDataExportRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// token from the link of data export ready email
+	Token string
+}
+
+func (b0 DownloadDataExportRequest_builder) Build() *DownloadDataExportRequest {
+	m0 := &DownloadDataExportRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Token = b.Token
+	return m0
+}
+
+type DownloadDataExportResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// next part of zip archive
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportResponse) Reset() {
+	*x = DownloadDataExportResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportResponse) ProtoMessage() {}
+
+func (x *DownloadDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadDataExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *DownloadDataExportResponse) SetChunk(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Chunk = v
+}
+
+type DownloadDataExportResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// next part of zip archive
+	Chunk []byte
+}
+
+func (b0 DownloadDataExportResponse_builder) Build() *DownloadDataExportResponse {
+	m0 := &DownloadDataExportResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Chunk = b.Chunk
+	return m0
+}
+
+type RequestAccountReactivationRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountReactivationRequest) Reset() {
+	*x = RequestAccountReactivationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountReactivationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountReactivationRequest) ProtoMessage() {}
+
+func (x *RequestAccountReactivationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestAccountReactivationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RequestAccountReactivationRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+type RequestAccountReactivationRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+}
+
+func (b0 RequestAccountReactivationRequest_builder) Build() *RequestAccountReactivationRequest {
+	m0 := &RequestAccountReactivationRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	return m0
+}
+
+type RequestAccountReactivationResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountReactivationResponse) Reset() {
+	*x = RequestAccountReactivationResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountReactivationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountReactivationResponse) ProtoMessage() {}
+
+func (x *RequestAccountReactivationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestAccountReactivationResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestAccountReactivationResponse_builder) Build() *RequestAccountReactivationResponse {
+	m0 := &RequestAccountReactivationResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ReactivateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// code sent to user's email by RequestAccountReactivation
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountRequest) ProtoMessage() {}
+
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReactivateAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReactivateAccountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReactivateAccountRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *ReactivateAccountRequest) SetCode(v string) {
+	x.Code = v
+}
+
+type ReactivateAccountRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+	// code sent to user's email by RequestAccountReactivation
+	Code string
+}
+
+func (b0 ReactivateAccountRequest_builder) Build() *ReactivateAccountRequest {
+	m0 := &ReactivateAccountRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	x.Code = b.Code
+	return m0
+}
+
+type ReactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountResponse) ProtoMessage() {}
+
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ReactivateAccountResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ReactivateAccountResponse_builder) Build() *ReactivateAccountResponse {
+	m0 := &ReactivateAccountResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RequestMagicLinkRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// identifies client which requested the link, only this client may complete sign in
+	ClientId      string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	IpAddr        string `protobuf:"bytes,3,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo    string `protobuf:"bytes,4,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkRequest) ProtoMessage() {}
+
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RequestMagicLinkRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RequestMagicLinkRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RequestMagicLinkRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *RequestMagicLinkRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *RequestMagicLinkRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *RequestMagicLinkRequest) SetClientId(v string) {
+	x.ClientId = v
+}
+
+func (x *RequestMagicLinkRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *RequestMagicLinkRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+type RequestMagicLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Email string
+	// identifies client which requested the link, only this client may complete sign in
+	ClientId   string
+	IpAddr     string
+	DeviceInfo string
+}
+
+func (b0 RequestMagicLinkRequest_builder) Build() *RequestMagicLinkRequest {
+	m0 := &RequestMagicLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Email = b.Email
+	x.ClientId = b.ClientId
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	return m0
+}
+
+type RequestMagicLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkResponse) Reset() {
+	*x = RequestMagicLinkResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkResponse) ProtoMessage() {}
+
+func (x *RequestMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RequestMagicLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RequestMagicLinkResponse_builder) Build() *RequestMagicLinkResponse {
+	m0 := &RequestMagicLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SignInWithMagicLinkRequest struct {
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	ClientId string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// either token from emailed link or emailed code is required
+	Token      string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Code       string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	RememberMe bool   `protobuf:"varint,4,opt,name=remember_me,json=rememberMe,proto3" json:"remember_me,omitempty"`
+	IpAddr     string `protobuf:"bytes,5,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo string `protobuf:"bytes,6,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	// token obtained after verifying 2FA on trusted device allows to skip 2FA
+	TrustedDeviceToken string `protobuf:"bytes,7,opt,name=trusted_device_token,json=trustedDeviceToken,proto3" json:"trusted_device_token,omitempty"`
+	// optionally binds created session to client's key
+	PublicKey     string `protobuf:"bytes,8,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInWithMagicLinkRequest) Reset() {
+	*x = SignInWithMagicLinkRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithMagicLinkRequest) ProtoMessage() {}
+
+func (x *SignInWithMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInWithMagicLinkRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetRememberMe() bool {
+	if x != nil {
+		return x.RememberMe
+	}
+	return false
+}
+
+func (x *SignInWithMagicLinkRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetTrustedDeviceToken() string {
+	if x != nil {
+		return x.TrustedDeviceToken
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkRequest) SetClientId(v string) {
+	x.ClientId = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetToken(v string) {
+	x.Token = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetCode(v string) {
+	x.Code = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetRememberMe(v bool) {
+	x.RememberMe = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetTrustedDeviceToken(v string) {
+	x.TrustedDeviceToken = v
+}
+
+func (x *SignInWithMagicLinkRequest) SetPublicKey(v string) {
+	x.PublicKey = v
+}
+
+type SignInWithMagicLinkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	ClientId string
+	// either token from emailed link or emailed code is required
+	Token      string
+	Code       string
+	RememberMe bool
+	IpAddr     string
+	DeviceInfo string
+	// token obtained after verifying 2FA on trusted device allows to skip 2FA
+	TrustedDeviceToken string
+	// optionally binds created session to client's key
+	PublicKey string
+}
+
+func (b0 SignInWithMagicLinkRequest_builder) Build() *SignInWithMagicLinkRequest {
+	m0 := &SignInWithMagicLinkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.ClientId = b.ClientId
+	x.Token = b.Token
+	x.Code = b.Code
+	x.RememberMe = b.RememberMe
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	x.TrustedDeviceToken = b.TrustedDeviceToken
+	x.PublicKey = b.PublicKey
+	return m0
+}
+
+type VerifyTwoFaRequest struct {
+	state  protoimpl.MessageState       `protogen:"hybrid.v1"`
+	Method v1.TwoFactorAuth_TwoFaMethod `protobuf:"varint,1,opt,name=method,proto3,enum=users.v1.TwoFactorAuth_TwoFaMethod" json:"method,omitempty"`
+	Email  string                       `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// code sent through user's 2FA channel or generated by TOTP app
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// confirmation code returned by sign in, required only if TOTP app is used
+	SignInConfirmationCode string `protobuf:"bytes,4,opt,name=sign_in_confirmation_code,json=signInConfirmationCode,proto3" json:"sign_in_confirmation_code,omitempty"`
+	RememberMe             bool   `protobuf:"varint,5,opt,name=remember_me,json=rememberMe,proto3" json:"remember_me,omitempty"`
+	IpAddr                 string `protobuf:"bytes,6,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo             string `protobuf:"bytes,7,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	// issues token allowing to skip 2FA on this device next time
+	TrustDevice bool `protobuf:"varint,8,opt,name=trust_device,json=trustDevice,proto3" json:"trust_device,omitempty"`
+	// optionally binds created session to client's key
+	PublicKey     string `protobuf:"bytes,9,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFaRequest) Reset() {
+	*x = VerifyTwoFaRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFaRequest) ProtoMessage() {}
+
+func (x *VerifyTwoFaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyTwoFaRequest) GetMethod() v1.TwoFactorAuth_TwoFaMethod {
+	if x != nil {
+		return x.Method
+	}
+	return v1.TwoFactorAuth_TwoFaMethod(0)
+}
+
+func (x *VerifyTwoFaRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) GetSignInConfirmationCode() string {
+	if x != nil {
+		return x.SignInConfirmationCode
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) GetRememberMe() bool {
+	if x != nil {
+		return x.RememberMe
+	}
+	return false
+}
+
+func (x *VerifyTwoFaRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) GetTrustDevice() bool {
+	if x != nil {
+		return x.TrustDevice
+	}
+	return false
+}
+
+func (x *VerifyTwoFaRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaRequest) SetMethod(v v1.TwoFactorAuth_TwoFaMethod) {
+	x.Method = v
+}
+
+func (x *VerifyTwoFaRequest) SetEmail(v string) {
+	x.Email = v
+}
+
+func (x *VerifyTwoFaRequest) SetCode(v string) {
+	x.Code = v
+}
+
+func (x *VerifyTwoFaRequest) SetSignInConfirmationCode(v string) {
+	x.SignInConfirmationCode = v
+}
+
+func (x *VerifyTwoFaRequest) SetRememberMe(v bool) {
+	x.RememberMe = v
+}
+
+func (x *VerifyTwoFaRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *VerifyTwoFaRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *VerifyTwoFaRequest) SetTrustDevice(v bool) {
+	x.TrustDevice = v
+}
+
+func (x *VerifyTwoFaRequest) SetPublicKey(v string) {
+	x.PublicKey = v
+}
+
+type VerifyTwoFaRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Method v1.TwoFactorAuth_TwoFaMethod
+	Email  string
+	// code sent through user's 2FA channel or generated by TOTP app
+	Code string
+	// confirmation code returned by sign in, required only if TOTP app is used
+	SignInConfirmationCode string
+	RememberMe             bool
+	IpAddr                 string
+	DeviceInfo             string
+	// issues token allowing to skip 2FA on this device next time
+	TrustDevice bool
+	// optionally binds created session to client's key
+	PublicKey string
+}
+
+func (b0 VerifyTwoFaRequest_builder) Build() *VerifyTwoFaRequest {
+	m0 := &VerifyTwoFaRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Method = b.Method
+	x.Email = b.Email
+	x.Code = b.Code
+	x.SignInConfirmationCode = b.SignInConfirmationCode
+	x.RememberMe = b.RememberMe
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	x.TrustDevice = b.TrustDevice
+	x.PublicKey = b.PublicKey
+	return m0
+}
+
+type VerifyTwoFaResponse struct {
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	Session *AuthSession           `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// present only if device trust was requested, it should be passed to sign in from the same device
+	TrustedDeviceToken string `protobuf:"bytes,2,opt,name=trusted_device_token,json=trustedDeviceToken,proto3" json:"trusted_device_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFaResponse) Reset() {
+	*x = VerifyTwoFaResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFaResponse) ProtoMessage() {}
+
+func (x *VerifyTwoFaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyTwoFaResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *VerifyTwoFaResponse) GetTrustedDeviceToken() string {
+	if x != nil {
+		return x.TrustedDeviceToken
+	}
+	return ""
+}
+
+func (x *VerifyTwoFaResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *VerifyTwoFaResponse) SetTrustedDeviceToken(v string) {
+	x.TrustedDeviceToken = v
+}
+
+func (x *VerifyTwoFaResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *VerifyTwoFaResponse) ClearSession() {
+	x.Session = nil
+}
+
+type VerifyTwoFaResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Session *AuthSession
+	// present only if device trust was requested, it should be passed to sign in from the same device
+	TrustedDeviceToken string
+}
+
+func (b0 VerifyTwoFaResponse_builder) Build() *VerifyTwoFaResponse {
+	m0 := &VerifyTwoFaResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Session = b.Session
+	x.TrustedDeviceToken = b.TrustedDeviceToken
+	return m0
+}
+
+// device which may skip 2FA on sign in
+type TrustedDevice struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrustedDevice) Reset() {
+	*x = TrustedDevice{}
+	mi := &file_auth_v1_auth_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustedDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedDevice) ProtoMessage() {}
+
+func (x *TrustedDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrustedDevice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrustedDevice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TrustedDevice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TrustedDevice) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *TrustedDevice) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *TrustedDevice) SetId(v int64) {
+	x.Id = v
+}
+
+func (x *TrustedDevice) SetLabel(v string) {
+	x.Label = v
+}
+
+func (x *TrustedDevice) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *TrustedDevice) SetLastUsedAt(v *timestamppb.Timestamp) {
+	x.LastUsedAt = v
+}
+
+func (x *TrustedDevice) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *TrustedDevice) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *TrustedDevice) HasLastUsedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.LastUsedAt != nil
+}
+
+func (x *TrustedDevice) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *TrustedDevice) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+func (x *TrustedDevice) ClearLastUsedAt() {
+	x.LastUsedAt = nil
+}
+
+func (x *TrustedDevice) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type TrustedDevice_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id         int64
+	Label      string
+	CreatedAt  *timestamppb.Timestamp
+	LastUsedAt *timestamppb.Timestamp
+	ExpiresAt  *timestamppb.Timestamp
+}
+
+func (b0 TrustedDevice_builder) Build() *TrustedDevice {
+	m0 := &TrustedDevice{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Label = b.Label
+	x.CreatedAt = b.CreatedAt
+	x.LastUsedAt = b.LastUsedAt
+	x.ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type GetTrustedDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrustedDevicesRequest) Reset() {
+	*x = GetTrustedDevicesRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrustedDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedDevicesRequest) ProtoMessage() {}
+
+func (x *GetTrustedDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetTrustedDevicesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetTrustedDevicesRequest_builder) Build() *GetTrustedDevicesRequest {
+	m0 := &GetTrustedDevicesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetTrustedDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Devices       []*TrustedDevice       `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrustedDevicesResponse) Reset() {
+	*x = GetTrustedDevicesResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrustedDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrustedDevicesResponse) ProtoMessage() {}
+
+func (x *GetTrustedDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTrustedDevicesResponse) GetDevices() []*TrustedDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetTrustedDevicesResponse) SetDevices(v []*TrustedDevice) {
+	x.Devices = v
+}
+
+type GetTrustedDevicesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Devices []*TrustedDevice
+}
+
+func (b0 GetTrustedDevicesResponse_builder) Build() *GetTrustedDevicesResponse {
+	m0 := &GetTrustedDevicesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Devices = b.Devices
+	return m0
+}
+
+type RevokeTrustedDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId      int64                  `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTrustedDeviceRequest) Reset() {
+	*x = RevokeTrustedDeviceRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTrustedDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTrustedDeviceRequest) ProtoMessage() {}
+
+func (x *RevokeTrustedDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeTrustedDeviceRequest) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *RevokeTrustedDeviceRequest) SetDeviceId(v int64) {
+	x.DeviceId = v
+}
+
+type RevokeTrustedDeviceRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId int64
+}
+
+func (b0 RevokeTrustedDeviceRequest_builder) Build() *RevokeTrustedDeviceRequest {
+	m0 := &RevokeTrustedDeviceRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	return m0
+}
+
+type RevokeTrustedDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTrustedDeviceResponse) Reset() {
+	*x = RevokeTrustedDeviceResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTrustedDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTrustedDeviceResponse) ProtoMessage() {}
+
+func (x *RevokeTrustedDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeTrustedDeviceResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeTrustedDeviceResponse_builder) Build() *RevokeTrustedDeviceResponse {
+	m0 := &RevokeTrustedDeviceResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RevokeAllTrustedDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllTrustedDevicesRequest) Reset() {
+	*x = RevokeAllTrustedDevicesRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllTrustedDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllTrustedDevicesRequest) ProtoMessage() {}
+
+func (x *RevokeAllTrustedDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeAllTrustedDevicesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeAllTrustedDevicesRequest_builder) Build() *RevokeAllTrustedDevicesRequest {
+	m0 := &RevokeAllTrustedDevicesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type RevokeAllTrustedDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllTrustedDevicesResponse) Reset() {
+	*x = RevokeAllTrustedDevicesResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllTrustedDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllTrustedDevicesResponse) ProtoMessage() {}
+
+func (x *RevokeAllTrustedDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RevokeAllTrustedDevicesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RevokeAllTrustedDevicesResponse_builder) Build() *RevokeAllTrustedDevicesResponse {
+	m0 := &RevokeAllTrustedDevicesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type WatchQRLoginRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// identifies client which displays QR code, only this client receives created session
+	ClientId   string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	IpAddr     string `protobuf:"bytes,2,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	DeviceInfo string `protobuf:"bytes,3,opt,name=device_info,json=deviceInfo,proto3" json:"device_info,omitempty"`
+	// png or svg, token url is rendered as QR code image within every token update if set
+	QrCodeFormat  string `protobuf:"bytes,4,opt,name=qr_code_format,json=qrCodeFormat,proto3" json:"qr_code_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQRLoginRequest) Reset() {
+	*x = WatchQRLoginRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQRLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQRLoginRequest) ProtoMessage() {}
+
+func (x *WatchQRLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WatchQRLoginRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *WatchQRLoginRequest) GetIpAddr() string {
+	if x != nil {
+		return x.IpAddr
+	}
+	return ""
+}
+
+func (x *WatchQRLoginRequest) GetDeviceInfo() string {
+	if x != nil {
+		return x.DeviceInfo
+	}
+	return ""
+}
+
+func (x *WatchQRLoginRequest) GetQrCodeFormat() string {
+	if x != nil {
+		return x.QrCodeFormat
+	}
+	return ""
+}
+
+func (x *WatchQRLoginRequest) SetClientId(v string) {
+	x.ClientId = v
+}
+
+func (x *WatchQRLoginRequest) SetIpAddr(v string) {
+	x.IpAddr = v
+}
+
+func (x *WatchQRLoginRequest) SetDeviceInfo(v string) {
+	x.DeviceInfo = v
+}
+
+func (x *WatchQRLoginRequest) SetQrCodeFormat(v string) {
+	x.QrCodeFormat = v
+}
+
+type WatchQRLoginRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// identifies client which displays QR code, only this client receives created session
+	ClientId   string
+	IpAddr     string
+	DeviceInfo string
+	// png or svg, token url is rendered as QR code image within every token update if set
+	QrCodeFormat string
+}
+
+func (b0 WatchQRLoginRequest_builder) Build() *WatchQRLoginRequest {
+	m0 := &WatchQRLoginRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.ClientId = b.ClientId
+	x.IpAddr = b.IpAddr
+	x.DeviceInfo = b.DeviceInfo
+	x.QrCodeFormat = b.QrCodeFormat
+	return m0
+}
+
+// token which must replace displayed QR code before it expires
+type QRLoginToken struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	QrCode        []byte                 `protobuf:"bytes,4,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QRLoginToken) Reset() {
+	*x = QRLoginToken{}
+	mi := &file_auth_v1_auth_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRLoginToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRLoginToken) ProtoMessage() {}
+
+func (x *QRLoginToken) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QRLoginToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *QRLoginToken) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *QRLoginToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *QRLoginToken) GetQrCode() []byte {
+	if x != nil {
+		return x.QrCode
+	}
+	return nil
+}
+
+func (x *QRLoginToken) SetToken(v string) {
+	x.Token = v
+}
+
+func (x *QRLoginToken) SetUrl(v string) {
+	x.Url = v
+}
+
+func (x *QRLoginToken) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.ExpiresAt = v
+}
+
+func (x *QRLoginToken) SetQrCode(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.QrCode = v
+}
+
+func (x *QRLoginToken) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExpiresAt != nil
+}
+
+func (x *QRLoginToken) ClearExpiresAt() {
+	x.ExpiresAt = nil
+}
+
+type QRLoginToken_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Token     string
+	Url       string
+	ExpiresAt *timestamppb.Timestamp
+	QrCode    []byte
+}
+
+func (b0 QRLoginToken_builder) Build() *QRLoginToken {
+	m0 := &QRLoginToken{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Token = b.Token
+	x.Url = b.Url
+	x.ExpiresAt = b.ExpiresAt
+	x.QrCode = b.QrCode
+	return m0
+}
+
+type WatchQRLoginResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*WatchQRLoginResponse_Token
+	//	*WatchQRLoginResponse_Session
+	Update        isWatchQRLoginResponse_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQRLoginResponse) Reset() {
+	*x = WatchQRLoginResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQRLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQRLoginResponse) ProtoMessage() {}
+
+func (x *WatchQRLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WatchQRLoginResponse) GetUpdate() isWatchQRLoginResponse_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *WatchQRLoginResponse) GetToken() *QRLoginToken {
+	if x != nil {
+		if x, ok := x.Update.(*WatchQRLoginResponse_Token); ok {
+			return x.Token
+		}
+	}
+	return nil
+}
+
+func (x *WatchQRLoginResponse) GetSession() *AuthSession {
+	if x != nil {
+		if x, ok := x.Update.(*WatchQRLoginResponse_Session); ok {
+			return x.Session
+		}
+	}
+	return nil
+}
+
+func (x *WatchQRLoginResponse) SetToken(v *QRLoginToken) {
+	if v == nil {
+		x.Update = nil
+		return
+	}
+	x.Update = &WatchQRLoginResponse_Token{v}
+}
+
+func (x *WatchQRLoginResponse) SetSession(v *AuthSession) {
+	if v == nil {
+		x.Update = nil
+		return
+	}
+	x.Update = &WatchQRLoginResponse_Session{v}
+}
+
+func (x *WatchQRLoginResponse) HasUpdate() bool {
+	if x == nil {
+		return false
+	}
+	return x.Update != nil
+}
+
+func (x *WatchQRLoginResponse) HasToken() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Update.(*WatchQRLoginResponse_Token)
+	return ok
+}
+
+func (x *WatchQRLoginResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Update.(*WatchQRLoginResponse_Session)
+	return ok
+}
+
+func (x *WatchQRLoginResponse) ClearUpdate() {
+	x.Update = nil
+}
+
+func (x *WatchQRLoginResponse) ClearToken() {
+	if _, ok := x.Update.(*WatchQRLoginResponse_Token); ok {
+		x.Update = nil
+	}
+}
+
+func (x *WatchQRLoginResponse) ClearSession() {
+	if _, ok := x.Update.(*WatchQRLoginResponse_Session); ok {
+		x.Update = nil
+	}
+}
+
+const WatchQRLoginResponse_Update_not_set_case case_WatchQRLoginResponse_Update = 0
+const WatchQRLoginResponse_Token_case case_WatchQRLoginResponse_Update = 1
+const WatchQRLoginResponse_Session_case case_WatchQRLoginResponse_Update = 2
+
+func (x *WatchQRLoginResponse) WhichUpdate() case_WatchQRLoginResponse_Update {
+	if x == nil {
+		return WatchQRLoginResponse_Update_not_set_case
+	}
+	switch x.Update.(type) {
+	case *WatchQRLoginResponse_Token:
+		return WatchQRLoginResponse_Token_case
+	case *WatchQRLoginResponse_Session:
+		return WatchQRLoginResponse_Session_case
+	default:
+		return WatchQRLoginResponse_Update_not_set_case
+	}
+}
+
+type WatchQRLoginResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Fields of oneof Update:
+	Token *QRLoginToken
+	// session created once token was accepted by another device, it is the last message of the stream
+	Session *AuthSession
+	// -- end of Update
+}
+
+func (b0 WatchQRLoginResponse_builder) Build() *WatchQRLoginResponse {
+	m0 := &WatchQRLoginResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Token != nil {
+		x.Update = &WatchQRLoginResponse_Token{b.Token}
+	}
+	if b.Session != nil {
+		x.Update = &WatchQRLoginResponse_Session{b.Session}
+	}
+	return m0
+}
+
+type case_WatchQRLoginResponse_Update protoreflect.FieldNumber
+
+func (x case_WatchQRLoginResponse_Update) String() string {
+	md := file_auth_v1_auth_proto_msgTypes[71].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isWatchQRLoginResponse_Update interface {
+	isWatchQRLoginResponse_Update()
+}
+
+type WatchQRLoginResponse_Token struct {
+	Token *QRLoginToken `protobuf:"bytes,1,opt,name=token,proto3,oneof"`
+}
+
+type WatchQRLoginResponse_Session struct {
+	// session created once token was accepted by another device, it is the last message of the stream
+	Session *AuthSession `protobuf:"bytes,2,opt,name=session,proto3,oneof"`
+}
+
+func (*WatchQRLoginResponse_Token) isWatchQRLoginResponse_Update() {}
+
+func (*WatchQRLoginResponse_Session) isWatchQRLoginResponse_Update() {}
+
+type SignInWithMagicLinkResponse struct {
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	User    *v1.User               `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Session *AuthSession           `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// present if user has totp 2FA, session is created after 2FA is verified
+	ConfirmationCode string `protobuf:"bytes,3,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SignInWithMagicLinkResponse) Reset() {
+	*x = SignInWithMagicLinkResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithMagicLinkResponse) ProtoMessage() {}
+
+func (x *SignInWithMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignInWithMagicLinkResponse) GetUser() *v1.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SignInWithMagicLinkResponse) GetSession() *AuthSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SignInWithMagicLinkResponse) GetConfirmationCode() string {
+	if x != nil {
+		return x.ConfirmationCode
+	}
+	return ""
+}
+
+func (x *SignInWithMagicLinkResponse) SetUser(v *v1.User) {
+	x.User = v
+}
+
+func (x *SignInWithMagicLinkResponse) SetSession(v *AuthSession) {
+	x.Session = v
+}
+
+func (x *SignInWithMagicLinkResponse) SetConfirmationCode(v string) {
+	x.ConfirmationCode = v
+}
+
+func (x *SignInWithMagicLinkResponse) HasUser() bool {
+	if x == nil {
+		return false
+	}
+	return x.User != nil
+}
+
+func (x *SignInWithMagicLinkResponse) HasSession() bool {
+	if x == nil {
+		return false
+	}
+	return x.Session != nil
+}
+
+func (x *SignInWithMagicLinkResponse) ClearUser() {
+	x.User = nil
+}
+
+func (x *SignInWithMagicLinkResponse) ClearSession() {
+	x.Session = nil
+}
+
+type SignInWithMagicLinkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	User    *v1.User
+	Session *AuthSession
+	// present if user has totp 2FA, session is created after 2FA is verified
+	ConfirmationCode string
+}
+
+func (b0 SignInWithMagicLinkResponse_builder) Build() *SignInWithMagicLinkResponse {
+	m0 := &SignInWithMagicLinkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.User = b.User
+	x.Session = b.Session
+	x.ConfirmationCode = b.ConfirmationCode
+	return m0
+}
+
+var File_auth_v1_auth_proto protoreflect.FileDescriptor
+
+const file_auth_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x12auth/v1/auth.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13users/v1/user.proto\"\x94\x03\n" +
+	"\rSignUpRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12+\n" +
+	"\x11confirmation_code\x18\x04 \x01(\tR\x10confirmationCode\x12\x17\n" +
+	"\aip_addr\x18\x05 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x06 \x01(\tR\n" +
+	"deviceInfo\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\a \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\b \x01(\tR\blastName\x12\x1b\n" +
+	"\tphoto_url\x18\t \x01(\tR\bphotoUrl\x12\x19\n" +
+	"\babout_me\x18\n" +
+	" \x01(\tR\aaboutMe\x129\n" +
+	"\n" +
+	"birth_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x1f\n" +
+	"\vinvite_code\x18\f \x01(\tR\n" +
+	"inviteCode\"d\n" +
+	"\x0eSignUpResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.auth.v1.AuthSessionR\asession\"\xa9\x02\n" +
+	"\vAuthSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12<\n" +
+	"\flast_seen_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\"\n" +
+	"\ris_long_lived\x18\x05 \x01(\bR\visLongLived\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x17\n" +
+	"\aip_addr\x18\a \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\b \x01(\tR\n" +
+	"deviceInfo\"\x9c\x01\n" +
+	"\rSignInRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vremember_me\x18\x03 \x01(\bR\n" +
+	"rememberMe\x12\x17\n" +
+	"\aip_addr\x18\x04 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x05 \x01(\tR\n" +
+	"deviceInfo\"\x91\x01\n" +
+	"\x0eSignInResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.auth.v1.AuthSessionR\asession\x12+\n" +
+	"\x11confirmation_code\x18\x03 \x01(\tR\x10confirmationCode\"\x14\n" +
+	"\x12PingSessionRequest\"E\n" +
+	"\x13PingSessionResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.auth.v1.AuthSessionR\asession\"\x1a\n" +
+	"\x18GetActiveSessionsRequest\"M\n" +
+	"\x19GetActiveSessionsResponse\x120\n" +
+	"\bsessions\x18\x01 \x03(\v2\x14.auth.v1.AuthSessionR\bsessions\"5\n" +
+	"\x14DeleteSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x17\n" +
+	"\x15DeleteSessionResponse\"\x95\x02\n" +
+	"\vAccessToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x81\x01\n" +
+	"\x18CreateAccessTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"j\n" +
+	"\x19CreateAccessTokenResponse\x127\n" +
+	"\faccess_token\x18\x01 \x01(\v2\x14.auth.v1.AccessTokenR\vaccessToken\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x18\n" +
+	"\x16GetAccessTokensRequest\"T\n" +
+	"\x17GetAccessTokensResponse\x129\n" +
+	"\raccess_tokens\x18\x01 \x03(\v2\x14.auth.v1.AccessTokenR\faccessTokens\"5\n" +
+	"\x18RevokeAccessTokenRequest\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\x03R\atokenId\"\x1b\n" +
+	"\x19RevokeAccessTokenResponse\"\xd8\x02\n" +
+	"\rSecurityEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
+	"\aip_addr\x18\x05 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x06 \x01(\tR\n" +
+	"deviceInfo\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12=\n" +
+	"\adetails\x18\b \x03(\v2#.auth.v1.SecurityEvent.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\x18GetSecurityEventsRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"l\n" +
+	"\x19GetSecurityEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.auth.v1.SecurityEventR\x06events\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\x1a\n" +
+	"\x18DeleteAllSessionsRequest\"\x1b\n" +
+	"\x19DeleteAllSessionsResponse\"\x1a\n" +
+	"\x18DeactivateAccountRequest\"\x1b\n" +
+	"\x19DeactivateAccountResponse\"\xa6\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\x81\x01\n" +
+	"\x13CreateInviteRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
+	"\bmax_uses\x18\x02 \x01(\x05R\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"S\n" +
+	"\x14CreateInviteResponse\x12'\n" +
+	"\x06invite\x18\x01 \x01(\v2\x0f.auth.v1.InviteR\x06invite\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x13\n" +
+	"\x11GetInvitesRequest\"?\n" +
+	"\x12GetInvitesResponse\x12)\n" +
+	"\ainvites\x18\x01 \x03(\v2\x0f.auth.v1.InviteR\ainvites\"2\n" +
+	"\x13RevokeInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\x03R\binviteId\"\x16\n" +
+	"\x14RevokeInviteResponse\"\x1f\n" +
+	"\x1dRequestAccountDeletionRequest\"_\n" +
+	"\x1eRequestAccountDeletionResponse\x12=\n" +
+	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"\x1e\n" +
+	"\x1cCancelAccountDeletionRequest\"\x1f\n" +
+	"\x1dCancelAccountDeletionResponse\"\x15\n" +
+	"\x13DisableTwoFaRequest\"\x16\n" +
+	"\x14DisableTwoFaResponse\"$\n" +
+	"\"RequestReauthenticationCodeRequest\"%\n" +
+	"#RequestReauthenticationCodeResponse\"\xd0\x01\n" +
+	"\x15ReauthenticateRequest\x12=\n" +
+	"\x06method\x18\x01 \x01(\x0e2%.auth.v1.ReauthenticateRequest.MethodR\x06method\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"H\n" +
+	"\x06Method\x12\x16\n" +
+	"\x12METHOD_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fMETHOD_PASSWORD\x10\x01\x12\x11\n" +
+	"\rMETHOD_TWO_FA\x10\x02\"H\n" +
+	"\x16ReauthenticateResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.auth.v1.AuthSessionR\asession\"6\n" +
+	"\x1eAnswerLoginConfirmationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb1\x01\n" +
+	"\x1fAnswerLoginConfirmationResponse\x12G\n" +
+	"\x06answer\x18\x01 \x01(\x0e2/.auth.v1.AnswerLoginConfirmationResponse.AnswerR\x06answer\"E\n" +
+	"\x06Answer\x12\x16\n" +
+	"\x12ANSWER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eANSWER_APPROVE\x10\x01\x12\x0f\n" +
+	"\vANSWER_DENY\x10\x02\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"c\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"\x17\n" +
+	"\x15ResetPasswordResponse\"\xed\x01\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12=\n" +
+	"\frequested_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12=\n" +
+	"\fcompleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x1a\n" +
+	"\x18RequestDataExportRequest\"Q\n" +
+	"\x19RequestDataExportResponse\x124\n" +
+	"\vdata_export\x18\x01 \x01(\v2\x13.auth.v1.DataExportR\n" +
+	"dataExport\"1\n" +
+	"\x19DownloadDataExportRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"2\n" +
+	"\x1aDownloadDataExportResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"9\n" +
+	"!RequestAccountReactivationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"$\n" +
+	"\"RequestAccountReactivationResponse\"D\n" +
+	"\x18ReactivateAccountRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x1b\n" +
+	"\x19ReactivateAccountResponse\"\x86\x01\n" +
+	"\x17RequestMagicLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x17\n" +
+	"\aip_addr\x18\x03 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x04 \x01(\tR\n" +
+	"deviceInfo\"\x1a\n" +
+	"\x18RequestMagicLinkResponse\"\x8f\x02\n" +
+	"\x1aSignInWithMagicLinkRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1f\n" +
+	"\vremember_me\x18\x04 \x01(\bR\n" +
+	"rememberMe\x12\x17\n" +
+	"\aip_addr\x18\x05 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x06 \x01(\tR\n" +
+	"deviceInfo\x120\n" +
+	"\x14trusted_device_token\x18\a \x01(\tR\x12trustedDeviceToken\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\b \x01(\tR\tpublicKey\"\xd3\x02\n" +
+	"\x12VerifyTwoFaRequest\x12;\n" +
+	"\x06method\x18\x01 \x01(\x0e2#.users.v1.TwoFactorAuth.TwoFaMethodR\x06method\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x129\n" +
+	"\x19sign_in_confirmation_code\x18\x04 \x01(\tR\x16signInConfirmationCode\x12\x1f\n" +
+	"\vremember_me\x18\x05 \x01(\bR\n" +
+	"rememberMe\x12\x17\n" +
+	"\aip_addr\x18\x06 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\a \x01(\tR\n" +
+	"deviceInfo\x12!\n" +
+	"\ftrust_device\x18\b \x01(\bR\vtrustDevice\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\t \x01(\tR\tpublicKey\"w\n" +
+	"\x13VerifyTwoFaResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.auth.v1.AuthSessionR\asession\x120\n" +
+	"\x14trusted_device_token\x18\x02 \x01(\tR\x12trustedDeviceToken\"\xe9\x01\n" +
+	"\rTrustedDevice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x1a\n" +
+	"\x18GetTrustedDevicesRequest\"M\n" +
+	"\x19GetTrustedDevicesResponse\x120\n" +
+	"\adevices\x18\x01 \x03(\v2\x16.auth.v1.TrustedDeviceR\adevices\"9\n" +
+	"\x1aRevokeTrustedDeviceRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\x03R\bdeviceId\"\x1d\n" +
+	"\x1bRevokeTrustedDeviceResponse\" \n" +
+	"\x1eRevokeAllTrustedDevicesRequest\"!\n" +
+	"\x1fRevokeAllTrustedDevicesResponse\"\x92\x01\n" +
+	"\x13WatchQRLoginRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x17\n" +
+	"\aip_addr\x18\x02 \x01(\tR\x06ipAddr\x12\x1f\n" +
+	"\vdevice_info\x18\x03 \x01(\tR\n" +
+	"deviceInfo\x12$\n" +
+	"\x0eqr_code_format\x18\x04 \x01(\tR\fqrCodeFormat\"\x8a\x01\n" +
+	"\fQRLoginToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x17\n" +
+	"\aqr_code\x18\x04 \x01(\fR\x06qrCode\"\x81\x01\n" +
+	"\x14WatchQRLoginResponse\x12-\n" +
+	"\x05token\x18\x01 \x01(\v2\x15.auth.v1.QRLoginTokenH\x00R\x05token\x120\n" +
+	"\asession\x18\x02 \x01(\v2\x14.auth.v1.AuthSessionH\x00R\asessionB\b\n" +
+	"\x06update\"\x9e\x01\n" +
+	"\x1bSignInWithMagicLinkResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12.\n" +
+	"\asession\x18\x02 \x01(\v2\x14.auth.v1.AuthSessionR\asession\x12+\n" +
+	"\x11confirmation_code\x18\x03 \x01(\tR\x10confirmationCode2\x9c\x17\n" +
+	"\vAuthService\x129\n" +
+	"\x06SignUp\x12\x16.auth.v1.SignUpRequest\x1a\x17.auth.v1.SignUpResponse\x129\n" +
+	"\x06SignIn\x12\x16.auth.v1.SignInRequest\x1a\x17.auth.v1.SignInResponse\x12H\n" +
+	"\vPingSession\x12\x1b.auth.v1.PingSessionRequest\x1a\x1c.auth.v1.PingSessionResponse\x12Z\n" +
+	"\x11GetActiveSessions\x12!.auth.v1.GetActiveSessionsRequest\x1a\".auth.v1.GetActiveSessionsResponse\x12N\n" +
+	"\rDeleteSession\x12\x1d.auth.v1.DeleteSessionRequest\x1a\x1e.auth.v1.DeleteSessionResponse\x12Z\n" +
+	"\x11CreateAccessToken\x12!.auth.v1.CreateAccessTokenRequest\x1a\".auth.v1.CreateAccessTokenResponse\x12T\n" +
+	"\x0fGetAccessTokens\x12\x1f.auth.v1.GetAccessTokensRequest\x1a .auth.v1.GetAccessTokensResponse\x12Z\n" +
+	"\x11RevokeAccessToken\x12!.auth.v1.RevokeAccessTokenRequest\x1a\".auth.v1.RevokeAccessTokenResponse\x12Z\n" +
+	"\x11GetSecurityEvents\x12!.auth.v1.GetSecurityEventsRequest\x1a\".auth.v1.GetSecurityEventsResponse\x12Z\n" +
+	"\x11DeleteAllSessions\x12!.auth.v1.DeleteAllSessionsRequest\x1a\".auth.v1.DeleteAllSessionsResponse\x12Z\n" +
+	"\x11DeactivateAccount\x12!.auth.v1.DeactivateAccountRequest\x1a\".auth.v1.DeactivateAccountResponse\x12K\n" +
+	"\fDisableTwoFa\x12\x1c.auth.v1.DisableTwoFaRequest\x1a\x1d.auth.v1.DisableTwoFaResponse\x12x\n" +
+	"\x1bRequestReauthenticationCode\x12+.auth.v1.RequestReauthenticationCodeRequest\x1a,.auth.v1.RequestReauthenticationCodeResponse\x12Q\n" +
+	"\x0eReauthenticate\x12\x1e.auth.v1.ReauthenticateRequest\x1a\x1f.auth.v1.ReauthenticateResponse\x12l\n" +
+	"\x17AnswerLoginConfirmation\x12'.auth.v1.AnswerLoginConfirmationRequest\x1a(.auth.v1.AnswerLoginConfirmationResponse\x12c\n" +
+	"\x14RequestPasswordReset\x12$.auth.v1.RequestPasswordResetRequest\x1a%.auth.v1.RequestPasswordResetResponse\x12N\n" +
+	"\rResetPassword\x12\x1d.auth.v1.ResetPasswordRequest\x1a\x1e.auth.v1.ResetPasswordResponse\x12Z\n" +
+	"\x11RequestDataExport\x12!.auth.v1.RequestDataExportRequest\x1a\".auth.v1.RequestDataExportResponse\x12_\n" +
+	"\x12DownloadDataExport\x12\".auth.v1.DownloadDataExportRequest\x1a#.auth.v1.DownloadDataExportResponse0\x01\x12u\n" +
+	"\x1aRequestAccountReactivation\x12*.auth.v1.RequestAccountReactivationRequest\x1a+.auth.v1.RequestAccountReactivationResponse\x12Z\n" +
+	"\x11ReactivateAccount\x12!.auth.v1.ReactivateAccountRequest\x1a\".auth.v1.ReactivateAccountResponse\x12W\n" +
+	"\x10RequestMagicLink\x12 .auth.v1.RequestMagicLinkRequest\x1a!.auth.v1.RequestMagicLinkResponse\x12`\n" +
+	"\x13SignInWithMagicLink\x12#.auth.v1.SignInWithMagicLinkRequest\x1a$.auth.v1.SignInWithMagicLinkResponse\x12M\n" +
+	"\fWatchQRLogin\x12\x1c.auth.v1.WatchQRLoginRequest\x1a\x1d.auth.v1.WatchQRLoginResponse0\x01\x12H\n" +
+	"\vVerifyTwoFa\x12\x1b.auth.v1.VerifyTwoFaRequest\x1a\x1c.auth.v1.VerifyTwoFaResponse\x12Z\n" +
+	"\x11GetTrustedDevices\x12!.auth.v1.GetTrustedDevicesRequest\x1a\".auth.v1.GetTrustedDevicesResponse\x12`\n" +
+	"\x13RevokeTrustedDevice\x12#.auth.v1.RevokeTrustedDeviceRequest\x1a$.auth.v1.RevokeTrustedDeviceResponse\x12l\n" +
+	"\x17RevokeAllTrustedDevices\x12'.auth.v1.RevokeAllTrustedDevicesRequest\x1a(.auth.v1.RevokeAllTrustedDevicesResponse\x12i\n" +
+	"\x16RequestAccountDeletion\x12&.auth.v1.RequestAccountDeletionRequest\x1a'.auth.v1.RequestAccountDeletionResponse\x12f\n" +
+	"\x15CancelAccountDeletion\x12%.auth.v1.CancelAccountDeletionRequest\x1a&.auth.v1.CancelAccountDeletionResponse\x12K\n" +
+	"\fCreateInvite\x12\x1c.auth.v1.CreateInviteRequest\x1a\x1d.auth.v1.CreateInviteResponse\x12E\n" +
+	"\n" +
+	"GetInvites\x12\x1a.auth.v1.GetInvitesRequest\x1a\x1b.auth.v1.GetInvitesResponse\x12K\n" +
+	"\fRevokeInvite\x12\x1c.auth.v1.RevokeInviteRequest\x1a\x1d.auth.v1.RevokeInviteResponseBEZCbuf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1;authv1b\x06proto3"
+
+var file_auth_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_auth_v1_auth_proto_goTypes = []any{
+	(ReauthenticateRequest_Method)(0),           // 0: auth.v1.ReauthenticateRequest.Method
+	(AnswerLoginConfirmationResponse_Answer)(0), // 1: auth.v1.AnswerLoginConfirmationResponse.Answer
+	(*SignUpRequest)(nil),                       // 2: auth.v1.SignUpRequest
+	(*SignUpResponse)(nil),                      // 3: auth.v1.SignUpResponse
+	(*AuthSession)(nil),                         // 4: auth.v1.AuthSession
+	(*SignInRequest)(nil),                       // 5: auth.v1.SignInRequest
+	(*SignInResponse)(nil),                      // 6: auth.v1.SignInResponse
+	(*PingSessionRequest)(nil),                  // 7: auth.v1.PingSessionRequest
+	(*PingSessionResponse)(nil),                 // 8: auth.v1.PingSessionResponse
+	(*GetActiveSessionsRequest)(nil),            // 9: auth.v1.GetActiveSessionsRequest
+	(*GetActiveSessionsResponse)(nil),           // 10: auth.v1.GetActiveSessionsResponse
+	(*DeleteSessionRequest)(nil),                // 11: auth.v1.DeleteSessionRequest
+	(*DeleteSessionResponse)(nil),               // 12: auth.v1.DeleteSessionResponse
+	(*AccessToken)(nil),                         // 13: auth.v1.AccessToken
+	(*CreateAccessTokenRequest)(nil),            // 14: auth.v1.CreateAccessTokenRequest
+	(*CreateAccessTokenResponse)(nil),           // 15: auth.v1.CreateAccessTokenResponse
+	(*GetAccessTokensRequest)(nil),              // 16: auth.v1.GetAccessTokensRequest
+	(*GetAccessTokensResponse)(nil),             // 17: auth.v1.GetAccessTokensResponse
+	(*RevokeAccessTokenRequest)(nil),            // 18: auth.v1.RevokeAccessTokenRequest
+	(*RevokeAccessTokenResponse)(nil),           // 19: auth.v1.RevokeAccessTokenResponse
+	(*SecurityEvent)(nil),                       // 20: auth.v1.SecurityEvent
+	(*GetSecurityEventsRequest)(nil),            // 21: auth.v1.GetSecurityEventsRequest
+	(*GetSecurityEventsResponse)(nil),           // 22: auth.v1.GetSecurityEventsResponse
+	(*DeleteAllSessionsRequest)(nil),            // 23: auth.v1.DeleteAllSessionsRequest
+	(*DeleteAllSessionsResponse)(nil),           // 24: auth.v1.DeleteAllSessionsResponse
+	(*DeactivateAccountRequest)(nil),            // 25: auth.v1.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),           // 26: auth.v1.DeactivateAccountResponse
+	(*Invite)(nil),                              // 27: auth.v1.Invite
+	(*CreateInviteRequest)(nil),                 // 28: auth.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),                // 29: auth.v1.CreateInviteResponse
+	(*GetInvitesRequest)(nil),                   // 30: auth.v1.GetInvitesRequest
+	(*GetInvitesResponse)(nil),                  // 31: auth.v1.GetInvitesResponse
+	(*RevokeInviteRequest)(nil),                 // 32: auth.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),                // 33: auth.v1.RevokeInviteResponse
+	(*RequestAccountDeletionRequest)(nil),       // 34: auth.v1.RequestAccountDeletionRequest
+	(*RequestAccountDeletionResponse)(nil),      // 35: auth.v1.RequestAccountDeletionResponse
+	(*CancelAccountDeletionRequest)(nil),        // 36: auth.v1.CancelAccountDeletionRequest
+	(*CancelAccountDeletionResponse)(nil),       // 37: auth.v1.CancelAccountDeletionResponse
+	(*DisableTwoFaRequest)(nil),                 // 38: auth.v1.DisableTwoFaRequest
+	(*DisableTwoFaResponse)(nil),                // 39: auth.v1.DisableTwoFaResponse
+	(*RequestReauthenticationCodeRequest)(nil),  // 40: auth.v1.RequestReauthenticationCodeRequest
+	(*RequestReauthenticationCodeResponse)(nil), // 41: auth.v1.RequestReauthenticationCodeResponse
+	(*ReauthenticateRequest)(nil),               // 42: auth.v1.ReauthenticateRequest
+	(*ReauthenticateResponse)(nil),              // 43: auth.v1.ReauthenticateResponse
+	(*AnswerLoginConfirmationRequest)(nil),      // 44: auth.v1.AnswerLoginConfirmationRequest
+	(*AnswerLoginConfirmationResponse)(nil),     // 45: auth.v1.AnswerLoginConfirmationResponse
+	(*RequestPasswordResetRequest)(nil),         // 46: auth.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),        // 47: auth.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),                // 48: auth.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),               // 49: auth.v1.ResetPasswordResponse
+	(*DataExport)(nil),                          // 50: auth.v1.DataExport
+	(*RequestDataExportRequest)(nil),            // 51: auth.v1.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),           // 52: auth.v1.RequestDataExportResponse
+	(*DownloadDataExportRequest)(nil),           // 53: auth.v1.DownloadDataExportRequest
+	(*DownloadDataExportResponse)(nil),          // 54: auth.v1.DownloadDataExportResponse
+	(*RequestAccountReactivationRequest)(nil),   // 55: auth.v1.RequestAccountReactivationRequest
+	(*RequestAccountReactivationResponse)(nil),  // 56: auth.v1.RequestAccountReactivationResponse
+	(*ReactivateAccountRequest)(nil),            // 57: auth.v1.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil),           // 58: auth.v1.ReactivateAccountResponse
+	(*RequestMagicLinkRequest)(nil),             // 59: auth.v1.RequestMagicLinkRequest
+	(*RequestMagicLinkResponse)(nil),            // 60: auth.v1.RequestMagicLinkResponse
+	(*SignInWithMagicLinkRequest)(nil),          // 61: auth.v1.SignInWithMagicLinkRequest
+	(*VerifyTwoFaRequest)(nil),                  // 62: auth.v1.VerifyTwoFaRequest
+	(*VerifyTwoFaResponse)(nil),                 // 63: auth.v1.VerifyTwoFaResponse
+	(*TrustedDevice)(nil),                       // 64: auth.v1.TrustedDevice
+	(*GetTrustedDevicesRequest)(nil),            // 65: auth.v1.GetTrustedDevicesRequest
+	(*GetTrustedDevicesResponse)(nil),           // 66: auth.v1.GetTrustedDevicesResponse
+	(*RevokeTrustedDeviceRequest)(nil),          // 67: auth.v1.RevokeTrustedDeviceRequest
+	(*RevokeTrustedDeviceResponse)(nil),         // 68: auth.v1.RevokeTrustedDeviceResponse
+	(*RevokeAllTrustedDevicesRequest)(nil),      // 69: auth.v1.RevokeAllTrustedDevicesRequest
+	(*RevokeAllTrustedDevicesResponse)(nil),     // 70: auth.v1.RevokeAllTrustedDevicesResponse
+	(*WatchQRLoginRequest)(nil),                 // 71: auth.v1.WatchQRLoginRequest
+	(*QRLoginToken)(nil),                        // 72: auth.v1.QRLoginToken
+	(*WatchQRLoginResponse)(nil),                // 73: auth.v1.WatchQRLoginResponse
+	(*SignInWithMagicLinkResponse)(nil),         // 74: auth.v1.SignInWithMagicLinkResponse
+	nil,                                         // 75: auth.v1.SecurityEvent.DetailsEntry
+	(*timestamppb.Timestamp)(nil),               // 76: google.protobuf.Timestamp
+	(*v1.User)(nil),                             // 77: users.v1.User
+	(v1.TwoFactorAuth_TwoFaMethod)(0),           // 78: users.v1.TwoFactorAuth.TwoFaMethod
+}
+var file_auth_v1_auth_proto_depIdxs = []int32{
+	76, // 0: auth.v1.SignUpRequest.birth_date:type_name -> google.protobuf.Timestamp
+	77, // 1: auth.v1.SignUpResponse.user:type_name -> users.v1.User
+	4,  // 2: auth.v1.SignUpResponse.session:type_name -> auth.v1.AuthSession
+	76, // 3: auth.v1.AuthSession.last_seen_at:type_name -> google.protobuf.Timestamp
+	76, // 4: auth.v1.AuthSession.created_at:type_name -> google.protobuf.Timestamp
+	77, // 5: auth.v1.SignInResponse.user:type_name -> users.v1.User
+	4,  // 6: auth.v1.SignInResponse.session:type_name -> auth.v1.AuthSession
+	4,  // 7: auth.v1.PingSessionResponse.session:type_name -> auth.v1.AuthSession
+	4,  // 8: auth.v1.GetActiveSessionsResponse.sessions:type_name -> auth.v1.AuthSession
+	76, // 9: auth.v1.AccessToken.created_at:type_name -> google.protobuf.Timestamp
+	76, // 10: auth.v1.AccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	76, // 11: auth.v1.AccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	76, // 12: auth.v1.CreateAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 13: auth.v1.CreateAccessTokenResponse.access_token:type_name -> auth.v1.AccessToken
+	13, // 14: auth.v1.GetAccessTokensResponse.access_tokens:type_name -> auth.v1.AccessToken
+	76, // 15: auth.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
+	75, // 16: auth.v1.SecurityEvent.details:type_name -> auth.v1.SecurityEvent.DetailsEntry
+	20, // 17: auth.v1.GetSecurityEventsResponse.events:type_name -> auth.v1.SecurityEvent
+	76, // 18: auth.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	76, // 19: auth.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	76, // 20: auth.v1.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	76, // 21: auth.v1.CreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 22: auth.v1.CreateInviteResponse.invite:type_name -> auth.v1.Invite
+	27, // 23: auth.v1.GetInvitesResponse.invites:type_name -> auth.v1.Invite
+	76, // 24: auth.v1.RequestAccountDeletionResponse.scheduled_at:type_name -> google.protobuf.Timestamp
+	0,  // 25: auth.v1.ReauthenticateRequest.method:type_name -> auth.v1.ReauthenticateRequest.Method
+	4,  // 26: auth.v1.ReauthenticateResponse.session:type_name -> auth.v1.AuthSession
+	1,  // 27: auth.v1.AnswerLoginConfirmationResponse.answer:type_name -> auth.v1.AnswerLoginConfirmationResponse.Answer
+	76, // 28: auth.v1.DataExport.requested_at:type_name -> google.protobuf.Timestamp
+	76, // 29: auth.v1.DataExport.completed_at:type_name -> google.protobuf.Timestamp
+	76, // 30: auth.v1.DataExport.expires_at:type_name -> google.protobuf.Timestamp
+	50, // 31: auth.v1.RequestDataExportResponse.data_export:type_name -> auth.v1.DataExport
+	78, // 32: auth.v1.VerifyTwoFaRequest.method:type_name -> users.v1.TwoFactorAuth.TwoFaMethod
+	4,  // 33: auth.v1.VerifyTwoFaResponse.session:type_name -> auth.v1.AuthSession
+	76, // 34: auth.v1.TrustedDevice.created_at:type_name -> google.protobuf.Timestamp
+	76, // 35: auth.v1.TrustedDevice.last_used_at:type_name -> google.protobuf.Timestamp
+	76, // 36: auth.v1.TrustedDevice.expires_at:type_name -> google.protobuf.Timestamp
+	64, // 37: auth.v1.GetTrustedDevicesResponse.devices:type_name -> auth.v1.TrustedDevice
+	76, // 38: auth.v1.QRLoginToken.expires_at:type_name -> google.protobuf.Timestamp
+	72, // 39: auth.v1.WatchQRLoginResponse.token:type_name -> auth.v1.QRLoginToken
+	4,  // 40: auth.v1.WatchQRLoginResponse.session:type_name -> auth.v1.AuthSession
+	77, // 41: auth.v1.SignInWithMagicLinkResponse.user:type_name -> users.v1.User
+	4,  // 42: auth.v1.SignInWithMagicLinkResponse.session:type_name -> auth.v1.AuthSession
+	2,  // 43: auth.v1.AuthService.SignUp:input_type -> auth.v1.SignUpRequest
+	5,  // 44: auth.v1.AuthService.SignIn:input_type -> auth.v1.SignInRequest
+	7,  // 45: auth.v1.AuthService.PingSession:input_type -> auth.v1.PingSessionRequest
+	9,  // 46: auth.v1.AuthService.GetActiveSessions:input_type -> auth.v1.GetActiveSessionsRequest
+	11, // 47: auth.v1.AuthService.DeleteSession:input_type -> auth.v1.DeleteSessionRequest
+	14, // 48: auth.v1.AuthService.CreateAccessToken:input_type -> auth.v1.CreateAccessTokenRequest
+	16, // 49: auth.v1.AuthService.GetAccessTokens:input_type -> auth.v1.GetAccessTokensRequest
+	18, // 50: auth.v1.AuthService.RevokeAccessToken:input_type -> auth.v1.RevokeAccessTokenRequest
+	21, // 51: auth.v1.AuthService.GetSecurityEvents:input_type -> auth.v1.GetSecurityEventsRequest
+	23, // 52: auth.v1.AuthService.DeleteAllSessions:input_type -> auth.v1.DeleteAllSessionsRequest
+	25, // 53: auth.v1.AuthService.DeactivateAccount:input_type -> auth.v1.DeactivateAccountRequest
+	38, // 54: auth.v1.AuthService.DisableTwoFa:input_type -> auth.v1.DisableTwoFaRequest
+	40, // 55: auth.v1.AuthService.RequestReauthenticationCode:input_type -> auth.v1.RequestReauthenticationCodeRequest
+	42, // 56: auth.v1.AuthService.Reauthenticate:input_type -> auth.v1.ReauthenticateRequest
+	44, // 57: auth.v1.AuthService.AnswerLoginConfirmation:input_type -> auth.v1.AnswerLoginConfirmationRequest
+	46, // 58: auth.v1.AuthService.RequestPasswordReset:input_type -> auth.v1.RequestPasswordResetRequest
+	48, // 59: auth.v1.AuthService.ResetPassword:input_type -> auth.v1.ResetPasswordRequest
+	51, // 60: auth.v1.AuthService.RequestDataExport:input_type -> auth.v1.RequestDataExportRequest
+	53, // 61: auth.v1.AuthService.DownloadDataExport:input_type -> auth.v1.DownloadDataExportRequest
+	55, // 62: auth.v1.AuthService.RequestAccountReactivation:input_type -> auth.v1.RequestAccountReactivationRequest
+	57, // 63: auth.v1.AuthService.ReactivateAccount:input_type -> auth.v1.ReactivateAccountRequest
+	59, // 64: auth.v1.AuthService.RequestMagicLink:input_type -> auth.v1.RequestMagicLinkRequest
+	61, // 65: auth.v1.AuthService.SignInWithMagicLink:input_type -> auth.v1.SignInWithMagicLinkRequest
+	71, // 66: auth.v1.AuthService.WatchQRLogin:input_type -> auth.v1.WatchQRLoginRequest
+	62, // 67: auth.v1.AuthService.VerifyTwoFa:input_type -> auth.v1.VerifyTwoFaRequest
+	65, // 68: auth.v1.AuthService.GetTrustedDevices:input_type -> auth.v1.GetTrustedDevicesRequest
+	67, // 69: auth.v1.AuthService.RevokeTrustedDevice:input_type -> auth.v1.RevokeTrustedDeviceRequest
+	69, // 70: auth.v1.AuthService.RevokeAllTrustedDevices:input_type -> auth.v1.RevokeAllTrustedDevicesRequest
+	34, // 71: auth.v1.AuthService.RequestAccountDeletion:input_type -> auth.v1.RequestAccountDeletionRequest
+	36, // 72: auth.v1.AuthService.CancelAccountDeletion:input_type -> auth.v1.CancelAccountDeletionRequest
+	28, // 73: auth.v1.AuthService.CreateInvite:input_type -> auth.v1.CreateInviteRequest
+	30, // 74: auth.v1.AuthService.GetInvites:input_type -> auth.v1.GetInvitesRequest
+	32, // 75: auth.v1.AuthService.RevokeInvite:input_type -> auth.v1.RevokeInviteRequest
+	3,  // 76: auth.v1.AuthService.SignUp:output_type -> auth.v1.SignUpResponse
+	6,  // 77: auth.v1.AuthService.SignIn:output_type -> auth.v1.SignInResponse
+	8,  // 78: auth.v1.AuthService.PingSession:output_type -> auth.v1.PingSessionResponse
+	10, // 79: auth.v1.AuthService.GetActiveSessions:output_type -> auth.v1.GetActiveSessionsResponse
+	12, // 80: auth.v1.AuthService.DeleteSession:output_type -> auth.v1.DeleteSessionResponse
+	15, // 81: auth.v1.AuthService.CreateAccessToken:output_type -> auth.v1.CreateAccessTokenResponse
+	17, // 82: auth.v1.AuthService.GetAccessTokens:output_type -> auth.v1.GetAccessTokensResponse
+	19, // 83: auth.v1.AuthService.RevokeAccessToken:output_type -> auth.v1.RevokeAccessTokenResponse
+	22, // 84: auth.v1.AuthService.GetSecurityEvents:output_type -> auth.v1.GetSecurityEventsResponse
+	24, // 85: auth.v1.AuthService.DeleteAllSessions:output_type -> auth.v1.DeleteAllSessionsResponse
+	26, // 86: auth.v1.AuthService.DeactivateAccount:output_type -> auth.v1.DeactivateAccountResponse
+	39, // 87: auth.v1.AuthService.DisableTwoFa:output_type -> auth.v1.DisableTwoFaResponse
+	41, // 88: auth.v1.AuthService.RequestReauthenticationCode:output_type -> auth.v1.RequestReauthenticationCodeResponse
+	43, // 89: auth.v1.AuthService.Reauthenticate:output_type -> auth.v1.ReauthenticateResponse
+	45, // 90: auth.v1.AuthService.AnswerLoginConfirmation:output_type -> auth.v1.AnswerLoginConfirmationResponse
+	47, // 91: auth.v1.AuthService.RequestPasswordReset:output_type -> auth.v1.RequestPasswordResetResponse
+	49, // 92: auth.v1.AuthService.ResetPassword:output_type -> auth.v1.ResetPasswordResponse
+	52, // 93: auth.v1.AuthService.RequestDataExport:output_type -> auth.v1.RequestDataExportResponse
+	54, // 94: auth.v1.AuthService.DownloadDataExport:output_type -> auth.v1.DownloadDataExportResponse
+	56, // 95: auth.v1.AuthService.RequestAccountReactivation:output_type -> auth.v1.RequestAccountReactivationResponse
+	58, // 96: auth.v1.AuthService.ReactivateAccount:output_type -> auth.v1.ReactivateAccountResponse
+	60, // 97: auth.v1.AuthService.RequestMagicLink:output_type -> auth.v1.RequestMagicLinkResponse
+	74, // 98: auth.v1.AuthService.SignInWithMagicLink:output_type -> auth.v1.SignInWithMagicLinkResponse
+	73, // 99: auth.v1.AuthService.WatchQRLogin:output_type -> auth.v1.WatchQRLoginResponse
+	63, // 100: auth.v1.AuthService.VerifyTwoFa:output_type -> auth.v1.VerifyTwoFaResponse
+	66, // 101: auth.v1.AuthService.GetTrustedDevices:output_type -> auth.v1.GetTrustedDevicesResponse
+	68, // 102: auth.v1.AuthService.RevokeTrustedDevice:output_type -> auth.v1.RevokeTrustedDeviceResponse
+	70, // 103: auth.v1.AuthService.RevokeAllTrustedDevices:output_type -> auth.v1.RevokeAllTrustedDevicesResponse
+	35, // 104: auth.v1.AuthService.RequestAccountDeletion:output_type -> auth.v1.RequestAccountDeletionResponse
+	37, // 105: auth.v1.AuthService.CancelAccountDeletion:output_type -> auth.v1.CancelAccountDeletionResponse
+	29, // 106: auth.v1.AuthService.CreateInvite:output_type -> auth.v1.CreateInviteResponse
+	31, // 107: auth.v1.AuthService.GetInvites:output_type -> auth.v1.GetInvitesResponse
+	33, // 108: auth.v1.AuthService.RevokeInvite:output_type -> auth.v1.RevokeInviteResponse
+	76, // [76:109] is the sub-list for method output_type
+	43, // [43:76] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_auth_proto_init() }
+func file_auth_v1_auth_proto_init() {
+	if File_auth_v1_auth_proto != nil {
+		return
+	}
+	file_auth_v1_auth_proto_msgTypes[71].OneofWrappers = []any{
+		(*WatchQRLoginResponse_Token)(nil),
+		(*WatchQRLoginResponse_Session)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   74,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_auth_proto_goTypes,
+		DependencyIndexes: file_auth_v1_auth_proto_depIdxs,
+		EnumInfos:         file_auth_v1_auth_proto_enumTypes,
+		MessageInfos:      file_auth_v1_auth_proto_msgTypes,
+	}.Build()
+	File_auth_v1_auth_proto = out.File
+	file_auth_v1_auth_proto_goTypes = nil
+	file_auth_v1_auth_proto_depIdxs = nil
+}
@@ -0,0 +1,1539 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: auth/v1/key_directory.proto
+
+//go:build !protoopaque
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Keys are base64url encoded without padding. Server stores only public keys
+type SignedPrekey struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	KeyId int32                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// raw 32 bytes X25519 key
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// signature of decoded public_key bytes made by device's identity key
+	Signature     string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedPrekey) Reset() {
+	*x = SignedPrekey{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedPrekey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedPrekey) ProtoMessage() {}
+
+func (x *SignedPrekey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignedPrekey) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *SignedPrekey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SignedPrekey) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignedPrekey) SetKeyId(v int32) {
+	x.KeyId = v
+}
+
+func (x *SignedPrekey) SetPublicKey(v string) {
+	x.PublicKey = v
+}
+
+func (x *SignedPrekey) SetSignature(v string) {
+	x.Signature = v
+}
+
+type SignedPrekey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	KeyId int32
+	// raw 32 bytes X25519 key
+	PublicKey string
+	// signature of decoded public_key bytes made by device's identity key
+	Signature string
+}
+
+func (b0 SignedPrekey_builder) Build() *SignedPrekey {
+	m0 := &SignedPrekey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.KeyId = b.KeyId
+	x.PublicKey = b.PublicKey
+	x.Signature = b.Signature
+	return m0
+}
+
+type OneTimePrekey struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	KeyId int32                  `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// raw 32 bytes X25519 key
+	PublicKey     string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneTimePrekey) Reset() {
+	*x = OneTimePrekey{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneTimePrekey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneTimePrekey) ProtoMessage() {}
+
+func (x *OneTimePrekey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OneTimePrekey) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *OneTimePrekey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *OneTimePrekey) SetKeyId(v int32) {
+	x.KeyId = v
+}
+
+func (x *OneTimePrekey) SetPublicKey(v string) {
+	x.PublicKey = v
+}
+
+type OneTimePrekey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	KeyId int32
+	// raw 32 bytes X25519 key
+	PublicKey string
+}
+
+func (b0 OneTimePrekey_builder) Build() *OneTimePrekey {
+	m0 := &OneTimePrekey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.KeyId = b.KeyId
+	x.PublicKey = b.PublicKey
+	return m0
+}
+
+type IdentityKey struct {
+	state    protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// PKIX Ed25519 or P-256 key
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityKey) Reset() {
+	*x = IdentityKey{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityKey) ProtoMessage() {}
+
+func (x *IdentityKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *IdentityKey) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *IdentityKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *IdentityKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IdentityKey) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+func (x *IdentityKey) SetPublicKey(v string) {
+	x.PublicKey = v
+}
+
+func (x *IdentityKey) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.CreatedAt = v
+}
+
+func (x *IdentityKey) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreatedAt != nil
+}
+
+func (x *IdentityKey) ClearCreatedAt() {
+	x.CreatedAt = nil
+}
+
+type IdentityKey_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId string
+	// PKIX Ed25519 or P-256 key
+	PublicKey string
+	CreatedAt *timestamppb.Timestamp
+}
+
+func (b0 IdentityKey_builder) Build() *IdentityKey {
+	m0 := &IdentityKey{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	x.PublicKey = b.PublicKey
+	x.CreatedAt = b.CreatedAt
+	return m0
+}
+
+type PrekeyBundle struct {
+	state        protoimpl.MessageState `protogen:"hybrid.v1"`
+	IdentityKey  *IdentityKey           `protobuf:"bytes,1,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPrekey *SignedPrekey          `protobuf:"bytes,2,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	// absent if device has run out of one-time prekeys
+	OneTimePrekey *OneTimePrekey `protobuf:"bytes,3,opt,name=one_time_prekey,json=oneTimePrekey,proto3" json:"one_time_prekey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrekeyBundle) Reset() {
+	*x = PrekeyBundle{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrekeyBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrekeyBundle) ProtoMessage() {}
+
+func (x *PrekeyBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PrekeyBundle) GetIdentityKey() *IdentityKey {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *PrekeyBundle) GetSignedPrekey() *SignedPrekey {
+	if x != nil {
+		return x.SignedPrekey
+	}
+	return nil
+}
+
+func (x *PrekeyBundle) GetOneTimePrekey() *OneTimePrekey {
+	if x != nil {
+		return x.OneTimePrekey
+	}
+	return nil
+}
+
+func (x *PrekeyBundle) SetIdentityKey(v *IdentityKey) {
+	x.IdentityKey = v
+}
+
+func (x *PrekeyBundle) SetSignedPrekey(v *SignedPrekey) {
+	x.SignedPrekey = v
+}
+
+func (x *PrekeyBundle) SetOneTimePrekey(v *OneTimePrekey) {
+	x.OneTimePrekey = v
+}
+
+func (x *PrekeyBundle) HasIdentityKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.IdentityKey != nil
+}
+
+func (x *PrekeyBundle) HasSignedPrekey() bool {
+	if x == nil {
+		return false
+	}
+	return x.SignedPrekey != nil
+}
+
+func (x *PrekeyBundle) HasOneTimePrekey() bool {
+	if x == nil {
+		return false
+	}
+	return x.OneTimePrekey != nil
+}
+
+func (x *PrekeyBundle) ClearIdentityKey() {
+	x.IdentityKey = nil
+}
+
+func (x *PrekeyBundle) ClearSignedPrekey() {
+	x.SignedPrekey = nil
+}
+
+func (x *PrekeyBundle) ClearOneTimePrekey() {
+	x.OneTimePrekey = nil
+}
+
+type PrekeyBundle_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	IdentityKey  *IdentityKey
+	SignedPrekey *SignedPrekey
+	// absent if device has run out of one-time prekeys
+	OneTimePrekey *OneTimePrekey
+}
+
+func (b0 PrekeyBundle_builder) Build() *PrekeyBundle {
+	m0 := &PrekeyBundle{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IdentityKey = b.IdentityKey
+	x.SignedPrekey = b.SignedPrekey
+	x.OneTimePrekey = b.OneTimePrekey
+	return m0
+}
+
+type DeviceKeysStatus struct {
+	state          protoimpl.MessageState `protogen:"hybrid.v1"`
+	OneTimePrekeys int32                  `protobuf:"varint,1,opt,name=one_time_prekeys,json=oneTimePrekeys,proto3" json:"one_time_prekeys,omitempty"`
+	// device should upload more one-time prekeys
+	OneTimePrekeysLow     bool                   `protobuf:"varint,2,opt,name=one_time_prekeys_low,json=oneTimePrekeysLow,proto3" json:"one_time_prekeys_low,omitempty"`
+	SignedPrekeyCreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=signed_prekey_created_at,json=signedPrekeyCreatedAt,proto3" json:"signed_prekey_created_at,omitempty"`
+	// device should rotate its signed prekey
+	SignedPrekeyRotationDue bool `protobuf:"varint,4,opt,name=signed_prekey_rotation_due,json=signedPrekeyRotationDue,proto3" json:"signed_prekey_rotation_due,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeviceKeysStatus) Reset() {
+	*x = DeviceKeysStatus{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceKeysStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceKeysStatus) ProtoMessage() {}
+
+func (x *DeviceKeysStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeviceKeysStatus) GetOneTimePrekeys() int32 {
+	if x != nil {
+		return x.OneTimePrekeys
+	}
+	return 0
+}
+
+func (x *DeviceKeysStatus) GetOneTimePrekeysLow() bool {
+	if x != nil {
+		return x.OneTimePrekeysLow
+	}
+	return false
+}
+
+func (x *DeviceKeysStatus) GetSignedPrekeyCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SignedPrekeyCreatedAt
+	}
+	return nil
+}
+
+func (x *DeviceKeysStatus) GetSignedPrekeyRotationDue() bool {
+	if x != nil {
+		return x.SignedPrekeyRotationDue
+	}
+	return false
+}
+
+func (x *DeviceKeysStatus) SetOneTimePrekeys(v int32) {
+	x.OneTimePrekeys = v
+}
+
+func (x *DeviceKeysStatus) SetOneTimePrekeysLow(v bool) {
+	x.OneTimePrekeysLow = v
+}
+
+func (x *DeviceKeysStatus) SetSignedPrekeyCreatedAt(v *timestamppb.Timestamp) {
+	x.SignedPrekeyCreatedAt = v
+}
+
+func (x *DeviceKeysStatus) SetSignedPrekeyRotationDue(v bool) {
+	x.SignedPrekeyRotationDue = v
+}
+
+func (x *DeviceKeysStatus) HasSignedPrekeyCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.SignedPrekeyCreatedAt != nil
+}
+
+func (x *DeviceKeysStatus) ClearSignedPrekeyCreatedAt() {
+	x.SignedPrekeyCreatedAt = nil
+}
+
+type DeviceKeysStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	OneTimePrekeys int32
+	// device should upload more one-time prekeys
+	OneTimePrekeysLow     bool
+	SignedPrekeyCreatedAt *timestamppb.Timestamp
+	// device should rotate its signed prekey
+	SignedPrekeyRotationDue bool
+}
+
+func (b0 DeviceKeysStatus_builder) Build() *DeviceKeysStatus {
+	m0 := &DeviceKeysStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.OneTimePrekeys = b.OneTimePrekeys
+	x.OneTimePrekeysLow = b.OneTimePrekeysLow
+	x.SignedPrekeyCreatedAt = b.SignedPrekeyCreatedAt
+	x.SignedPrekeyRotationDue = b.SignedPrekeyRotationDue
+	return m0
+}
+
+type RegisterDeviceKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId       string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IdentityKey    string                 `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPrekey   *SignedPrekey          `protobuf:"bytes,3,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	OneTimePrekeys []*OneTimePrekey       `protobuf:"bytes,4,rep,name=one_time_prekeys,json=oneTimePrekeys,proto3" json:"one_time_prekeys,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceKeysRequest) Reset() {
+	*x = RegisterDeviceKeysRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceKeysRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RegisterDeviceKeysRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeysRequest) GetIdentityKey() string {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return ""
+}
+
+func (x *RegisterDeviceKeysRequest) GetSignedPrekey() *SignedPrekey {
+	if x != nil {
+		return x.SignedPrekey
+	}
+	return nil
+}
+
+func (x *RegisterDeviceKeysRequest) GetOneTimePrekeys() []*OneTimePrekey {
+	if x != nil {
+		return x.OneTimePrekeys
+	}
+	return nil
+}
+
+func (x *RegisterDeviceKeysRequest) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+func (x *RegisterDeviceKeysRequest) SetIdentityKey(v string) {
+	x.IdentityKey = v
+}
+
+func (x *RegisterDeviceKeysRequest) SetSignedPrekey(v *SignedPrekey) {
+	x.SignedPrekey = v
+}
+
+func (x *RegisterDeviceKeysRequest) SetOneTimePrekeys(v []*OneTimePrekey) {
+	x.OneTimePrekeys = v
+}
+
+func (x *RegisterDeviceKeysRequest) HasSignedPrekey() bool {
+	if x == nil {
+		return false
+	}
+	return x.SignedPrekey != nil
+}
+
+func (x *RegisterDeviceKeysRequest) ClearSignedPrekey() {
+	x.SignedPrekey = nil
+}
+
+type RegisterDeviceKeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId       string
+	IdentityKey    string
+	SignedPrekey   *SignedPrekey
+	OneTimePrekeys []*OneTimePrekey
+}
+
+func (b0 RegisterDeviceKeysRequest_builder) Build() *RegisterDeviceKeysRequest {
+	m0 := &RegisterDeviceKeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	x.IdentityKey = b.IdentityKey
+	x.SignedPrekey = b.SignedPrekey
+	x.OneTimePrekeys = b.OneTimePrekeys
+	return m0
+}
+
+type RegisterDeviceKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	IdentityKey   *IdentityKey           `protobuf:"bytes,1,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceKeysResponse) Reset() {
+	*x = RegisterDeviceKeysResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceKeysResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RegisterDeviceKeysResponse) GetIdentityKey() *IdentityKey {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return nil
+}
+
+func (x *RegisterDeviceKeysResponse) SetIdentityKey(v *IdentityKey) {
+	x.IdentityKey = v
+}
+
+func (x *RegisterDeviceKeysResponse) HasIdentityKey() bool {
+	if x == nil {
+		return false
+	}
+	return x.IdentityKey != nil
+}
+
+func (x *RegisterDeviceKeysResponse) ClearIdentityKey() {
+	x.IdentityKey = nil
+}
+
+type RegisterDeviceKeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	IdentityKey *IdentityKey
+}
+
+func (b0 RegisterDeviceKeysResponse_builder) Build() *RegisterDeviceKeysResponse {
+	m0 := &RegisterDeviceKeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IdentityKey = b.IdentityKey
+	return m0
+}
+
+type RotateSignedPrekeyRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	SignedPrekey  *SignedPrekey          `protobuf:"bytes,2,opt,name=signed_prekey,json=signedPrekey,proto3" json:"signed_prekey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSignedPrekeyRequest) Reset() {
+	*x = RotateSignedPrekeyRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSignedPrekeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSignedPrekeyRequest) ProtoMessage() {}
+
+func (x *RotateSignedPrekeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RotateSignedPrekeyRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RotateSignedPrekeyRequest) GetSignedPrekey() *SignedPrekey {
+	if x != nil {
+		return x.SignedPrekey
+	}
+	return nil
+}
+
+func (x *RotateSignedPrekeyRequest) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+func (x *RotateSignedPrekeyRequest) SetSignedPrekey(v *SignedPrekey) {
+	x.SignedPrekey = v
+}
+
+func (x *RotateSignedPrekeyRequest) HasSignedPrekey() bool {
+	if x == nil {
+		return false
+	}
+	return x.SignedPrekey != nil
+}
+
+func (x *RotateSignedPrekeyRequest) ClearSignedPrekey() {
+	x.SignedPrekey = nil
+}
+
+type RotateSignedPrekeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId     string
+	SignedPrekey *SignedPrekey
+}
+
+func (b0 RotateSignedPrekeyRequest_builder) Build() *RotateSignedPrekeyRequest {
+	m0 := &RotateSignedPrekeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	x.SignedPrekey = b.SignedPrekey
+	return m0
+}
+
+type RotateSignedPrekeyResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSignedPrekeyResponse) Reset() {
+	*x = RotateSignedPrekeyResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSignedPrekeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSignedPrekeyResponse) ProtoMessage() {}
+
+func (x *RotateSignedPrekeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RotateSignedPrekeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RotateSignedPrekeyResponse_builder) Build() *RotateSignedPrekeyResponse {
+	m0 := &RotateSignedPrekeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type UploadOneTimePrekeysRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Prekeys       []*OneTimePrekey       `protobuf:"bytes,2,rep,name=prekeys,proto3" json:"prekeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadOneTimePrekeysRequest) Reset() {
+	*x = UploadOneTimePrekeysRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadOneTimePrekeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadOneTimePrekeysRequest) ProtoMessage() {}
+
+func (x *UploadOneTimePrekeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadOneTimePrekeysRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UploadOneTimePrekeysRequest) GetPrekeys() []*OneTimePrekey {
+	if x != nil {
+		return x.Prekeys
+	}
+	return nil
+}
+
+func (x *UploadOneTimePrekeysRequest) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+func (x *UploadOneTimePrekeysRequest) SetPrekeys(v []*OneTimePrekey) {
+	x.Prekeys = v
+}
+
+type UploadOneTimePrekeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId string
+	Prekeys  []*OneTimePrekey
+}
+
+func (b0 UploadOneTimePrekeysRequest_builder) Build() *UploadOneTimePrekeysRequest {
+	m0 := &UploadOneTimePrekeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	x.Prekeys = b.Prekeys
+	return m0
+}
+
+type UploadOneTimePrekeysResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadOneTimePrekeysResponse) Reset() {
+	*x = UploadOneTimePrekeysResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadOneTimePrekeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadOneTimePrekeysResponse) ProtoMessage() {}
+
+func (x *UploadOneTimePrekeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type UploadOneTimePrekeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 UploadOneTimePrekeysResponse_builder) Build() *UploadOneTimePrekeysResponse {
+	m0 := &UploadOneTimePrekeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetPrekeyBundlesRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrekeyBundlesRequest) Reset() {
+	*x = GetPrekeyBundlesRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrekeyBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrekeyBundlesRequest) ProtoMessage() {}
+
+func (x *GetPrekeyBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetPrekeyBundlesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPrekeyBundlesRequest) SetUserId(v int64) {
+	x.UserId = v
+}
+
+type GetPrekeyBundlesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 GetPrekeyBundlesRequest_builder) Build() *GetPrekeyBundlesRequest {
+	m0 := &GetPrekeyBundlesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserId = b.UserId
+	return m0
+}
+
+type GetPrekeyBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Bundles       []*PrekeyBundle        `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrekeyBundlesResponse) Reset() {
+	*x = GetPrekeyBundlesResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrekeyBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrekeyBundlesResponse) ProtoMessage() {}
+
+func (x *GetPrekeyBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetPrekeyBundlesResponse) GetBundles() []*PrekeyBundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+func (x *GetPrekeyBundlesResponse) SetBundles(v []*PrekeyBundle) {
+	x.Bundles = v
+}
+
+type GetPrekeyBundlesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Bundles []*PrekeyBundle
+}
+
+func (b0 GetPrekeyBundlesResponse_builder) Build() *GetPrekeyBundlesResponse {
+	m0 := &GetPrekeyBundlesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Bundles = b.Bundles
+	return m0
+}
+
+type GetIdentityKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityKeysRequest) Reset() {
+	*x = GetIdentityKeysRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityKeysRequest) ProtoMessage() {}
+
+func (x *GetIdentityKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetIdentityKeysRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetIdentityKeysRequest) SetUserId(v int64) {
+	x.UserId = v
+}
+
+type GetIdentityKeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 GetIdentityKeysRequest_builder) Build() *GetIdentityKeysRequest {
+	m0 := &GetIdentityKeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserId = b.UserId
+	return m0
+}
+
+type GetIdentityKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	IdentityKeys  []*IdentityKey         `protobuf:"bytes,1,rep,name=identity_keys,json=identityKeys,proto3" json:"identity_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdentityKeysResponse) Reset() {
+	*x = GetIdentityKeysResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdentityKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityKeysResponse) ProtoMessage() {}
+
+func (x *GetIdentityKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetIdentityKeysResponse) GetIdentityKeys() []*IdentityKey {
+	if x != nil {
+		return x.IdentityKeys
+	}
+	return nil
+}
+
+func (x *GetIdentityKeysResponse) SetIdentityKeys(v []*IdentityKey) {
+	x.IdentityKeys = v
+}
+
+type GetIdentityKeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	IdentityKeys []*IdentityKey
+}
+
+func (b0 GetIdentityKeysResponse_builder) Build() *GetIdentityKeysResponse {
+	m0 := &GetIdentityKeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.IdentityKeys = b.IdentityKeys
+	return m0
+}
+
+type GetDeviceKeysStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceKeysStatusRequest) Reset() {
+	*x = GetDeviceKeysStatusRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceKeysStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceKeysStatusRequest) ProtoMessage() {}
+
+func (x *GetDeviceKeysStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDeviceKeysStatusRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *GetDeviceKeysStatusRequest) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+type GetDeviceKeysStatusRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId string
+}
+
+func (b0 GetDeviceKeysStatusRequest_builder) Build() *GetDeviceKeysStatusRequest {
+	m0 := &GetDeviceKeysStatusRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	return m0
+}
+
+type GetDeviceKeysStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Status        *DeviceKeysStatus      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceKeysStatusResponse) Reset() {
+	*x = GetDeviceKeysStatusResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceKeysStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceKeysStatusResponse) ProtoMessage() {}
+
+func (x *GetDeviceKeysStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDeviceKeysStatusResponse) GetStatus() *DeviceKeysStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetDeviceKeysStatusResponse) SetStatus(v *DeviceKeysStatus) {
+	x.Status = v
+}
+
+func (x *GetDeviceKeysStatusResponse) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.Status != nil
+}
+
+func (x *GetDeviceKeysStatusResponse) ClearStatus() {
+	x.Status = nil
+}
+
+type GetDeviceKeysStatusResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Status *DeviceKeysStatus
+}
+
+func (b0 GetDeviceKeysStatusResponse_builder) Build() *GetDeviceKeysStatusResponse {
+	m0 := &GetDeviceKeysStatusResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Status = b.Status
+	return m0
+}
+
+type RemoveDeviceKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceKeysRequest) Reset() {
+	*x = RemoveDeviceKeysRequest{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceKeysRequest) ProtoMessage() {}
+
+func (x *RemoveDeviceKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RemoveDeviceKeysRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *RemoveDeviceKeysRequest) SetDeviceId(v string) {
+	x.DeviceId = v
+}
+
+type RemoveDeviceKeysRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceId string
+}
+
+func (b0 RemoveDeviceKeysRequest_builder) Build() *RemoveDeviceKeysRequest {
+	m0 := &RemoveDeviceKeysRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DeviceId = b.DeviceId
+	return m0
+}
+
+type RemoveDeviceKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDeviceKeysResponse) Reset() {
+	*x = RemoveDeviceKeysResponse{}
+	mi := &file_auth_v1_key_directory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDeviceKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDeviceKeysResponse) ProtoMessage() {}
+
+func (x *RemoveDeviceKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_key_directory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type RemoveDeviceKeysResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 RemoveDeviceKeysResponse_builder) Build() *RemoveDeviceKeysResponse {
+	m0 := &RemoveDeviceKeysResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+var File_auth_v1_key_directory_proto protoreflect.FileDescriptor
+
+const file_auth_v1_key_directory_proto_rawDesc = "" +
+	"\n" +
+	"\x1bauth/v1/key_directory.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"b\n" +
+	"\fSignedPrekey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x05R\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\"E\n" +
+	"\rOneTimePrekey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\x05R\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\"\x84\x01\n" +
+	"\vIdentityKey\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc3\x01\n" +
+	"\fPrekeyBundle\x127\n" +
+	"\fidentity_key\x18\x01 \x01(\v2\x14.auth.v1.IdentityKeyR\videntityKey\x12:\n" +
+	"\rsigned_prekey\x18\x02 \x01(\v2\x15.auth.v1.SignedPrekeyR\fsignedPrekey\x12>\n" +
+	"\x0fone_time_prekey\x18\x03 \x01(\v2\x16.auth.v1.OneTimePrekeyR\roneTimePrekey\"\xff\x01\n" +
+	"\x10DeviceKeysStatus\x12(\n" +
+	"\x10one_time_prekeys\x18\x01 \x01(\x05R\x0eoneTimePrekeys\x12/\n" +
+	"\x14one_time_prekeys_low\x18\x02 \x01(\bR\x11oneTimePrekeysLow\x12S\n" +
+	"\x18signed_prekey_created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x15signedPrekeyCreatedAt\x12;\n" +
+	"\x1asigned_prekey_rotation_due\x18\x04 \x01(\bR\x17signedPrekeyRotationDue\"\xd9\x01\n" +
+	"\x19RegisterDeviceKeysRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12!\n" +
+	"\fidentity_key\x18\x02 \x01(\tR\videntityKey\x12:\n" +
+	"\rsigned_prekey\x18\x03 \x01(\v2\x15.auth.v1.SignedPrekeyR\fsignedPrekey\x12@\n" +
+	"\x10one_time_prekeys\x18\x04 \x03(\v2\x16.auth.v1.OneTimePrekeyR\x0eoneTimePrekeys\"U\n" +
+	"\x1aRegisterDeviceKeysResponse\x127\n" +
+	"\fidentity_key\x18\x01 \x01(\v2\x14.auth.v1.IdentityKeyR\videntityKey\"t\n" +
+	"\x19RotateSignedPrekeyRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12:\n" +
+	"\rsigned_prekey\x18\x02 \x01(\v2\x15.auth.v1.SignedPrekeyR\fsignedPrekey\"\x1c\n" +
+	"\x1aRotateSignedPrekeyResponse\"l\n" +
+	"\x1bUploadOneTimePrekeysRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x120\n" +
+	"\aprekeys\x18\x02 \x03(\v2\x16.auth.v1.OneTimePrekeyR\aprekeys\"\x1e\n" +
+	"\x1cUploadOneTimePrekeysResponse\"2\n" +
+	"\x17GetPrekeyBundlesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"K\n" +
+	"\x18GetPrekeyBundlesResponse\x12/\n" +
+	"\abundles\x18\x01 \x03(\v2\x15.auth.v1.PrekeyBundleR\abundles\"1\n" +
+	"\x16GetIdentityKeysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"T\n" +
+	"\x17GetIdentityKeysResponse\x129\n" +
+	"\ridentity_keys\x18\x01 \x03(\v2\x14.auth.v1.IdentityKeyR\fidentityKeys\"9\n" +
+	"\x1aGetDeviceKeysStatusRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\"P\n" +
+	"\x1bGetDeviceKeysStatusResponse\x121\n" +
+	"\x06status\x18\x01 \x01(\v2\x19.auth.v1.DeviceKeysStatusR\x06status\"6\n" +
+	"\x17RemoveDeviceKeysRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\"\x1a\n" +
+	"\x18RemoveDeviceKeysResponse2\xa2\x05\n" +
+	"\x13KeyDirectoryService\x12]\n" +
+	"\x12RegisterDeviceKeys\x12\".auth.v1.RegisterDeviceKeysRequest\x1a#.auth.v1.RegisterDeviceKeysResponse\x12]\n" +
+	"\x12RotateSignedPrekey\x12\".auth.v1.RotateSignedPrekeyRequest\x1a#.auth.v1.RotateSignedPrekeyResponse\x12c\n" +
+	"\x14UploadOneTimePrekeys\x12$.auth.v1.UploadOneTimePrekeysRequest\x1a%.auth.v1.UploadOneTimePrekeysResponse\x12W\n" +
+	"\x10GetPrekeyBundles\x12 .auth.v1.GetPrekeyBundlesRequest\x1a!.auth.v1.GetPrekeyBundlesResponse\x12T\n" +
+	"\x0fGetIdentityKeys\x12\x1f.auth.v1.GetIdentityKeysRequest\x1a .auth.v1.GetIdentityKeysResponse\x12`\n" +
+	"\x13GetDeviceKeysStatus\x12#.auth.v1.GetDeviceKeysStatusRequest\x1a$.auth.v1.GetDeviceKeysStatusResponse\x12W\n" +
+	"\x10RemoveDeviceKeys\x12 .auth.v1.RemoveDeviceKeysRequest\x1a!.auth.v1.RemoveDeviceKeysResponseBEZCbuf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1;authv1b\x06proto3"
+
+var file_auth_v1_key_directory_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_auth_v1_key_directory_proto_goTypes = []any{
+	(*SignedPrekey)(nil),                 // 0: auth.v1.SignedPrekey
+	(*OneTimePrekey)(nil),                // 1: auth.v1.OneTimePrekey
+	(*IdentityKey)(nil),                  // 2: auth.v1.IdentityKey
+	(*PrekeyBundle)(nil),                 // 3: auth.v1.PrekeyBundle
+	(*DeviceKeysStatus)(nil),             // 4: auth.v1.DeviceKeysStatus
+	(*RegisterDeviceKeysRequest)(nil),    // 5: auth.v1.RegisterDeviceKeysRequest
+	(*RegisterDeviceKeysResponse)(nil),   // 6: auth.v1.RegisterDeviceKeysResponse
+	(*RotateSignedPrekeyRequest)(nil),    // 7: auth.v1.RotateSignedPrekeyRequest
+	(*RotateSignedPrekeyResponse)(nil),   // 8: auth.v1.RotateSignedPrekeyResponse
+	(*UploadOneTimePrekeysRequest)(nil),  // 9: auth.v1.UploadOneTimePrekeysRequest
+	(*UploadOneTimePrekeysResponse)(nil), // 10: auth.v1.UploadOneTimePrekeysResponse
+	(*GetPrekeyBundlesRequest)(nil),      // 11: auth.v1.GetPrekeyBundlesRequest
+	(*GetPrekeyBundlesResponse)(nil),     // 12: auth.v1.GetPrekeyBundlesResponse
+	(*GetIdentityKeysRequest)(nil),       // 13: auth.v1.GetIdentityKeysRequest
+	(*GetIdentityKeysResponse)(nil),      // 14: auth.v1.GetIdentityKeysResponse
+	(*GetDeviceKeysStatusRequest)(nil),   // 15: auth.v1.GetDeviceKeysStatusRequest
+	(*GetDeviceKeysStatusResponse)(nil),  // 16: auth.v1.GetDeviceKeysStatusResponse
+	(*RemoveDeviceKeysRequest)(nil),      // 17: auth.v1.RemoveDeviceKeysRequest
+	(*RemoveDeviceKeysResponse)(nil),     // 18: auth.v1.RemoveDeviceKeysResponse
+	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
+}
+var file_auth_v1_key_directory_proto_depIdxs = []int32{
+	19, // 0: auth.v1.IdentityKey.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: auth.v1.PrekeyBundle.identity_key:type_name -> auth.v1.IdentityKey
+	0,  // 2: auth.v1.PrekeyBundle.signed_prekey:type_name -> auth.v1.SignedPrekey
+	1,  // 3: auth.v1.PrekeyBundle.one_time_prekey:type_name -> auth.v1.OneTimePrekey
+	19, // 4: auth.v1.DeviceKeysStatus.signed_prekey_created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: auth.v1.RegisterDeviceKeysRequest.signed_prekey:type_name -> auth.v1.SignedPrekey
+	1,  // 6: auth.v1.RegisterDeviceKeysRequest.one_time_prekeys:type_name -> auth.v1.OneTimePrekey
+	2,  // 7: auth.v1.RegisterDeviceKeysResponse.identity_key:type_name -> auth.v1.IdentityKey
+	0,  // 8: auth.v1.RotateSignedPrekeyRequest.signed_prekey:type_name -> auth.v1.SignedPrekey
+	1,  // 9: auth.v1.UploadOneTimePrekeysRequest.prekeys:type_name -> auth.v1.OneTimePrekey
+	3,  // 10: auth.v1.GetPrekeyBundlesResponse.bundles:type_name -> auth.v1.PrekeyBundle
+	2,  // 11: auth.v1.GetIdentityKeysResponse.identity_keys:type_name -> auth.v1.IdentityKey
+	4,  // 12: auth.v1.GetDeviceKeysStatusResponse.status:type_name -> auth.v1.DeviceKeysStatus
+	5,  // 13: auth.v1.KeyDirectoryService.RegisterDeviceKeys:input_type -> auth.v1.RegisterDeviceKeysRequest
+	7,  // 14: auth.v1.KeyDirectoryService.RotateSignedPrekey:input_type -> auth.v1.RotateSignedPrekeyRequest
+	9,  // 15: auth.v1.KeyDirectoryService.UploadOneTimePrekeys:input_type -> auth.v1.UploadOneTimePrekeysRequest
+	11, // 16: auth.v1.KeyDirectoryService.GetPrekeyBundles:input_type -> auth.v1.GetPrekeyBundlesRequest
+	13, // 17: auth.v1.KeyDirectoryService.GetIdentityKeys:input_type -> auth.v1.GetIdentityKeysRequest
+	15, // 18: auth.v1.KeyDirectoryService.GetDeviceKeysStatus:input_type -> auth.v1.GetDeviceKeysStatusRequest
+	17, // 19: auth.v1.KeyDirectoryService.RemoveDeviceKeys:input_type -> auth.v1.RemoveDeviceKeysRequest
+	6,  // 20: auth.v1.KeyDirectoryService.RegisterDeviceKeys:output_type -> auth.v1.RegisterDeviceKeysResponse
+	8,  // 21: auth.v1.KeyDirectoryService.RotateSignedPrekey:output_type -> auth.v1.RotateSignedPrekeyResponse
+	10, // 22: auth.v1.KeyDirectoryService.UploadOneTimePrekeys:output_type -> auth.v1.UploadOneTimePrekeysResponse
+	12, // 23: auth.v1.KeyDirectoryService.GetPrekeyBundles:output_type -> auth.v1.GetPrekeyBundlesResponse
+	14, // 24: auth.v1.KeyDirectoryService.GetIdentityKeys:output_type -> auth.v1.GetIdentityKeysResponse
+	16, // 25: auth.v1.KeyDirectoryService.GetDeviceKeysStatus:output_type -> auth.v1.GetDeviceKeysStatusResponse
+	18, // 26: auth.v1.KeyDirectoryService.RemoveDeviceKeys:output_type -> auth.v1.RemoveDeviceKeysResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_key_directory_proto_init() }
+func file_auth_v1_key_directory_proto_init() {
+	if File_auth_v1_key_directory_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_key_directory_proto_rawDesc), len(file_auth_v1_key_directory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_key_directory_proto_goTypes,
+		DependencyIndexes: file_auth_v1_key_directory_proto_depIdxs,
+		MessageInfos:      file_auth_v1_key_directory_proto_msgTypes,
+	}.Build()
+	File_auth_v1_key_directory_proto = out.File
+	file_auth_v1_key_directory_proto_goTypes = nil
+	file_auth_v1_key_directory_proto_depIdxs = nil
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: auth/v1/permissions.proto
+
+//go:build !protoopaque
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUserPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionsRequest) Reset() {
+	*x = GetUserPermissionsRequest{}
+	mi := &file_auth_v1_permissions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsRequest) ProtoMessage() {}
+
+func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_permissions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserPermissionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserPermissionsRequest) SetUserId(v int64) {
+	x.UserId = v
+}
+
+type GetUserPermissionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId int64
+}
+
+func (b0 GetUserPermissionsRequest_builder) Build() *GetUserPermissionsRequest {
+	m0 := &GetUserPermissionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.UserId = b.UserId
+	return m0
+}
+
+type GetUserPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// e.g "users:read", empty for inactive accounts
+	Permissions   []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionsResponse) Reset() {
+	*x = GetUserPermissionsResponse{}
+	mi := &file_auth_v1_permissions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsResponse) ProtoMessage() {}
+
+func (x *GetUserPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_permissions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUserPermissionsResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GetUserPermissionsResponse) SetPermissions(v []string) {
+	x.Permissions = v
+}
+
+type GetUserPermissionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// e.g "users:read", empty for inactive accounts
+	Permissions []string
+}
+
+func (b0 GetUserPermissionsResponse_builder) Build() *GetUserPermissionsResponse {
+	m0 := &GetUserPermissionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Permissions = b.Permissions
+	return m0
+}
+
+var File_auth_v1_permissions_proto protoreflect.FileDescriptor
+
+const file_auth_v1_permissions_proto_rawDesc = "" +
+	"\n" +
+	"\x19auth/v1/permissions.proto\x12\aauth.v1\"4\n" +
+	"\x19GetUserPermissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
+	"\x1aGetUserPermissionsResponse\x12 \n" +
+	"\vpermissions\x18\x01 \x03(\tR\vpermissions2s\n" +
+	"\x12PermissionsService\x12]\n" +
+	"\x12GetUserPermissions\x12\".auth.v1.GetUserPermissionsRequest\x1a#.auth.v1.GetUserPermissionsResponseBEZCbuf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1;authv1b\x06proto3"
+
+var file_auth_v1_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_auth_v1_permissions_proto_goTypes = []any{
+	(*GetUserPermissionsRequest)(nil),  // 0: auth.v1.GetUserPermissionsRequest
+	(*GetUserPermissionsResponse)(nil), // 1: auth.v1.GetUserPermissionsResponse
+}
+var file_auth_v1_permissions_proto_depIdxs = []int32{
+	0, // 0: auth.v1.PermissionsService.GetUserPermissions:input_type -> auth.v1.GetUserPermissionsRequest
+	1, // 1: auth.v1.PermissionsService.GetUserPermissions:output_type -> auth.v1.GetUserPermissionsResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_permissions_proto_init() }
+func file_auth_v1_permissions_proto_init() {
+	if File_auth_v1_permissions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_permissions_proto_rawDesc), len(file_auth_v1_permissions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_permissions_proto_goTypes,
+		DependencyIndexes: file_auth_v1_permissions_proto_depIdxs,
+		MessageInfos:      file_auth_v1_permissions_proto_msgTypes,
+	}.Build()
+	File_auth_v1_permissions_proto = out.File
+	file_auth_v1_permissions_proto_goTypes = nil
+	file_auth_v1_permissions_proto_depIdxs = nil
+}
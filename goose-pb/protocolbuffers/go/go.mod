@@ -0,0 +1,5 @@
+module buf.build/gen/go/co3n/goose-proto/protocolbuffers/go
+
+go 1.23
+
+require google.golang.org/protobuf v1.36.11
@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: users/v1/user.proto
+
+//go:build protoopaque
+
+package usersv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TwoFactorAuth_TwoFaMethod int32
+
+const (
+	TwoFactorAuth_TWO_FA_METHOD_UNSPECIFIED TwoFactorAuth_TwoFaMethod = 0
+	TwoFactorAuth_TWO_FA_METHOD_EMAIL       TwoFactorAuth_TwoFaMethod = 1
+	TwoFactorAuth_TWO_FA_METHOD_SMS         TwoFactorAuth_TwoFaMethod = 2
+	TwoFactorAuth_TWO_FA_METHOD_TELEGRAM    TwoFactorAuth_TwoFaMethod = 3
+	TwoFactorAuth_TWO_FA_METHOD_TOTP        TwoFactorAuth_TwoFaMethod = 4
+)
+
+// Enum value maps for TwoFactorAuth_TwoFaMethod.
+var (
+	TwoFactorAuth_TwoFaMethod_name = map[int32]string{
+		0: "TWO_FA_METHOD_UNSPECIFIED",
+		1: "TWO_FA_METHOD_EMAIL",
+		2: "TWO_FA_METHOD_SMS",
+		3: "TWO_FA_METHOD_TELEGRAM",
+		4: "TWO_FA_METHOD_TOTP",
+	}
+	TwoFactorAuth_TwoFaMethod_value = map[string]int32{
+		"TWO_FA_METHOD_UNSPECIFIED": 0,
+		"TWO_FA_METHOD_EMAIL":       1,
+		"TWO_FA_METHOD_SMS":         2,
+		"TWO_FA_METHOD_TELEGRAM":    3,
+		"TWO_FA_METHOD_TOTP":        4,
+	}
+)
+
+func (x TwoFactorAuth_TwoFaMethod) Enum() *TwoFactorAuth_TwoFaMethod {
+	p := new(TwoFactorAuth_TwoFaMethod)
+	*p = x
+	return p
+}
+
+func (x TwoFactorAuth_TwoFaMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TwoFactorAuth_TwoFaMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_users_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (TwoFactorAuth_TwoFaMethod) Type() protoreflect.EnumType {
+	return &file_users_v1_user_proto_enumTypes[0]
+}
+
+func (x TwoFactorAuth_TwoFaMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type User struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id            int64                  `protobuf:"varint,1,opt,name=id,proto3"`
+	xxx_hidden_Username      string                 `protobuf:"bytes,2,opt,name=username,proto3"`
+	xxx_hidden_Email         string                 `protobuf:"bytes,3,opt,name=email,proto3"`
+	xxx_hidden_CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3"`
+	xxx_hidden_UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3"`
+	xxx_hidden_TwoFactorAuth *TwoFactorAuth         `protobuf:"bytes,6,opt,name=two_factor_auth,json=twoFactorAuth,proto3"`
+	xxx_hidden_FirstName     string                 `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3"`
+	xxx_hidden_LastName      string                 `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3"`
+	xxx_hidden_AboutMe       string                 `protobuf:"bytes,9,opt,name=about_me,json=aboutMe,proto3"`
+	xxx_hidden_PhotoUrl      string                 `protobuf:"bytes,10,opt,name=photo_url,json=photoUrl,proto3"`
+	xxx_hidden_BirthDate     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=birth_date,json=birthDate,proto3"`
+	xxx_hidden_PhoneNumber   string                 `protobuf:"bytes,12,opt,name=phone_number,json=phoneNumber,proto3"`
+	xxx_hidden_Language      string                 `protobuf:"bytes,13,opt,name=language,proto3"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_users_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.xxx_hidden_Username
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.xxx_hidden_Email
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *User) GetTwoFactorAuth() *TwoFactorAuth {
+	if x != nil {
+		return x.xxx_hidden_TwoFactorAuth
+	}
+	return nil
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.xxx_hidden_FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.xxx_hidden_LastName
+	}
+	return ""
+}
+
+func (x *User) GetAboutMe() string {
+	if x != nil {
+		return x.xxx_hidden_AboutMe
+	}
+	return ""
+}
+
+func (x *User) GetPhotoUrl() string {
+	if x != nil {
+		return x.xxx_hidden_PhotoUrl
+	}
+	return ""
+}
+
+func (x *User) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_BirthDate
+	}
+	return nil
+}
+
+func (x *User) GetPhoneNumber() string {
+	if x != nil {
+		return x.xxx_hidden_PhoneNumber
+	}
+	return ""
+}
+
+func (x *User) GetLanguage() string {
+	if x != nil {
+		return x.xxx_hidden_Language
+	}
+	return ""
+}
+
+func (x *User) SetId(v int64) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *User) SetUsername(v string) {
+	x.xxx_hidden_Username = v
+}
+
+func (x *User) SetEmail(v string) {
+	x.xxx_hidden_Email = v
+}
+
+func (x *User) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *User) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *User) SetTwoFactorAuth(v *TwoFactorAuth) {
+	x.xxx_hidden_TwoFactorAuth = v
+}
+
+func (x *User) SetFirstName(v string) {
+	x.xxx_hidden_FirstName = v
+}
+
+func (x *User) SetLastName(v string) {
+	x.xxx_hidden_LastName = v
+}
+
+func (x *User) SetAboutMe(v string) {
+	x.xxx_hidden_AboutMe = v
+}
+
+func (x *User) SetPhotoUrl(v string) {
+	x.xxx_hidden_PhotoUrl = v
+}
+
+func (x *User) SetBirthDate(v *timestamppb.Timestamp) {
+	x.xxx_hidden_BirthDate = v
+}
+
+func (x *User) SetPhoneNumber(v string) {
+	x.xxx_hidden_PhoneNumber = v
+}
+
+func (x *User) SetLanguage(v string) {
+	x.xxx_hidden_Language = v
+}
+
+func (x *User) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *User) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *User) HasTwoFactorAuth() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TwoFactorAuth != nil
+}
+
+func (x *User) HasBirthDate() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BirthDate != nil
+}
+
+func (x *User) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *User) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *User) ClearTwoFactorAuth() {
+	x.xxx_hidden_TwoFactorAuth = nil
+}
+
+func (x *User) ClearBirthDate() {
+	x.xxx_hidden_BirthDate = nil
+}
+
+type User_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id            int64
+	Username      string
+	Email         string
+	CreatedAt     *timestamppb.Timestamp
+	UpdatedAt     *timestamppb.Timestamp
+	TwoFactorAuth *TwoFactorAuth
+	FirstName     string
+	LastName      string
+	AboutMe       string
+	PhotoUrl      string
+	BirthDate     *timestamppb.Timestamp
+	PhoneNumber   string
+	Language      string
+}
+
+func (b0 User_builder) Build() *User {
+	m0 := &User{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Username = b.Username
+	x.xxx_hidden_Email = b.Email
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_TwoFactorAuth = b.TwoFactorAuth
+	x.xxx_hidden_FirstName = b.FirstName
+	x.xxx_hidden_LastName = b.LastName
+	x.xxx_hidden_AboutMe = b.AboutMe
+	x.xxx_hidden_PhotoUrl = b.PhotoUrl
+	x.xxx_hidden_BirthDate = b.BirthDate
+	x.xxx_hidden_PhoneNumber = b.PhoneNumber
+	x.xxx_hidden_Language = b.Language
+	return m0
+}
+
+type TwoFactorAuth struct {
+	state                 protoimpl.MessageState    `protogen:"opaque.v1"`
+	xxx_hidden_UserId     int64                     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3"`
+	xxx_hidden_Method     TwoFactorAuth_TwoFaMethod `protobuf:"varint,2,opt,name=method,proto3,enum=users.v1.TwoFactorAuth_TwoFaMethod"`
+	xxx_hidden_Contact    string                    `protobuf:"bytes,3,opt,name=contact,proto3"`
+	xxx_hidden_TotpSecret []byte                    `protobuf:"bytes,4,opt,name=totp_secret,json=totpSecret,proto3"`
+	xxx_hidden_Enabled    bool                      `protobuf:"varint,5,opt,name=enabled,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TwoFactorAuth) Reset() {
+	*x = TwoFactorAuth{}
+	mi := &file_users_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorAuth) ProtoMessage() {}
+
+func (x *TwoFactorAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_users_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TwoFactorAuth) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *TwoFactorAuth) GetMethod() TwoFactorAuth_TwoFaMethod {
+	if x != nil {
+		return x.xxx_hidden_Method
+	}
+	return TwoFactorAuth_TWO_FA_METHOD_UNSPECIFIED
+}
+
+func (x *TwoFactorAuth) GetContact() string {
+	if x != nil {
+		return x.xxx_hidden_Contact
+	}
+	return ""
+}
+
+func (x *TwoFactorAuth) GetTotpSecret() []byte {
+	if x != nil {
+		return x.xxx_hidden_TotpSecret
+	}
+	return nil
+}
+
+func (x *TwoFactorAuth) GetEnabled() bool {
+	if x != nil {
+		return x.xxx_hidden_Enabled
+	}
+	return false
+}
+
+func (x *TwoFactorAuth) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+}
+
+func (x *TwoFactorAuth) SetMethod(v TwoFactorAuth_TwoFaMethod) {
+	x.xxx_hidden_Method = v
+}
+
+func (x *TwoFactorAuth) SetContact(v string) {
+	x.xxx_hidden_Contact = v
+}
+
+func (x *TwoFactorAuth) SetTotpSecret(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_TotpSecret = v
+}
+
+func (x *TwoFactorAuth) SetEnabled(v bool) {
+	x.xxx_hidden_Enabled = v
+}
+
+type TwoFactorAuth_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// id of the user which twofa belongs to
+	UserId int64
+	// delivery method (sms, email, telegram, totp, etc.)
+	Method TwoFactorAuth_TwoFaMethod
+	// could be user's telegram, email address, etc.
+	// or optional depending on transport (can be derived from user entity)
+	Contact string
+	// secret key required for OTP generation if TOTP delivery method is used
+	TotpSecret []byte
+	// indicates whether user has 2fa enabled.
+	// true by default, but can be disabled by the user
+	Enabled bool
+}
+
+func (b0 TwoFactorAuth_builder) Build() *TwoFactorAuth {
+	m0 := &TwoFactorAuth{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UserId = b.UserId
+	x.xxx_hidden_Method = b.Method
+	x.xxx_hidden_Contact = b.Contact
+	x.xxx_hidden_TotpSecret = b.TotpSecret
+	x.xxx_hidden_Enabled = b.Enabled
+	return m0
+}
+
+var File_users_v1_user_proto protoreflect.FileDescriptor
+
+const file_users_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x13users/v1/user.proto\x12\busers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x03\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
+	"\x0ftwo_factor_auth\x18\x06 \x01(\v2\x17.users.v1.TwoFactorAuthR\rtwoFactorAuth\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\a \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\b \x01(\tR\blastName\x12\x19\n" +
+	"\babout_me\x18\t \x01(\tR\aaboutMe\x12\x1b\n" +
+	"\tphoto_url\x18\n" +
+	" \x01(\tR\bphotoUrl\x129\n" +
+	"\n" +
+	"birth_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12!\n" +
+	"\fphone_number\x18\f \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\blanguage\x18\r \x01(\tR\blanguage\"\xcd\x02\n" +
+	"\rTwoFactorAuth\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12;\n" +
+	"\x06method\x18\x02 \x01(\x0e2#.users.v1.TwoFactorAuth.TwoFaMethodR\x06method\x12\x18\n" +
+	"\acontact\x18\x03 \x01(\tR\acontact\x12\x1f\n" +
+	"\vtotp_secret\x18\x04 \x01(\fR\n" +
+	"totpSecret\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\x90\x01\n" +
+	"\vTwoFaMethod\x12\x1d\n" +
+	"\x19TWO_FA_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TWO_FA_METHOD_EMAIL\x10\x01\x12\x15\n" +
+	"\x11TWO_FA_METHOD_SMS\x10\x02\x12\x1a\n" +
+	"\x16TWO_FA_METHOD_TELEGRAM\x10\x03\x12\x16\n" +
+	"\x12TWO_FA_METHOD_TOTP\x10\x04BGZEbuf.build/gen/go/co3n/goose-proto/protocolbuffers/go/users/v1;usersv1b\x06proto3"
+
+var file_users_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_users_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_users_v1_user_proto_goTypes = []any{
+	(TwoFactorAuth_TwoFaMethod)(0), // 0: users.v1.TwoFactorAuth.TwoFaMethod
+	(*User)(nil),                   // 1: users.v1.User
+	(*TwoFactorAuth)(nil),          // 2: users.v1.TwoFactorAuth
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+}
+var file_users_v1_user_proto_depIdxs = []int32{
+	3, // 0: users.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: users.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	2, // 2: users.v1.User.two_factor_auth:type_name -> users.v1.TwoFactorAuth
+	3, // 3: users.v1.User.birth_date:type_name -> google.protobuf.Timestamp
+	0, // 4: users.v1.TwoFactorAuth.method:type_name -> users.v1.TwoFactorAuth.TwoFaMethod
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_users_v1_user_proto_init() }
+func file_users_v1_user_proto_init() {
+	if File_users_v1_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_v1_user_proto_rawDesc), len(file_users_v1_user_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_users_v1_user_proto_goTypes,
+		DependencyIndexes: file_users_v1_user_proto_depIdxs,
+		EnumInfos:         file_users_v1_user_proto_enumTypes,
+		MessageInfos:      file_users_v1_user_proto_msgTypes,
+	}.Build()
+	File_users_v1_user_proto = out.File
+	file_users_v1_user_proto_goTypes = nil
+	file_users_v1_user_proto_depIdxs = nil
+}
@@ -1,6 +1,9 @@
 package notifications
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 type EmailType string
 
@@ -11,6 +14,22 @@ var (
 	EMAIL_TYPE_LOGIN_NEW_DEVICE    EmailType = "login_new_device"
 	EMAIL_TYPE_EMAIL_TWO_FA        EmailType = "email_two_fa"
 	EMAIL_TYPE_TWO_FA_CONFIRMED    EmailType = "two_fa_confirmed"
+	EMAIL_TYPE_LOGIN_CHALLENGE     EmailType = "login_challenge"
+	EMAIL_TYPE_PASSWORD_RESET      EmailType = "password_reset"
+	EMAIL_TYPE_SESSION_EVICTED     EmailType = "session_evicted"
+	EMAIL_TYPE_DELETION_SCHEDULED  EmailType = "deletion_scheduled"
+	EMAIL_TYPE_DELETION_REMINDER   EmailType = "deletion_reminder"
+	EMAIL_TYPE_DATA_EXPORT_READY   EmailType = "data_export_ready"
+	EMAIL_TYPE_ACCOUNT_STATE       EmailType = "account_state_changed"
+	EMAIL_TYPE_ACCOUNT_REACTIVATE  EmailType = "account_reactivate"
+	EMAIL_TYPE_MAGIC_LINK          EmailType = "magic_link"
+)
+
+// NotificationType is a kind of in-app notification delivered to user's devices
+type NotificationType string
+
+var (
+	NOTIFICATION_TYPE_PREKEYS_LOW NotificationType = "prekeys_low"
 )
 
 type Language string
@@ -27,6 +46,14 @@ type EmailMessage struct {
 	Data     json.RawMessage
 }
 
+// NotificationMessage is delivered to all online devices of a user unless DeviceId is set
+type NotificationMessage struct {
+	Type     NotificationType
+	UserId   int
+	DeviceId string
+	Data     json.RawMessage
+}
+
 type SignUpNotice struct {
 	Username string
 }
@@ -55,4 +82,80 @@ type LoginNewDeviceNotice struct {
 	IpAddr     string
 	DeviceInfo string
 	Location   string
+	// DeviceName is human readable device description e.g "Chrome 120 on Windows 10"
+	DeviceName     string
+	DeviceType     string
+	DevicePlatform string
+	DeviceClient   string
+	// ApproveToken and DenyToken are single-use answers to "was this you?" question.
+	// Both are empty if confirmation could not be issued
+	ApproveToken string
+	DenyToken    string
+}
+
+// LoginChallengeNotice contains code confirming suspicious sign in attempt
+type LoginChallengeNotice struct {
+	Username string
+	Code     string
+	IpAddr   string
+	Location string
+}
+
+type PasswordResetNotice struct {
+	Username string
+	Code     string
+}
+
+// SessionEvictedNotice describes session which was signed out because of concurrent sessions limit
+type SessionEvictedNotice struct {
+	Username   string
+	IpAddr     string
+	DeviceName string
+	Location   string
+	LastSeenAt time.Time
+}
+
+// PrekeysLowNotice asks device to upload more one-time prekeys
+// and to rotate its signed prekey if it is due
+type PrekeysLowNotice struct {
+	Remaining               int
+	SignedPrekeyRotationDue bool
+}
+
+// AccountDeletionNotice is sent when deletion is requested and once again shortly before account is erased
+type AccountDeletionNotice struct {
+	Username    string
+	ScheduledAt time.Time
+}
+
+// DataExportReadyNotice contains token which downloads archive with user's personal data until ExpiresAt
+type DataExportReadyNotice struct {
+	Username      string
+	DownloadToken string
+	ExpiresAt     time.Time
+}
+
+// AccountStateNotice tells user that their account was locked, suspended, banned or restored.
+// Until is nil if state is not temporary
+type AccountStateNotice struct {
+	Username string
+	State    string
+	Reason   string
+	Until    *time.Time
+}
+
+// AccountReactivationNotice contains code which reactivates deactivated or locked account
+type AccountReactivationNotice struct {
+	Username string
+	Code     string
+}
+
+// MagicLinkNotice signs user in without password. Token is a part of sign in link and Code may be entered instead.
+// IpAddr and Location describe where sign in was requested from
+type MagicLinkNotice struct {
+	Username string
+	Token    string
+	Code     string
+	IpAddr   string
+	Location string
 }
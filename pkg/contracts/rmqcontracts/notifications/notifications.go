@@ -21,7 +21,7 @@ func New() *Contracts {
 				Durable: true,
 			},
 			Notifications: rmqcontracts.Queue{
-				Name:    "emails",
+				Name:    "notifications",
 				Durable: true,
 			},
 		},
@@ -0,0 +1,30 @@
+package security
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AlertType string
+
+var (
+	ALERT_TYPE_SUSPICIOUS_LOGIN AlertType = "suspicious_login"
+)
+
+type AlertMessage struct {
+	Type       AlertType
+	OccurredAt time.Time
+	Data       json.RawMessage
+}
+
+type SuspiciousLoginAlert struct {
+	UserId     int
+	Email      string
+	IpAddr     string
+	DeviceInfo string
+	Location   string
+	Score      int
+	Signals    []string
+	// Challenged is true if login was suspended until user passes step-up verification
+	Challenged bool
+}
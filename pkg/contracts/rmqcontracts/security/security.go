@@ -0,0 +1,24 @@
+package security
+
+import (
+	"github.com/modulix-systems/goose-talk/contracts/rmqcontracts"
+)
+
+type Contracts struct {
+	Queues Queues
+}
+
+type Queues struct {
+	Alerts rmqcontracts.Queue
+}
+
+func New() *Contracts {
+	return &Contracts{
+		Queues: Queues{
+			Alerts: rmqcontracts.Queue{
+				Name:    "security_alerts",
+				Durable: true,
+			},
+		},
+	}
+}
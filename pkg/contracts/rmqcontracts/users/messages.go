@@ -0,0 +1,42 @@
+package users
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EventType string
+
+var (
+	EVENT_TYPE_USER_DELETED               EventType = "user.deleted"
+	EVENT_TYPE_USER_DATA_EXPORT_REQUESTED EventType = "user.data_export_requested"
+)
+
+type EventMessage struct {
+	Type       EventType
+	OccurredAt time.Time
+	Data       json.RawMessage
+}
+
+// UserDeletedEvent is published once account is erased.
+// Consumers must purge or tombstone content owned by the user
+type UserDeletedEvent struct {
+	UserId    int
+	DeletedAt time.Time
+}
+
+// DataExportRequestedEvent asks services to collect personal data they hold about the user.
+// Each service replies with a single DataExportPart sent to ReplyQueue
+type DataExportRequestedEvent struct {
+	ExportId   string
+	UserId     int
+	ReplyQueue string
+}
+
+// DataExportPart is service's reply to DataExportRequestedEvent.
+// Data is any JSON document, it is put into export archive as is
+type DataExportPart struct {
+	ExportId string
+	Service  string
+	Data     json.RawMessage
+}
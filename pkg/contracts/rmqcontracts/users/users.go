@@ -0,0 +1,39 @@
+package users
+
+import (
+	"github.com/modulix-systems/goose-talk/contracts/rmqcontracts"
+)
+
+type Contracts struct {
+	Exchanges Exchanges
+	Queues    Queues
+}
+
+// Exchanges are topic exchanges, event type is used as routing key
+// so each service binds its own queue to events it's interested in
+type Exchanges struct {
+	Events rmqcontracts.Exchange
+}
+
+type Queues struct {
+	// DataExportParts receives personal data which services collected for requested export
+	DataExportParts rmqcontracts.Queue
+}
+
+func New() *Contracts {
+	return &Contracts{
+		Exchanges: Exchanges{
+			Events: rmqcontracts.Exchange{
+				Name:    "user_events",
+				Kind:    "topic",
+				Durable: true,
+			},
+		},
+		Queues: Queues{
+			DataExportParts: rmqcontracts.Queue{
+				Name:    "data_export_parts",
+				Durable: true,
+			},
+		},
+	}
+}
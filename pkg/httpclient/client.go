@@ -2,6 +2,7 @@ package httpclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,7 +31,7 @@ func New(baseUrl string, opts ...Option) *Client {
 	return client
 }
 
-func (c *Client) makeRequest(path string, method string, dst any, query url.Values, body any) error {
+func (c *Client) makeRequest(ctx context.Context, path string, method string, dst any, query url.Values, body any) error {
 	method = strings.ToUpper(method)
 	reqUrl, err := url.JoinPath(c.baseUrl, path)
 	if err != nil {
@@ -38,9 +39,10 @@ func (c *Client) makeRequest(path string, method string, dst any, query url.Valu
 	}
 	reqUrl += fmt.Sprintf("?%s", query.Encode())
 
-	req, err := http.NewRequest(method, reqUrl, nil)
+	// request is aborted once ctx is done e.g long poll on shutdown
+	req, err := http.NewRequestWithContext(ctx, method, reqUrl, nil)
 	if err != nil {
-		return fmt.Errorf("httpclient - %s '%s' - http.NewRequest: %w", method, path, err)
+		return fmt.Errorf("httpclient - %s '%s' - http.NewRequestWithContext: %w", method, path, err)
 	}
 
 	if c.bearerAuthToken != "" {
@@ -87,10 +89,10 @@ func (c *Client) makeRequest(path string, method string, dst any, query url.Valu
 	return nil
 }
 
-func (c *Client) Get(path string, query url.Values, dst any) error {
-	return c.makeRequest(path, "GET", dst, query, nil)
+func (c *Client) Get(ctx context.Context, path string, query url.Values, dst any) error {
+	return c.makeRequest(ctx, path, "GET", dst, query, nil)
 }
 
-func (c *Client) Post(path string, data any, dst any) error {
-	return c.makeRequest(path, "POST", dst, url.Values{}, data)
+func (c *Client) Post(ctx context.Context, path string, data any, dst any) error {
+	return c.makeRequest(ctx, path, "POST", dst, url.Values{}, data)
 }
@@ -0,0 +1,51 @@
+package rbac
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cachedPermissions struct {
+	permissions []Permission
+	expiresAt   time.Time
+}
+
+// CachedResolver keeps resolved permissions for ttl to avoid asking auth service on every RPC.
+// Revoked permissions stay effective until ttl passes so it should be short
+type CachedResolver struct {
+	resolver Resolver
+	ttl      time.Duration
+	mu       sync.Mutex
+	cache    map[int]cachedPermissions
+}
+
+func NewCachedResolver(resolver Resolver, ttl time.Duration) *CachedResolver {
+	return &CachedResolver{resolver: resolver, ttl: ttl, cache: make(map[int]cachedPermissions)}
+}
+
+func (r *CachedResolver) Permissions(ctx context.Context, userId int) ([]Permission, error) {
+	now := time.Now()
+	r.mu.Lock()
+	cached, ok := r.cache[userId]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.permissions, nil
+	}
+
+	permissions, err := r.resolver.Permissions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// drop expired entries so cache does not grow with every user ever seen
+	for id, entry := range r.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(r.cache, id)
+		}
+	}
+	r.cache[userId] = cachedPermissions{permissions: permissions, expiresAt: now.Add(r.ttl)}
+	return permissions, nil
+}
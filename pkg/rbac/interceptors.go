@@ -0,0 +1,52 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, "Authentication required")
+	case errors.Is(err, ErrPermissionDenied), errors.Is(err, ErrNotAnnotated):
+		return status.Error(codes.PermissionDenied, "Permission denied")
+	default:
+		return status.Error(codes.Internal, "Internal error")
+	}
+}
+
+// UnaryServerInterceptor must be chained after interceptor which authenticates caller
+func (e *Enforcer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := e.Authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor must be chained after interceptor which authenticates caller
+func (e *Enforcer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := e.Authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return toStatus(err)
+		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
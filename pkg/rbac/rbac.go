@@ -0,0 +1,90 @@
+// Package rbac enforces role-based access control in gRPC services.
+// Roles and their permissions are managed by auth service, services only
+// declare which permission every RPC requires and resolve permissions of the caller.
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// Permission is a name of action e.g "users:write"
+type Permission string
+
+// Public marks RPC which does not require any permission
+const Public Permission = ""
+
+var (
+	ErrUnauthenticated  = errors.New("rbac: caller is not authenticated")
+	ErrPermissionDenied = errors.New("rbac: caller does not have required permission")
+	ErrNotAnnotated     = errors.New("rbac: method does not declare required permission")
+)
+
+// Policy maps full RPC method name (e.g "/auth.v1.AdminService/SearchUsers") to permission it requires.
+// Methods missing in policy are denied so new RPCs can not be exposed unprotected by mistake
+type Policy map[string]Permission
+
+// Resolver returns permissions granted to user e.g by asking auth service
+type Resolver interface {
+	Permissions(ctx context.Context, userId int) ([]Permission, error)
+}
+
+// ResolverFunc adapts ordinary function to Resolver
+type ResolverFunc func(ctx context.Context, userId int) ([]Permission, error)
+
+func (f ResolverFunc) Permissions(ctx context.Context, userId int) ([]Permission, error) {
+	return f(ctx, userId)
+}
+
+// UserIdFunc extracts authenticated user id from request context, it is set by service's auth interceptor
+type UserIdFunc func(ctx context.Context) (int, bool)
+
+// Enforcer checks that caller of RPC has permission required by policy
+type Enforcer struct {
+	policy   Policy
+	userId   UserIdFunc
+	resolver Resolver
+}
+
+func NewEnforcer(policy Policy, userId UserIdFunc, resolver Resolver) *Enforcer {
+	return &Enforcer{policy: policy, userId: userId, resolver: resolver}
+}
+
+type permissionsCtxKey struct{}
+
+// Authorize checks access to method and returns context carrying caller's permissions
+func (e *Enforcer) Authorize(ctx context.Context, method string) (context.Context, error) {
+	required, ok := e.policy[method]
+	if !ok {
+		return ctx, ErrNotAnnotated
+	}
+	if required == Public {
+		return ctx, nil
+	}
+
+	userId, ok := e.userId(ctx)
+	if !ok {
+		return ctx, ErrUnauthenticated
+	}
+	granted, err := e.resolver.Permissions(ctx, userId)
+	if err != nil {
+		return ctx, err
+	}
+	if !slices.Contains(granted, required) {
+		return ctx, ErrPermissionDenied
+	}
+	return context.WithValue(ctx, permissionsCtxKey{}, granted), nil
+}
+
+// PermissionsFromContext returns permissions of caller resolved during authorization.
+// Handlers may use it for checks finer than per RPC ones
+func PermissionsFromContext(ctx context.Context) []Permission {
+	granted, _ := ctx.Value(permissionsCtxKey{}).([]Permission)
+	return granted
+}
+
+// HasPermission reports whether caller resolved during authorization has permission
+func HasPermission(ctx context.Context, permission Permission) bool {
+	return slices.Contains(PermissionsFromContext(ctx), permission)
+}
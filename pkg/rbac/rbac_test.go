@@ -0,0 +1,114 @@
+package rbac_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type userIdCtxKey struct{}
+
+const (
+	publicMethod = "/test.v1.Service/Public"
+	readMethod   = "/test.v1.Service/Read"
+	writeMethod  = "/test.v1.Service/Write"
+)
+
+func newEnforcer(resolver rbac.Resolver) *rbac.Enforcer {
+	return rbac.NewEnforcer(
+		rbac.Policy{
+			publicMethod: rbac.Public,
+			readMethod:   "users:read",
+			writeMethod:  "users:write",
+		},
+		func(ctx context.Context) (int, bool) {
+			userId, ok := ctx.Value(userIdCtxKey{}).(int)
+			return userId, ok
+		},
+		resolver,
+	)
+}
+
+func staticResolver(permissions ...rbac.Permission) rbac.Resolver {
+	return rbac.ResolverFunc(func(context.Context, int) ([]rbac.Permission, error) {
+		return permissions, nil
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	enforcer := newEnforcer(staticResolver("users:read"))
+	authenticatedCtx := context.WithValue(context.Background(), userIdCtxKey{}, 1)
+
+	t.Run("public", func(t *testing.T) {
+		_, err := enforcer.Authorize(context.Background(), publicMethod)
+		assert.NoError(t, err)
+	})
+	t.Run("granted", func(t *testing.T) {
+		ctx, err := enforcer.Authorize(authenticatedCtx, readMethod)
+		require.NoError(t, err)
+		assert.True(t, rbac.HasPermission(ctx, "users:read"))
+		assert.False(t, rbac.HasPermission(ctx, "users:write"))
+	})
+	t.Run("denied", func(t *testing.T) {
+		_, err := enforcer.Authorize(authenticatedCtx, writeMethod)
+		assert.ErrorIs(t, err, rbac.ErrPermissionDenied)
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := enforcer.Authorize(context.Background(), readMethod)
+		assert.ErrorIs(t, err, rbac.ErrUnauthenticated)
+	})
+	t.Run("not annotated", func(t *testing.T) {
+		_, err := enforcer.Authorize(authenticatedCtx, "/test.v1.Service/Unknown")
+		assert.ErrorIs(t, err, rbac.ErrNotAnnotated)
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := newEnforcer(staticResolver("users:read")).UnaryServerInterceptor()
+	ctx := context.WithValue(context.Background(), userIdCtxKey{}, 1)
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: readMethod}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: writeMethod}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: readMethod}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	failing := newEnforcer(rbac.ResolverFunc(func(context.Context, int) ([]rbac.Permission, error) {
+		return nil, errors.New("auth service is unavailable")
+	})).UnaryServerInterceptor()
+	_, err = failing(ctx, nil, &grpc.UnaryServerInfo{FullMethod: readMethod}, handler)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestCachedResolver(t *testing.T) {
+	calls := 0
+	resolver := rbac.NewCachedResolver(rbac.ResolverFunc(func(context.Context, int) ([]rbac.Permission, error) {
+		calls++
+		return []rbac.Permission{"users:read"}, nil
+	}), 50*time.Millisecond)
+
+	for range 3 {
+		permissions, err := resolver.Permissions(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []rbac.Permission{"users:read"}, permissions)
+	}
+	assert.Equal(t, 1, calls)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err := resolver.Permissions(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
@@ -10,13 +10,17 @@ require (
 	github.com/go-webauthn/webauthn v0.13.0
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/golang-migrate/migrate/v4 v4.18.3
+	github.com/hashicorp/golang-lru/v2 v2.0.7
 	github.com/ilyakaznacheev/cleanenv v1.5.0
 	github.com/jackc/pgx/v5 v5.8.0
 	github.com/modulix-systems/goose-talk/contracts v0.0.0-00010101000000-000000000000
 	github.com/modulix-systems/goose-talk/logger v0.0.0-00010101000000-000000000000
 	github.com/modulix-systems/goose-talk/postgres v0.0.0-00010101000000-000000000000
 	github.com/modulix-systems/goose-talk/rabbitmq v0.0.0-00010101000000-000000000000
+	github.com/modulix-systems/goose-talk/rbac v0.0.0-00010101000000-000000000000
+	github.com/oschwald/maxminddb-golang/v2 v2.1.0
 	github.com/rabbitmq/amqp091-go v1.10.0
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.11.1
 	golang.org/x/crypto v0.46.0
 	google.golang.org/grpc v1.72.1
@@ -26,11 +30,14 @@ require (
 require github.com/rs/zerolog v1.34.0 // indirect
 
 replace (
+	buf.build/gen/go/co3n/goose-proto/grpc/go => ../../goose-pb/grpc/go
+	buf.build/gen/go/co3n/goose-proto/protocolbuffers/go => ../../goose-pb/protocolbuffers/go
 	github.com/modulix-systems/goose-talk/contracts => ../../pkg/contracts
 	github.com/modulix-systems/goose-talk/httpclient => ../../pkg/httpclient
 	github.com/modulix-systems/goose-talk/logger => ../../pkg/logger
 	github.com/modulix-systems/goose-talk/postgres => ../../pkg/postgres
 	github.com/modulix-systems/goose-talk/rabbitmq => ../../pkg/rabbitmq
+	github.com/modulix-systems/goose-talk/rbac => ../../pkg/rbac
 )
 
 require (
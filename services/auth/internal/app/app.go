@@ -2,28 +2,46 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/modulix-systems/goose-talk/internal/config"
 	rpc_v1 "github.com/modulix-systems/goose-talk/internal/controller/grpc/v1"
+	"github.com/modulix-systems/goose-talk/internal/controller/jobs"
+	rmqController "github.com/modulix-systems/goose-talk/internal/controller/rmq"
+	tgbotController "github.com/modulix-systems/goose-talk/internal/controller/tgbot"
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+	"github.com/modulix-systems/goose-talk/internal/gateways/alerts"
+	"github.com/modulix-systems/goose-talk/internal/gateways/filestorage"
 	"github.com/modulix-systems/goose-talk/internal/gateways/geoip"
+	"github.com/modulix-systems/goose-talk/internal/gateways/iplist"
+	"github.com/modulix-systems/goose-talk/internal/gateways/keyring"
 	"github.com/modulix-systems/goose-talk/internal/gateways/notifications"
+	"github.com/modulix-systems/goose-talk/internal/gateways/pwned"
+	"github.com/modulix-systems/goose-talk/internal/gateways/qrcode"
 	"github.com/modulix-systems/goose-talk/internal/gateways/security"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/cachedrepos"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
 	"github.com/modulix-systems/goose-talk/internal/gateways/tgbot"
+	"github.com/modulix-systems/goose-talk/internal/gateways/useragent"
+	"github.com/modulix-systems/goose-talk/internal/gateways/userevents"
 	"github.com/modulix-systems/goose-talk/internal/gateways/webauthn"
 	"github.com/modulix-systems/goose-talk/internal/services/auth"
 	"github.com/modulix-systems/goose-talk/logger"
 	"github.com/modulix-systems/goose-talk/pkg/grpcserver"
+	"github.com/modulix-systems/goose-talk/pkg/jwt"
 	"github.com/modulix-systems/goose-talk/pkg/redis"
+	pkgValidator "github.com/modulix-systems/goose-talk/pkg/validator"
 	"github.com/modulix-systems/goose-talk/postgres"
 	"github.com/modulix-systems/goose-talk/rabbitmq"
+	"google.golang.org/grpc"
 )
 
 // Run creates objects via constructors.
@@ -58,6 +76,7 @@ func Run(cfg *config.Config) {
 
 	pgRepos := pgrepos.New(pg)
 	redisRepos := redisrepos.New(rdb)
+	sessionsRepo := cachedrepos.NewAuthSessionsRepo(pgRepos.AuthSessions, redisRepos.AuthSessions, log)
 
 	appUrl, err := url.Parse(cfg.App.Url)
 	if err != nil {
@@ -69,8 +88,57 @@ func Run(cfg *config.Config) {
 		log.Fatal(fmt.Errorf("app - Run - notifications.New: %w", err))
 	}
 
-	geoipClient := geoip.New()
-	securityProvider := security.New(cfg.TotpTTL, config.OTP_LENGTH, cfg.App.Name)
+	geoIpProviders := []gateways.GeoIpApi{}
+	if cfg.GeoIp.DbPath != "" {
+		geoIpDb, err := geoip.NewDatabaseClient(cfg.GeoIp.DbPath)
+		if err != nil {
+			log.Fatal(fmt.Errorf("app - Run - geoip.NewDatabaseClient: %w", err))
+		}
+		defer geoIpDb.Close()
+		geoIpProviders = append(geoIpProviders, geoIpDb)
+	}
+	if cfg.GeoIp.HttpFallback {
+		geoIpProviders = append(geoIpProviders, geoip.New(cfg.GeoIp.HttpApiKey, cfg.GeoIp.HttpTimeout))
+	}
+	if len(geoIpProviders) == 0 {
+		log.Warn("app - Run - no geoip providers configured, locations of sessions will be unknown")
+	}
+	geoipClient := geoip.NewCachedClient(geoip.NewChain(geoIpProviders...), cfg.GeoIp.CacheSize, cfg.GeoIp.CacheTTL)
+	breachedPasswords, err := pwned.Load(cfg.PasswordPolicy.BreachedFiles...)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - pwned.Load: %w", err))
+	}
+
+	riskyNetworks, err := iplist.Load(cfg.LoginRisk.RiskyNetworksFiles...)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - iplist.Load: %w", err))
+	}
+
+	alertsClient, err := alerts.New(rmq, log)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - alerts.New: %w", err))
+	}
+
+	userEventsClient, err := userevents.New(rmq, log)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - userevents.New: %w", err))
+	}
+
+	dataExportStorage, err := filestorage.NewLocal(cfg.DataExport.Dir)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - filestorage.NewLocal: %w", err))
+	}
+
+	keyRing, err := keyring.Load(cfg.Encryption.MasterKeys, cfg.Encryption.MasterKeyFiles, cfg.Encryption.ActiveKeyId)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - keyring.Load: %w", err))
+	}
+
+	securityProvider := security.New(cfg.TotpTTL, config.OTP_LENGTH, cfg.App.Name, security.Argon2Params{
+		Memory:      cfg.PasswordHashing.MemoryKiB,
+		Iterations:  cfg.PasswordHashing.Iterations,
+		Parallelism: cfg.PasswordHashing.Parallelism,
+	})
 	webauthnProvider := webauthn.New(cfg.App.Name, appUrl.Host, []string{appUrl.Host})
 
 	tgBotClient, err := tgbot.New(cfg.Tgbot.Token)
@@ -80,28 +148,114 @@ func Run(cfg *config.Config) {
 
 	authService := auth.New(
 		pgRepos.Users,
-		redisRepos.AuthSessions,
+		sessionsRepo,
 		redisRepos.QRLoginTokens,
 		redisRepos.Otp,
 		redisRepos.PasskeySession,
+		redisRepos.TelegramLinks,
+		pgRepos.SecurityEvents,
+		redisRepos.LoginConfirmations,
+		pgRepos.TrustedDevices,
+		redisRepos.SessionProofNonces,
+		pgRepos.AccessTokens,
+		pgRepos.KeyDirectory,
+		pgRepos.DeletedUsers,
+		pgRepos.DataExports,
+		pgRepos.AdminActions,
+		pgRepos.Roles,
+		redisRepos.MagicLinks,
+		pgRepos.Invites,
 		notificationsClient,
 		webauthnProvider,
 		securityProvider,
+		keyRing,
 		tgBotClient,
 		geoipClient,
+		useragent.New(),
+		riskyNetworks,
+		alertsClient,
+		userEventsClient,
+		jwt.NewTokenProvider(cfg.Jwt.SigningKey, cfg.Jwt.SigningAlg),
+		dataExportStorage,
+		qrcode.New(config.QR_CODE_SIZE),
 
 		cfg.OtpTTL,
 		cfg.LoginTokenTTL,
 		cfg.DefaultSessionTTL,
 		cfg.LongLivedSessionTTL,
+		cfg.LoginConfirmationTTL,
+		cfg.TrustedDeviceTTL,
+		cfg.ReauthWindow,
+		cfg.SessionProofMaxSkew,
+		cfg.AccessTokenMaxTTL,
+		cfg.KeyDirectory.SignedPrekeyMaxAge,
+		cfg.AccountDeletion.GracePeriod,
+		cfg.AccountDeletion.RemindBefore,
+		cfg.DataExport.CollectTimeout,
+		cfg.DataExport.TTL,
+		cfg.AccountLock.Window,
+		cfg.AccountLock.Duration,
+		cfg.MagicLinkTTL,
+		cfg.Invites.MaxTTL,
+		cfg.LoginRisk.Threshold,
+		cfg.SessionLimits.MaxDefault,
+		cfg.SessionLimits.MaxLongLived,
+		cfg.SessionLimits.Policy,
+		&pkgValidator.PasswordPolicy{
+			MinLength:      cfg.PasswordPolicy.MinLength,
+			MaxLength:      cfg.PasswordPolicy.MaxLength,
+			MinEntropyBits: cfg.PasswordPolicy.MinEntropyBits,
+			Breached:       breachedPasswords,
+		},
+		cfg.KeyDirectory.MaxOneTimePrekeys,
+		cfg.KeyDirectory.PrekeysLowThreshold,
+		cfg.DataExport.Services,
+		cfg.AccountLock.MaxFailedSignIns,
+		appUrl,
+		cfg.Invites.Required,
+		cfg.Invites.MaxUses,
 		log,
 	)
 
 	validate := validator.New(validator.WithRequiredStructEnabled())
-	grpcServer := grpcserver.New(log, cfg.Port)
+	authInterceptor := rpc_v1.NewAuthInterceptor(authService, cfg.InternalApi.ServiceTokens, log)
+	enforcer := rpc_v1.NewEnforcer(authService)
+	grpcServer := grpcserver.New(
+		log,
+		cfg.Port,
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary(), enforcer.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream(), enforcer.StreamServerInterceptor()),
+	)
 	rpc_v1.Register(grpcServer, authService, log, validate)
 
+	rmqServer := rabbitmq.NewServer(rmq, time.Second)
+	rmqController.Register(rmqServer, authService, log)
+
+	tgBotServer := tgbotController.NewServer(
+		tgBotClient,
+		tgbotController.NewHandler(authService, tgBotClient, log),
+		log,
+		cfg.Tgbot.PollTimeout,
+	)
+
+	reencryptionJob := jobs.NewReencryption(
+		authService, log, cfg.Encryption.ReencryptionInterval, cfg.Encryption.ReencryptionBatchSize,
+	)
+
+	accountDeletionJob := jobs.NewAccountDeletion(
+		authService, log, cfg.AccountDeletion.Interval, cfg.AccountDeletion.BatchSize,
+	)
+
+	dataExportsJob := jobs.NewDataExports(
+		authService, log, cfg.DataExport.Interval, cfg.DataExport.BatchSize,
+	)
+
 	go grpcServer.Run()
+	go rmqServer.Run()
+	go tgBotServer.Run()
+	go reencryptionJob.Run()
+	go accountDeletionJob.Run()
+	go dataExportsJob.Run()
 
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
@@ -112,8 +266,20 @@ func Run(cfg *config.Config) {
 		log.Info("app - Run - interrupt signal", "signalName", s.String())
 	case err = <-grpcServer.ServeErr:
 		log.Error(fmt.Errorf("app - Run - grpcServer.ServeErr: %w", err))
+	case err = <-rmqServer.ServeErr:
+		log.Error(fmt.Errorf("app - Run - rmqServer.ServeErr: %w", err))
 	}
 
 	// Shutdown
+	dataExportsJob.Stop()
+	accountDeletionJob.Stop()
+	reencryptionJob.Stop()
+	tgBotServer.Stop()
 	grpcServer.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err = rmqServer.Stop(ctx); err != nil {
+		log.Error(fmt.Errorf("app - Run - rmqServer.Stop: %w", err))
+	}
 }
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/utils"
 	"github.com/modulix-systems/goose-talk/logger"
 )
@@ -20,12 +22,37 @@ type (
 		Log                 Log
 		App                 App
 		Tgbot               Tgbot
+		GeoIp               GeoIp
+		LoginRisk           LoginRisk
+		SessionLimits       SessionLimits
+		PasswordHashing     PasswordHashing
+		PasswordPolicy      PasswordPolicy
+		Encryption          Encryption
+		KeyDirectory        KeyDirectory
+		AccountDeletion     AccountDeletion
+		DataExport          DataExport
+		AccountLock         AccountLock
+		Invites             Invites
+		InternalApi         InternalApi
+		Jwt                 Jwt
 		Port                string        `env-default:"8000"`
 		OtpTTL              time.Duration `env:"OTP_TTL" env-default:"5m"`
 		TotpTTL             time.Duration `env:"TOTP_TTL" env-default:"1m"`
 		LoginTokenTTL       time.Duration `env:"LOGIN_TOKEN_TTL" env-default:"1m"`
 		DefaultSessionTTL   time.Duration `env:"DEFAULT_SESSION_TTL" env-default:"72h"`
 		LongLivedSessionTTL time.Duration `env:"LONG_LIVED_SESSION_TTL" env-default:"720h"`
+		// LoginConfirmationTTL is how long "was this you?" links from new device notice stay valid
+		LoginConfirmationTTL time.Duration `env:"LOGIN_CONFIRMATION_TTL" env-default:"72h"`
+		// TrustedDeviceTTL is how long device trusted during 2FA verification may skip it
+		TrustedDeviceTTL time.Duration `env:"TRUSTED_DEVICE_TTL" env-default:"720h"`
+		// ReauthWindow is how long after authentication sensitive actions are allowed within session
+		ReauthWindow time.Duration `env:"REAUTH_WINDOW" env-default:"10m"`
+		// SessionProofMaxSkew is max difference between server time and timestamp of request signed by session key
+		SessionProofMaxSkew time.Duration `env:"SESSION_PROOF_MAX_SKEW" env-default:"1m"`
+		// AccessTokenMaxTTL is the longest lifetime personal access token may be created with
+		AccessTokenMaxTTL time.Duration `env:"ACCESS_TOKEN_MAX_TTL" env-default:"8760h"`
+		// MagicLinkTTL is how long emailed passwordless sign in link and code stay valid
+		MagicLinkTTL time.Duration `env:"MAGIC_LINK_TTL" env-default:"15m"`
 	}
 
 	App struct {
@@ -34,6 +61,13 @@ type (
 		Url     string `env:"APP_URL,required"`
 	}
 
+	InternalApi struct {
+		// ServiceTokens authenticate other services calling internal RPCs e.g PermissionsService.
+		// Every service is given its own token generated with `openssl rand -hex 32`,
+		// internal RPCs reject every caller if no token is configured
+		ServiceTokens []string `env:"INTERNAL_SERVICE_TOKENS" env-separator:","`
+	}
+
 	Postgres struct {
 		Url         string `env:"PG_URL,required"`
 		MaxPoolSize int    `env:"PG_MAX_POOL_SIZE"`
@@ -49,7 +83,115 @@ type (
 	}
 
 	Tgbot struct {
-		Token string `env:"TG_BOT_TOKEN,required"`
+		Token       string        `env:"TG_BOT_TOKEN,required"`
+		PollTimeout time.Duration `env:"TG_BOT_POLL_TIMEOUT" env-default:"30s"`
+	}
+
+	GeoIp struct {
+		// DbPath is a path to MaxMind City database (mmdb), database lookup is disabled if empty
+		DbPath string `env:"GEOIP_DB_PATH"`
+		// HttpFallback enables ip-api.com lookups if location is not found in database.
+		// It sends sign in addresses to third party, so it is opt-in and requires HttpApiKey
+		HttpFallback bool          `env:"GEOIP_HTTP_FALLBACK" env-default:"false"`
+		HttpApiKey   string        `env:"GEOIP_HTTP_API_KEY"`
+		HttpTimeout  time.Duration `env:"GEOIP_HTTP_TIMEOUT" env-default:"2s"`
+		CacheSize    int           `env:"GEOIP_CACHE_SIZE" env-default:"10000"`
+		CacheTTL     time.Duration `env:"GEOIP_CACHE_TTL" env-default:"24h"`
+	}
+
+	LoginRisk struct {
+		// Threshold is a score starting from which sign in requires step-up verification.
+		// Detection is disabled if threshold is not positive
+		Threshold int `env:"LOGIN_RISK_THRESHOLD" env-default:"50"`
+		// RiskyNetworksFiles are lists of TOR exit nodes, datacenter ranges, etc. One ip or CIDR per line
+		RiskyNetworksFiles []string `env:"LOGIN_RISK_NETWORKS_FILES" env-separator:","`
+	}
+
+	SessionLimits struct {
+		// Max number of concurrent sessions per user, not positive value disables the limit
+		MaxDefault   int `env:"SESSIONS_MAX_DEFAULT" env-default:"20"`
+		MaxLongLived int `env:"SESSIONS_MAX_LONG_LIVED" env-default:"10"`
+		// Policy is either "evict" or "reject"
+		Policy entity.SessionLimitPolicy `env:"SESSIONS_LIMIT_POLICY" env-default:"evict"`
+	}
+
+	// PasswordHashing holds argon2id parameters. Stored hashes are upgraded on sign in once they change
+	PasswordHashing struct {
+		MemoryKiB   uint32 `env:"ARGON2_MEMORY_KIB" env-default:"65536"`
+		Iterations  uint32 `env:"ARGON2_ITERATIONS" env-default:"3"`
+		Parallelism uint8  `env:"ARGON2_PARALLELISM" env-default:"2"`
+	}
+
+	PasswordPolicy struct {
+		MinLength int `env:"PASSWORD_MIN_LENGTH" env-default:"8"`
+		MaxLength int `env:"PASSWORD_MAX_LENGTH" env-default:"128"`
+		// MinEntropyBits is estimated strength new password must have, not positive value disables the check
+		MinEntropyBits float64 `env:"PASSWORD_MIN_ENTROPY_BITS" env-default:"45"`
+		// BreachedFiles are HIBP SHA-1 datasets: files with full hashes or directories of range files
+		BreachedFiles []string `env:"PASSWORD_BREACHED_FILES" env-separator:","`
+	}
+
+	Encryption struct {
+		// MasterKeys are "id:hexkey" pairs of 32 bytes keys, at least one key must be configured
+		// either here or in MasterKeyFiles. Key is generated with `openssl rand -hex 32`
+		MasterKeys     []string `env:"ENCRYPTION_MASTER_KEYS" env-separator:","`
+		MasterKeyFiles []string `env:"ENCRYPTION_MASTER_KEY_FILES" env-separator:","`
+		// ActiveKeyId encrypts new values, the largest key id is used if it is not set
+		ActiveKeyId           uint32        `env:"ENCRYPTION_ACTIVE_KEY_ID"`
+		ReencryptionInterval  time.Duration `env:"ENCRYPTION_REENCRYPTION_INTERVAL" env-default:"1h"`
+		ReencryptionBatchSize int           `env:"ENCRYPTION_REENCRYPTION_BATCH_SIZE" env-default:"100"`
+	}
+
+	KeyDirectory struct {
+		// MaxOneTimePrekeys is how many unused one-time prekeys a single device may store
+		MaxOneTimePrekeys int `env:"E2E_MAX_ONE_TIME_PREKEYS" env-default:"200"`
+		// PrekeysLowThreshold is a number of one-time prekeys below which device should upload more
+		PrekeysLowThreshold int `env:"E2E_PREKEYS_LOW_THRESHOLD" env-default:"20"`
+		// SignedPrekeyMaxAge is how long signed prekey is used before device is asked to rotate it
+		SignedPrekeyMaxAge time.Duration `env:"E2E_SIGNED_PREKEY_MAX_AGE" env-default:"720h"`
+	}
+
+	AccountDeletion struct {
+		// GracePeriod is how long user may cancel requested deletion before account is erased
+		GracePeriod time.Duration `env:"ACCOUNT_DELETION_GRACE_PERIOD" env-default:"720h"`
+		// RemindBefore is how long before erasure user is reminded about it
+		RemindBefore time.Duration `env:"ACCOUNT_DELETION_REMIND_BEFORE" env-default:"72h"`
+		Interval     time.Duration `env:"ACCOUNT_DELETION_INTERVAL" env-default:"10m"`
+		BatchSize    int           `env:"ACCOUNT_DELETION_BATCH_SIZE" env-default:"100"`
+	}
+
+	DataExport struct {
+		// Dir keeps assembled archives until they expire
+		Dir string `env:"DATA_EXPORT_DIR" env-default:"data/exports"`
+		// Services are expected to send their part of export, archive is assembled once all of them
+		// replied or CollectTimeout is over
+		Services       []string      `env:"DATA_EXPORT_SERVICES" env-separator:","`
+		CollectTimeout time.Duration `env:"DATA_EXPORT_COLLECT_TIMEOUT" env-default:"10m"`
+		// TTL is how long archive may be downloaded
+		TTL       time.Duration `env:"DATA_EXPORT_TTL" env-default:"72h"`
+		Interval  time.Duration `env:"DATA_EXPORT_INTERVAL" env-default:"1m"`
+		BatchSize int           `env:"DATA_EXPORT_BATCH_SIZE" env-default:"10"`
+	}
+
+	AccountLock struct {
+		// MaxFailedSignIns in a row within Window lock account for Duration, zero disables locking
+		MaxFailedSignIns int           `env:"ACCOUNT_LOCK_MAX_FAILED_SIGN_INS" env-default:"10"`
+		Window           time.Duration `env:"ACCOUNT_LOCK_WINDOW" env-default:"15m"`
+		Duration         time.Duration `env:"ACCOUNT_LOCK_DURATION" env-default:"30m"`
+	}
+
+	Invites struct {
+		// Required makes sign up invite-only
+		Required bool `env:"INVITES_REQUIRED" env-default:"false"`
+		// MaxUses and MaxTTL limit invites created by users, admins may exceed them
+		MaxUses int           `env:"INVITES_MAX_USES" env-default:"5"`
+		MaxTTL  time.Duration `env:"INVITES_MAX_TTL" env-default:"168h"`
+	}
+
+	Jwt struct {
+		// SigningKey is HMAC secret of at least 32 bytes e.g generated with `openssl rand -hex 32`
+		SigningKey string `env:"JWT_SIGNING_KEY" env-required:"true"`
+		SigningAlg string `env:"JWT_SIGNING_ALG" env-default:"HS256"`
 	}
 
 	Log struct {
@@ -70,9 +212,65 @@ func MustLoad(configPath string) *Config {
 	if err != nil {
 		panic(err)
 	}
+	if err = cfg.validate(); err != nil {
+		panic(err)
+	}
 	return &cfg
 }
 
+// validate rejects values which can not be expressed with struct tags
+func (cfg *Config) validate() error {
+	if strings.Contains(cfg.Jwt.SigningKey, SECRET_PLACEHOLDER) {
+		return fmt.Errorf("config - JWT_SIGNING_KEY is a sample placeholder, generate a real key")
+	}
+	for _, key := range cfg.Encryption.MasterKeys {
+		if strings.Contains(key, SECRET_PLACEHOLDER) {
+			return fmt.Errorf("config - ENCRYPTION_MASTER_KEYS contain a sample placeholder, generate a real key")
+		}
+	}
+	for _, token := range cfg.InternalApi.ServiceTokens {
+		if strings.Contains(token, SECRET_PLACEHOLDER) || len(token) < MIN_SERVICE_TOKEN_LENGTH {
+			return fmt.Errorf("config - INTERNAL_SERVICE_TOKENS must be at least %d bytes long and not sample placeholders", MIN_SERVICE_TOKEN_LENGTH)
+		}
+	}
+	if len(cfg.Jwt.SigningKey) < MIN_JWT_SIGNING_KEY_LENGTH {
+		return fmt.Errorf("config - JWT_SIGNING_KEY must be at least %d bytes long", MIN_JWT_SIGNING_KEY_LENGTH)
+	}
+	// argon2 panics on zero iterations or parallelism and requires at least 8 KiB of memory per thread
+	hashing := cfg.PasswordHashing
+	if hashing.Iterations < 1 || hashing.Parallelism < 1 {
+		return fmt.Errorf("config - ARGON2_ITERATIONS and ARGON2_PARALLELISM must be positive")
+	}
+	if hashing.MemoryKiB < 8*uint32(hashing.Parallelism) {
+		return fmt.Errorf("config - ARGON2_MEMORY_KIB must be at least 8 KiB per ARGON2_PARALLELISM thread")
+	}
+	// time.NewTicker panics on not positive interval and batch of zero size never drains the queue
+	jobs := []struct {
+		name      string
+		interval  time.Duration
+		batchSize int
+	}{
+		{"ENCRYPTION_REENCRYPTION", cfg.Encryption.ReencryptionInterval, cfg.Encryption.ReencryptionBatchSize},
+		{"ACCOUNT_DELETION", cfg.AccountDeletion.Interval, cfg.AccountDeletion.BatchSize},
+		{"DATA_EXPORT", cfg.DataExport.Interval, cfg.DataExport.BatchSize},
+	}
+	for _, job := range jobs {
+		if job.interval <= 0 || job.batchSize <= 0 {
+			return fmt.Errorf("config - %s_INTERVAL and %s_BATCH_SIZE must be positive", job.name, job.name)
+		}
+	}
+	if cfg.GeoIp.HttpFallback && (cfg.GeoIp.HttpApiKey == "" || cfg.GeoIp.HttpTimeout <= 0) {
+		return fmt.Errorf("config - GEOIP_HTTP_FALLBACK requires GEOIP_HTTP_API_KEY and positive GEOIP_HTTP_TIMEOUT")
+	}
+	switch cfg.SessionLimits.Policy {
+	case entity.SESSION_LIMIT_POLICY_EVICT, entity.SESSION_LIMIT_POLICY_REJECT:
+	default:
+		return fmt.Errorf("config - SESSIONS_LIMIT_POLICY must be either %q or %q, got %q",
+			entity.SESSION_LIMIT_POLICY_EVICT, entity.SESSION_LIMIT_POLICY_REJECT, cfg.SessionLimits.Policy)
+	}
+	return nil
+}
+
 func ResolveConfigPath(mode string) string {
 	if mode == "" {
 		mode = os.Getenv("MODE")
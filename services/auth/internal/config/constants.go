@@ -1,8 +1,49 @@
 package config
 
+import "time"
+
 const (
-	TRANSACTION_CTX_KEY                       = "transaction"
-	OTP_LENGTH                                = 6
-	LOGIN_TOKEN_LENGTH                        = 16
-	TOTP_SECRET_LENGTH = 8
+	TRANSACTION_CTX_KEY       = "transaction"
+	OTP_LENGTH                = 6
+	LOGIN_TOKEN_LENGTH        = 16
+	LOGIN_WATCH_ID_LENGTH     = 32
+	TOTP_SECRET_LENGTH        = 8
+	TELEGRAM_LINK_CODE_LENGTH = 16
+
+	// Width and height of rendered PNG QR code in pixels
+	QR_CODE_SIZE = 256
+
+	// HMAC key shorter than hash output weakens signatures
+	MIN_JWT_SIGNING_KEY_LENGTH = 32
+	// Token other services authenticate with must not be guessable
+	MIN_SERVICE_TOKEN_LENGTH = 32
+	// Marks secrets in sample configs which must be replaced before the service is started
+	SECRET_PLACEHOLDER = "CHANGE_ME"
+
+	// Code issued for specific flow is invalidated after this number of failed attempts
+	MAX_OTP_ATTEMPTS = 5
+
+	// Data export which failed to assemble this number of times is given up
+	MAX_DATA_EXPORT_ATTEMPTS = 5
+	// Delay before retrying failed data export, doubled after every failed attempt
+	DATA_EXPORT_RETRY_BACKOFF = time.Minute
+
+	LOGIN_CONFIRMATION_NONCE_LENGTH = 16
+
+	MAGIC_LINK_TOKEN_LENGTH = 32
+
+	ACCESS_TOKEN_LENGTH = 32
+	// Number of random token characters kept in plain text to help user identify the token
+	ACCESS_TOKEN_VISIBLE_PREFIX_LENGTH = 4
+
+	INVITE_CODE_LENGTH = 16
+	// Number of random code characters kept in plain text to help inviter identify the invite
+	INVITE_CODE_VISIBLE_PREFIX_LENGTH = 4
+
+	// Number of recent logins sign in attempt is compared with
+	LOGIN_RISK_HISTORY_SIZE = 20
+	// Travel between logins faster than this speed is considered impossible
+	MAX_TRAVEL_SPEED_KMH = 1000
+	// Distances below this value are ignored because of geoip inaccuracy
+	MIN_TRAVEL_DISTANCE_KM = 300
 )
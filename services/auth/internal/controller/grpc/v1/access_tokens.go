@@ -0,0 +1,85 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) CreateAccessToken(
+	ctx context.Context,
+	req *pb.CreateAccessTokenRequest,
+) (*pb.CreateAccessTokenResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.CreateAccessTokenRequest{
+		UserId:    caller.UserId,
+		SessionId: caller.SessionId,
+		Name:      req.GetName(),
+		ExpiresAt: req.GetExpiresAt().AsTime(),
+	}
+	for _, scope := range req.GetScopes() {
+		reqDto.Scopes = append(reqDto.Scopes, entity.AccessTokenScope(scope))
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	result, err := a.service.CreateAccessToken(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidAccessTokenExpiry) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.CreateAccessTokenResponse{
+		AccessToken: mapAccessToken(result.AccessToken),
+		Token:       result.Token,
+	}, nil
+}
+
+func (a *AuthV1) GetAccessTokens(ctx context.Context, req *pb.GetAccessTokensRequest) (*pb.GetAccessTokensResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	tokens, err := a.service.GetAccessTokens(ctx, caller.UserId)
+	if err != nil {
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetAccessTokensResponse{AccessTokens: make([]*pb.AccessToken, 0, len(tokens))}
+	for i := range tokens {
+		resp.AccessTokens = append(resp.AccessTokens, mapAccessToken(&tokens[i]))
+	}
+	return resp, nil
+}
+
+func (a *AuthV1) RevokeAccessToken(
+	ctx context.Context,
+	req *pb.RevokeAccessTokenRequest,
+) (*pb.RevokeAccessTokenResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.RevokeAccessToken(ctx, caller.UserId, int(req.GetTokenId())); err != nil {
+		if errors.Is(err, auth.ErrAccessTokenNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.RevokeAccessTokenResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) RequestAccountDeletion(
+	ctx context.Context,
+	req *pb.RequestAccountDeletionRequest,
+) (*pb.RequestAccountDeletionResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	scheduledAt, err := a.service.RequestAccountDeletion(ctx, caller.UserId, caller.SessionId)
+	if err != nil {
+		if errors.Is(err, auth.ErrDeletionAlreadyScheduled) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.RequestAccountDeletionResponse{ScheduledAt: mapTimestamp(scheduledAt)}, nil
+}
+
+func (a *AuthV1) CancelAccountDeletion(
+	ctx context.Context,
+	req *pb.CancelAccountDeletionRequest,
+) (*pb.CancelAccountDeletionResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.CancelAccountDeletion(ctx, caller.UserId, caller.SessionId); err != nil {
+		if errors.Is(err, auth.ErrDeletionNotScheduled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.CancelAccountDeletionResponse{}, nil
+}
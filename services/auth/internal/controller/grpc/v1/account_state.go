@@ -0,0 +1,53 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) RequestAccountReactivation(
+	ctx context.Context,
+	req *pb.RequestAccountReactivationRequest,
+) (*pb.RequestAccountReactivationResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	if err := a.service.RequestAccountReactivation(ctx, req.GetEmail()); err != nil {
+		return nil, ErrInternalError
+	}
+
+	return &pb.RequestAccountReactivationResponse{}, nil
+}
+
+func (a *AuthV1) ReactivateAccount(
+	ctx context.Context,
+	req *pb.ReactivateAccountRequest,
+) (*pb.ReactivateAccountResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := &dtos.ReactivateAccountRequest{Email: req.GetEmail(), Code: req.GetCode()}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := a.service.ReactivateAccount(ctx, reqDto); err != nil {
+		if errors.Is(err, auth.ErrOtpIsNotValid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, auth.ErrAccountNotReactivatable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.ReactivateAccountResponse{}, nil
+}
@@ -0,0 +1,367 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/gen/go/co3n/goose-proto/grpc/go/auth/v1/authv1grpc"
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminV1 struct {
+	authv1grpc.UnimplementedAdminServiceServer
+
+	service *auth.Service
+	log     logger.Interface
+}
+
+// mapAdminError maps errors common to admin usecases, which check admin's permission and recent authentication
+func mapAdminError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrUserNotFound), errors.Is(err, auth.ErrRoleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrRoleAlreadyAssigned):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, auth.ErrRoleNotAssigned), errors.Is(err, auth.ErrInvalidAccountStateTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return mapRecentAuthError(err)
+	}
+}
+
+func (a *AdminV1) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.AdminSearchUsersRequest{
+		AdminId:   caller.UserId,
+		SessionId: caller.SessionId,
+		Query:     req.GetQuery(),
+		Limit:     int(req.GetLimit()),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	users, err := a.service.SearchUsers(ctx, reqDto)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	resp := &pb.SearchUsersResponse{Users: make([]*pb.AdminUser, 0, len(users))}
+	for i := range users {
+		resp.Users = append(resp.Users, mapAdminUser(&users[i]))
+	}
+	return resp, nil
+}
+
+func (a *AdminV1) GetUserDetails(ctx context.Context, req *pb.GetUserDetailsRequest) (*pb.GetUserDetailsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	details, err := a.service.GetUserDetails(ctx, newAdminUserRequest(ctx, req.GetUserId()))
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	resp := &pb.GetUserDetailsResponse{
+		User:     mapAdminUser(details.User),
+		Sessions: make([]*pb.AuthSession, 0, len(details.Sessions)),
+	}
+	for i := range details.Sessions {
+		resp.Sessions = append(resp.Sessions, mapSession(&details.Sessions[i]))
+	}
+	return resp, nil
+}
+
+func (a *AdminV1) ChangeAccountState(
+	ctx context.Context,
+	req *pb.ChangeAccountStateRequest,
+) (*pb.ChangeAccountStateResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.ChangeAccountStateRequest{
+		AdminId:   caller.UserId,
+		SessionId: caller.SessionId,
+		UserId:    int(req.GetUserId()),
+		State:     entity.AccountState(req.GetState()),
+		Reason:    req.GetReason(),
+	}
+	if req.HasUntil() {
+		until := req.GetUntil().AsTime()
+		reqDto.Until = &until
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	user, err := a.service.ChangeAccountState(ctx, reqDto)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.ChangeAccountStateResponse{User: mapAdminUser(user)}, nil
+}
+
+func (a *AdminV1) ForceLogout(ctx context.Context, req *pb.ForceLogoutRequest) (*pb.ForceLogoutResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	if err := a.service.ForceLogout(ctx, newAdminUserRequest(ctx, req.GetUserId())); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.ForceLogoutResponse{}, nil
+}
+
+func (a *AdminV1) ResetTwoFa(ctx context.Context, req *pb.ResetTwoFaRequest) (*pb.ResetTwoFaResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	if err := a.service.ResetTwoFa(ctx, newAdminUserRequest(ctx, req.GetUserId())); err != nil {
+		if errors.Is(err, auth.Err2FANotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.ResetTwoFaResponse{}, nil
+}
+
+func (a *AdminV1) TriggerPasswordReset(
+	ctx context.Context,
+	req *pb.TriggerPasswordResetRequest,
+) (*pb.TriggerPasswordResetResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	if err := a.service.TriggerPasswordReset(ctx, newAdminUserRequest(ctx, req.GetUserId())); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.TriggerPasswordResetResponse{}, nil
+}
+
+func (a *AdminV1) SearchSecurityEvents(
+	ctx context.Context,
+	req *pb.SearchSecurityEventsRequest,
+) (*pb.SearchSecurityEventsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	filter := &dtos.SecurityEventsFilter{
+		UserId:   int(req.GetUserId()),
+		IpAddr:   req.GetIpAddr(),
+		BeforeId: req.GetBeforeId(),
+		Limit:    int(req.GetLimit()),
+	}
+	for _, typ := range req.GetTypes() {
+		filter.Types = append(filter.Types, entity.SecurityEventType(typ))
+	}
+	if errs := filter.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	page, err := a.service.SearchSecurityEvents(ctx, caller.UserId, caller.SessionId, filter)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidSecurityEventsFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, mapAdminError(err)
+	}
+
+	events := mapSecurityEventsPage(page)
+	return &pb.SearchSecurityEventsResponse{Events: events.GetEvents(), NextCursor: events.GetNextCursor()}, nil
+}
+
+func (a *AdminV1) GetAdminActions(ctx context.Context, req *pb.GetAdminActionsRequest) (*pb.GetAdminActionsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	filter := &dtos.AdminActionsFilter{
+		AdminId:      int(req.GetAdminId()),
+		TargetUserId: int(req.GetTargetUserId()),
+		BeforeId:     req.GetBeforeId(),
+		Limit:        int(req.GetLimit()),
+	}
+	for _, action := range req.GetActions() {
+		filter.Actions = append(filter.Actions, entity.AdminActionType(action))
+	}
+	if errs := filter.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	page, err := a.service.GetAdminActions(ctx, caller.UserId, caller.SessionId, filter)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	resp := &pb.GetAdminActionsResponse{
+		Actions:    make([]*pb.AdminAction, 0, len(page.Actions)),
+		NextCursor: page.NextCursor,
+	}
+	for i := range page.Actions {
+		resp.Actions = append(resp.Actions, mapAdminAction(&page.Actions[i]))
+	}
+	return resp, nil
+}
+
+func (a *AdminV1) GetRoles(ctx context.Context, req *pb.GetRolesRequest) (*pb.GetRolesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	roles, err := a.service.GetRoles(ctx, caller.UserId, caller.SessionId)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.GetRolesResponse{Roles: mapRoles(roles)}, nil
+}
+
+func (a *AdminV1) GetUserRoles(ctx context.Context, req *pb.GetUserRolesRequest) (*pb.GetUserRolesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	roles, err := a.service.GetUserRoles(ctx, newAdminUserRequest(ctx, req.GetUserId()))
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.GetUserRolesResponse{Roles: mapRoles(roles)}, nil
+}
+
+func (a *AdminV1) SaveRole(ctx context.Context, req *pb.SaveRoleRequest) (*pb.SaveRoleResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.SaveRoleRequest{
+		AdminId:     caller.UserId,
+		SessionId:   caller.SessionId,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+	for _, permission := range req.GetPermissions() {
+		reqDto.Permissions = append(reqDto.Permissions, rbac.Permission(permission))
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	role, err := a.service.SaveRole(ctx, reqDto)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.SaveRoleResponse{Role: mapRole(role)}, nil
+}
+
+func (a *AdminV1) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.DeleteRole(ctx, caller.UserId, caller.SessionId, req.GetName()); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.DeleteRoleResponse{}, nil
+}
+
+func (a *AdminV1) AssignRole(ctx context.Context, req *pb.AssignRoleRequest) (*pb.AssignRoleResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := newUserRoleRequest(ctx, req.GetUserId(), req.GetRoleName())
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := a.service.AssignRole(ctx, reqDto); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.AssignRoleResponse{}, nil
+}
+
+func (a *AdminV1) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*pb.RevokeRoleResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := newUserRoleRequest(ctx, req.GetUserId(), req.GetRoleName())
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := a.service.RevokeRole(ctx, reqDto); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.RevokeRoleResponse{}, nil
+}
+
+func (a *AdminV1) CreateInvite(
+	ctx context.Context,
+	req *pb.AdminCreateInviteRequest,
+) (*pb.AdminCreateInviteResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.CreateInviteRequest{
+		UserId:    caller.UserId,
+		SessionId: caller.SessionId,
+		Email:     req.GetEmail(),
+		MaxUses:   int(req.GetMaxUses()),
+		ExpiresAt: req.GetExpiresAt().AsTime(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	result, err := a.service.CreateInviteAsAdmin(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidInviteLimits) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, mapAdminError(err)
+	}
+
+	return &pb.AdminCreateInviteResponse{Invite: mapInvite(result.Invite), Code: result.Code}, nil
+}
+
+// newAdminUserRequest builds request for action of calling admin targeting user
+func newAdminUserRequest(ctx context.Context, userId int64) *dtos.AdminUserRequest {
+	caller := callerFromCtx(ctx)
+	return &dtos.AdminUserRequest{AdminId: caller.UserId, SessionId: caller.SessionId, UserId: int(userId)}
+}
+
+func newUserRoleRequest(ctx context.Context, userId int64, roleName string) *dtos.UserRoleRequest {
+	caller := callerFromCtx(ctx)
+	return &dtos.UserRoleRequest{
+		AdminId:   caller.UserId,
+		SessionId: caller.SessionId,
+		UserId:    int(userId),
+		RoleName:  roleName,
+	}
+}
+
+func newAdminController(service *auth.Service, log logger.Interface) *AdminV1 {
+	return &AdminV1{service: service, log: log}
+}
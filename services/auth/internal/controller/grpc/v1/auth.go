@@ -11,7 +11,6 @@ import (
 	"github.com/modulix-systems/goose-talk/internal/services/auth"
 	"github.com/modulix-systems/goose-talk/internal/utils"
 	"github.com/modulix-systems/goose-talk/logger"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -39,33 +38,15 @@ func (a *AuthV1) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.SignUpR
 		DeviceInfo:       req.GetDeviceInfo(),
 		BirthDate:        req.BirthDate.AsTime(),
 		AboutMe:          req.GetAboutMe(),
+		InviteCode:       req.GetInviteCode(),
 	}
 	if errs := reqDto.Validate(); len(errs) > 0 {
-		st := status.New(codes.InvalidArgument, "Validation error")
-		st, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: errs})
-		if err != nil {
-			return nil, ErrInternalError
-		}
-		return nil, st.Err()
+		return nil, newValidationError(errs)
 	}
 
 	result, err := a.service.SignUp(ctx, reqDto)
 	if err != nil {
-		if errors.Is(err, auth.ErrOtpIsNotValid) {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
-		if errors.Is(err, auth.ErrUserAlreadyExists) {
-			return nil, status.Error(codes.AlreadyExists, err.Error())
-		}
-		if errors.Is(err, auth.ErrEmailUnverified) {
-			st := status.New(codes.InvalidArgument, "Verify email to proceed")
-			st, err = st.WithDetails(&errdetails.ErrorInfo{Reason: "EMAIL_UNVERIFIED"})
-			if err != nil {
-				return nil, ErrInternalError
-			}
-			return nil, st.Err()
-		}
-		return nil, ErrInternalError
+		return nil, mapSignUpError(err)
 	}
 
 	return &pb.SignUpResponse{
@@ -74,6 +55,26 @@ func (a *AuthV1) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.SignUpR
 	}, nil
 }
 
+func mapSignUpError(err error) error {
+	var policyErr *auth.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return newValidationError(policyErr.Violations)
+	}
+	if errors.Is(err, auth.ErrOtpIsNotValid) || errors.Is(err, auth.ErrInvalidInvite) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, auth.ErrUserAlreadyExists) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, auth.ErrEmailUnverified) {
+		return newErrorWithReason(codes.InvalidArgument, "Verify email to proceed", "EMAIL_UNVERIFIED")
+	}
+	if errors.Is(err, auth.ErrInviteRequired) {
+		return newErrorWithReason(codes.FailedPrecondition, err.Error(), "INVITE_REQUIRED")
+	}
+	return ErrInternalError
+}
+
 func (a *AuthV1) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.SignInResponse, error) {
 	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
 	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
@@ -0,0 +1,45 @@
+package rpc_v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMapSignUpError(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{name: "invite required", err: auth.ErrInviteRequired, code: codes.FailedPrecondition, reason: "INVITE_REQUIRED"},
+		{name: "invalid invite", err: auth.ErrInvalidInvite, code: codes.InvalidArgument},
+		{name: "wrapped invalid invite", err: fmt.Errorf("redeem: %w", auth.ErrInvalidInvite), code: codes.InvalidArgument},
+		{name: "invalid otp", err: auth.ErrOtpIsNotValid, code: codes.InvalidArgument},
+		{name: "user exists", err: auth.ErrUserAlreadyExists, code: codes.AlreadyExists},
+		{name: "email unverified", err: auth.ErrEmailUnverified, code: codes.InvalidArgument, reason: "EMAIL_UNVERIFIED"},
+		{name: "unexpected", err: fmt.Errorf("connection refused"), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := status.FromError(mapSignUpError(tc.err))
+			require.True(t, ok)
+			assert.Equal(t, tc.code, st.Code())
+			if tc.reason == "" {
+				return
+			}
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tc.reason, info.GetReason())
+		})
+	}
+}
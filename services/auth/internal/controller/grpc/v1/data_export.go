@@ -0,0 +1,75 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dataExportChunkSize keeps streamed messages well below default gRPC message size limit
+const dataExportChunkSize = 64 << 10
+
+func (a *AuthV1) RequestDataExport(
+	ctx context.Context,
+	req *pb.RequestDataExportRequest,
+) (*pb.RequestDataExportResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	export, err := a.service.RequestDataExport(ctx, caller.UserId, caller.SessionId)
+	if err != nil {
+		if errors.Is(err, auth.ErrDataExportInProgress) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.RequestDataExportResponse{DataExport: mapDataExport(export)}, nil
+}
+
+func (a *AuthV1) DownloadDataExport(
+	req *pb.DownloadDataExportRequest,
+	stream grpc.ServerStreamingServer[pb.DownloadDataExportResponse],
+) error {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(stream.Context())
+	ctx := logger.CtxWithCorrelationID(stream.Context(), correlationId)
+
+	export, file, err := a.service.OpenDataExport(ctx, req.GetToken())
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidDataExportToken) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return ErrInternalError
+	}
+	defer file.Close()
+
+	buf := make([]byte, dataExportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.DownloadDataExportResponse{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			a.log.Error(
+				fmt.Errorf("rpc_v1 - DownloadDataExport - file.Read: %w", err),
+				"correlationId", correlationId, "exportId", export.Id,
+			)
+			return ErrInternalError
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package rpc_v1
 
 import (
+	"errors"
+
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -8,3 +12,46 @@ import (
 var (
 	ErrInternalError = status.Error(codes.Internal, "Internal error")
 )
+
+// newValidationError reports fields of request which did not pass validation
+func newValidationError(violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, "Validation error").WithDetails(
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return ErrInternalError
+	}
+	return st.Err()
+}
+
+// newErrorWithReason attaches machine readable reason so client can react to the error e.g by asking user to verify email
+func newErrorWithReason(code codes.Code, msg string, reason string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{Reason: reason})
+	if err != nil {
+		return ErrInternalError
+	}
+	return st.Err()
+}
+
+// reauthenticationRequiredReason tells client to confirm user's identity with Reauthenticate and retry
+const reauthenticationRequiredReason = "REAUTHENTICATION_REQUIRED"
+
+// isAccountStateError reports whether err means that account can not be used in its current state
+func isAccountStateError(err error) bool {
+	return errors.Is(err, auth.ErrAccountLocked) ||
+		errors.Is(err, auth.ErrAccountSuspended) ||
+		errors.Is(err, auth.ErrAccountBanned) ||
+		errors.Is(err, auth.ErrDeactivatedAccount)
+}
+
+// mapRecentAuthError maps errors of usecases which are initiated within session and require recent authentication
+func mapRecentAuthError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrReauthenticationRequired):
+		return newErrorWithReason(codes.FailedPrecondition, err.Error(), reauthenticationRequiredReason)
+	case errors.Is(err, auth.ErrSessionNotFound), errors.Is(err, auth.ErrUserNotFound):
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return ErrInternalError
+	}
+}
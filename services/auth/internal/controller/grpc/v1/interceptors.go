@@ -0,0 +1,303 @@
+package rpc_v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"buf.build/gen/go/co3n/goose-proto/grpc/go/auth/v1/authv1grpc"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying caller's credentials
+const (
+	userIdMetaKey                = "x-user-id"
+	sessionIdMetaKey             = "x-session-id"
+	sessionProofNonceMetaKey     = "x-session-proof-nonce"
+	sessionProofTimestampMetaKey = "x-session-proof-timestamp"
+	sessionProofSignatureMetaKey = "x-session-proof-signature"
+	authorizationMetaKey         = "authorization"
+	serviceTokenMetaKey          = "x-service-token"
+)
+
+const bearerPrefix = "Bearer "
+
+type credentials int
+
+const (
+	// caller is not authenticated
+	credentialsNone credentials = iota
+	// caller must pass session
+	credentialsSession
+	// caller must pass session or personal access token granting scope
+	credentialsSessionOrAccessToken
+	// caller is another service which must pass one of configured service tokens
+	credentialsService
+)
+
+type methodAuth struct {
+	credentials credentials
+	// scope access token must grant if it is accepted by the method
+	scope entity.AccessTokenScope
+}
+
+// methodsAuth declares credentials every RPC must be called with.
+// Methods missing here are rejected so new RPCs can not be exposed unauthenticated by mistake
+var methodsAuth = map[string]methodAuth{
+	reflectionv1.ServerReflection_ServerReflectionInfo_FullMethodName:      {credentials: credentialsNone},
+	reflectionv1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: {credentials: credentialsNone},
+
+	authv1grpc.AuthService_SignUp_FullMethodName:      {credentials: credentialsNone},
+	authv1grpc.AuthService_SignIn_FullMethodName:      {credentials: credentialsNone},
+	authv1grpc.AuthService_PingSession_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AuthService_GetActiveSessions_FullMethodName: {
+		credentials: credentialsSessionOrAccessToken, scope: entity.ACCESS_TOKEN_SCOPE_SESSIONS_READ,
+	},
+	authv1grpc.AuthService_DeleteSession_FullMethodName: {
+		credentials: credentialsSessionOrAccessToken, scope: entity.ACCESS_TOKEN_SCOPE_SESSIONS_WRITE,
+	},
+	authv1grpc.AuthService_CreateAccessToken_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AuthService_GetAccessTokens_FullMethodName:   {credentials: credentialsSession},
+	authv1grpc.AuthService_RevokeAccessToken_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AuthService_GetSecurityEvents_FullMethodName: {
+		credentials: credentialsSessionOrAccessToken, scope: entity.ACCESS_TOKEN_SCOPE_SECURITY_EVENTS_READ,
+	},
+	authv1grpc.AuthService_DeleteAllSessions_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AuthService_DeactivateAccount_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AuthService_DisableTwoFa_FullMethodName:                {credentials: credentialsSession},
+	authv1grpc.AuthService_RequestReauthenticationCode_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AuthService_Reauthenticate_FullMethodName:              {credentials: credentialsSession},
+	authv1grpc.AuthService_AnswerLoginConfirmation_FullMethodName:     {credentials: credentialsNone},
+	authv1grpc.AuthService_RequestPasswordReset_FullMethodName:        {credentials: credentialsNone},
+	authv1grpc.AuthService_ResetPassword_FullMethodName:               {credentials: credentialsNone},
+	authv1grpc.AuthService_RequestDataExport_FullMethodName:           {credentials: credentialsSession},
+	// download is authorized by the emailed token
+	authv1grpc.AuthService_DownloadDataExport_FullMethodName:         {credentials: credentialsNone},
+	authv1grpc.AuthService_RequestAccountReactivation_FullMethodName: {credentials: credentialsNone},
+	authv1grpc.AuthService_ReactivateAccount_FullMethodName:          {credentials: credentialsNone},
+	authv1grpc.AuthService_RequestMagicLink_FullMethodName:           {credentials: credentialsNone},
+	authv1grpc.AuthService_SignInWithMagicLink_FullMethodName:        {credentials: credentialsNone},
+	// session is sent only to the stream which created accepted token
+	authv1grpc.AuthService_WatchQRLogin_FullMethodName:            {credentials: credentialsNone},
+	authv1grpc.AuthService_VerifyTwoFa_FullMethodName:             {credentials: credentialsNone},
+	authv1grpc.AuthService_GetTrustedDevices_FullMethodName:       {credentials: credentialsSession},
+	authv1grpc.AuthService_RevokeTrustedDevice_FullMethodName:     {credentials: credentialsSession},
+	authv1grpc.AuthService_RevokeAllTrustedDevices_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AuthService_RequestAccountDeletion_FullMethodName:  {credentials: credentialsSession},
+	authv1grpc.AuthService_CancelAccountDeletion_FullMethodName:   {credentials: credentialsSession},
+	authv1grpc.AuthService_CreateInvite_FullMethodName:            {credentials: credentialsSession},
+	authv1grpc.AuthService_GetInvites_FullMethodName:              {credentials: credentialsSession},
+	authv1grpc.AuthService_RevokeInvite_FullMethodName:            {credentials: credentialsSession},
+
+	authv1grpc.KeyDirectoryService_RegisterDeviceKeys_FullMethodName:   {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_RotateSignedPrekey_FullMethodName:   {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_UploadOneTimePrekeys_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_GetPrekeyBundles_FullMethodName:     {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_GetIdentityKeys_FullMethodName:      {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_GetDeviceKeysStatus_FullMethodName:  {credentials: credentialsSession},
+	authv1grpc.KeyDirectoryService_RemoveDeviceKeys_FullMethodName:     {credentials: credentialsSession},
+
+	// internal RPC called by other services to resolve permissions of their callers
+	authv1grpc.PermissionsService_GetUserPermissions_FullMethodName: {credentials: credentialsService},
+
+	authv1grpc.AdminService_SearchUsers_FullMethodName:          {credentials: credentialsSession},
+	authv1grpc.AdminService_GetUserDetails_FullMethodName:       {credentials: credentialsSession},
+	authv1grpc.AdminService_ChangeAccountState_FullMethodName:   {credentials: credentialsSession},
+	authv1grpc.AdminService_ForceLogout_FullMethodName:          {credentials: credentialsSession},
+	authv1grpc.AdminService_ResetTwoFa_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AdminService_TriggerPasswordReset_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AdminService_SearchSecurityEvents_FullMethodName: {credentials: credentialsSession},
+	authv1grpc.AdminService_GetAdminActions_FullMethodName:      {credentials: credentialsSession},
+	authv1grpc.AdminService_GetRoles_FullMethodName:             {credentials: credentialsSession},
+	authv1grpc.AdminService_GetUserRoles_FullMethodName:         {credentials: credentialsSession},
+	authv1grpc.AdminService_SaveRole_FullMethodName:             {credentials: credentialsSession},
+	authv1grpc.AdminService_DeleteRole_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AdminService_AssignRole_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AdminService_RevokeRole_FullMethodName:           {credentials: credentialsSession},
+	authv1grpc.AdminService_CreateInvite_FullMethodName:         {credentials: credentialsSession},
+}
+
+var errUnauthenticated = status.Error(codes.Unauthenticated, "Authentication required")
+
+// caller is authenticated user on whose behalf RPC is made.
+// SessionId is empty if caller is authenticated with access token
+type caller struct {
+	UserId        int
+	SessionId     string
+	AccessTokenId int
+}
+
+type callerCtxKey struct{}
+
+// callerFromCtx returns caller set by AuthInterceptor, it is zero for public RPCs
+func callerFromCtx(ctx context.Context) caller {
+	c, _ := ctx.Value(callerCtxKey{}).(caller)
+	return c
+}
+
+// AuthInterceptor authenticates caller of every RPC according to methodsAuth
+type AuthInterceptor struct {
+	service *auth.Service
+	// serviceTokenHashes are SHA-256 hashes of tokens other services authenticate with,
+	// hashes have equal length so they are compared in constant time
+	serviceTokenHashes [][]byte
+	log                logger.Interface
+}
+
+func NewAuthInterceptor(service *auth.Service, serviceTokens []string, log logger.Interface) *AuthInterceptor {
+	hashes := make([][]byte, 0, len(serviceTokens))
+	for _, token := range serviceTokens {
+		hash := sha256.Sum256([]byte(token))
+		hashes = append(hashes, hash[:])
+	}
+	return &AuthInterceptor{service: service, serviceTokenHashes: hashes, log: log}
+}
+
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (i *AuthInterceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	required, ok := methodsAuth[method]
+	if !ok {
+		return ctx, status.Error(codes.PermissionDenied, "Permission denied")
+	}
+	if required.credentials == credentialsNone {
+		return ctx, nil
+	}
+
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	meta, _ := metadata.FromIncomingContext(ctx)
+	if required.credentials == credentialsService {
+		if !i.isServiceToken(firstMetaValue(meta, serviceTokenMetaKey)) {
+			return ctx, errUnauthenticated
+		}
+		return ctx, nil
+	}
+	if bearer := firstMetaValue(meta, authorizationMetaKey); bearer != "" {
+		if required.credentials != credentialsSessionOrAccessToken || !strings.HasPrefix(bearer, bearerPrefix) {
+			return ctx, errUnauthenticated
+		}
+		return i.authenticateAccessToken(
+			logger.CtxWithCorrelationID(ctx, correlationId), strings.TrimPrefix(bearer, bearerPrefix), required.scope,
+		)
+	}
+
+	userId, err := strconv.Atoi(firstMetaValue(meta, userIdMetaKey))
+	sessionId := firstMetaValue(meta, sessionIdMetaKey)
+	if err != nil || sessionId == "" {
+		return ctx, errUnauthenticated
+	}
+
+	session, err := i.service.AuthenticateSession(
+		logger.CtxWithCorrelationID(ctx, correlationId), userId, sessionId, sessionProofFromMeta(meta, method),
+	)
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) ||
+			errors.Is(err, auth.ErrSessionProofRequired) ||
+			errors.Is(err, auth.ErrInvalidSessionProof) {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return ctx, ErrInternalError
+	}
+
+	return context.WithValue(ctx, callerCtxKey{}, caller{UserId: session.UserId, SessionId: session.Id}), nil
+}
+
+func (i *AuthInterceptor) authenticateAccessToken(
+	ctx context.Context,
+	plainToken string,
+	scope entity.AccessTokenScope,
+) (context.Context, error) {
+	token, err := i.service.AuthenticateAccessToken(ctx, plainToken, scope)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidAccessToken) {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if errors.Is(err, auth.ErrInsufficientScope) {
+			return ctx, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if isAccountStateError(err) {
+			return ctx, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return ctx, ErrInternalError
+	}
+
+	return context.WithValue(ctx, callerCtxKey{}, caller{UserId: token.UserId, AccessTokenId: token.Id}), nil
+}
+
+// isServiceToken reports whether token is one of configured service tokens.
+// Every configured token is compared so that time does not depend on which one matched
+func (i *AuthInterceptor) isServiceToken(token string) bool {
+	if token == "" {
+		return false
+	}
+	hash := sha256.Sum256([]byte(token))
+	matched := 0
+	for _, serviceTokenHash := range i.serviceTokenHashes {
+		matched |= subtle.ConstantTimeCompare(hash[:], serviceTokenHash)
+	}
+	return matched == 1
+}
+
+// sessionProofFromMeta returns proof signed for method or nil if request is not signed
+func sessionProofFromMeta(meta metadata.MD, method string) *dtos.SessionProof {
+	nonce := firstMetaValue(meta, sessionProofNonceMetaKey)
+	signature := firstMetaValue(meta, sessionProofSignatureMetaKey)
+	if nonce == "" && signature == "" {
+		return nil
+	}
+
+	proof := &dtos.SessionProof{Nonce: nonce, Method: method, Signature: signature}
+	// malformed timestamp is left zero so proof is rejected as malformed
+	if unix, err := strconv.ParseInt(firstMetaValue(meta, sessionProofTimestampMetaKey), 10, 64); err == nil {
+		proof.Timestamp = time.Unix(unix, 0)
+	}
+	return proof
+}
+
+func firstMetaValue(meta metadata.MD, key string) string {
+	if values := meta.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package rpc_v1
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/gen/go/co3n/goose-proto/grpc/go/auth/v1/authv1grpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestEveryMethodIsAnnotated(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{
+		authv1grpc.AuthService_ServiceDesc,
+		authv1grpc.AdminService_ServiceDesc,
+		authv1grpc.PermissionsService_ServiceDesc,
+		authv1grpc.KeyDirectoryService_ServiceDesc,
+	} {
+		methods := []string{}
+		for _, method := range desc.Methods {
+			methods = append(methods, "/"+desc.ServiceName+"/"+method.MethodName)
+		}
+		for _, stream := range desc.Streams {
+			methods = append(methods, "/"+desc.ServiceName+"/"+stream.StreamName)
+		}
+
+		for _, method := range methods {
+			assert.Contains(t, methodsAuth, method)
+			assert.Contains(t, methodsPermissions, method)
+		}
+	}
+}
+
+func TestServiceCredentials(t *testing.T) {
+	serviceToken := "4f2a9c7e1b3d5f6a8c0e2b4d6f8a0c2e"
+	interceptor := NewAuthInterceptor(nil, []string{serviceToken}, nil)
+	method := authv1grpc.PermissionsService_GetUserPermissions_FullMethodName
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(serviceTokenMetaKey, serviceToken))
+		_, err := interceptor.authenticate(ctx, method)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(serviceTokenMetaKey, serviceToken+"x"))
+		_, err := interceptor.authenticate(ctx, method)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := interceptor.authenticate(context.Background(), method)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("no tokens configured", func(t *testing.T) {
+		interceptor := NewAuthInterceptor(nil, nil, nil)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(serviceTokenMetaKey, ""))
+		_, err := interceptor.authenticate(ctx, method)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,96 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mapInvite(invite *entity.Invite) *pb.Invite {
+	result := &pb.Invite{
+		Id:        int64(invite.Id),
+		Prefix:    invite.Prefix,
+		Email:     invite.Email,
+		MaxUses:   int32(invite.MaxUses),
+		Uses:      int32(invite.Uses),
+		CreatedAt: mapTimestamp(invite.CreatedAt),
+		ExpiresAt: mapTimestamp(invite.ExpiresAt),
+	}
+	if invite.RevokedAt != nil {
+		result.RevokedAt = mapTimestamp(*invite.RevokedAt)
+	}
+	return result
+}
+
+func (a *AuthV1) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.CreateInviteResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.CreateInviteRequest{
+		UserId:    caller.UserId,
+		SessionId: caller.SessionId,
+		Email:     req.GetEmail(),
+		MaxUses:   int(req.GetMaxUses()),
+		ExpiresAt: req.GetExpiresAt().AsTime(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	result, err := a.service.CreateInvite(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidInviteLimits) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if isAccountStateError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.CreateInviteResponse{Invite: mapInvite(result.Invite), Code: result.Code}, nil
+}
+
+func (a *AuthV1) GetInvites(ctx context.Context, req *pb.GetInvitesRequest) (*pb.GetInvitesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	invites, err := a.service.GetInvites(ctx, caller.UserId)
+	if err != nil {
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetInvitesResponse{Invites: make([]*pb.Invite, 0, len(invites))}
+	for i := range invites {
+		resp.Invites = append(resp.Invites, mapInvite(&invites[i]))
+	}
+	return resp, nil
+}
+
+func (a *AuthV1) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.RevokeInvite(ctx, caller.UserId, int(req.GetInviteId())); err != nil {
+		if errors.Is(err, auth.ErrInviteNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.RevokeInviteResponse{}, nil
+}
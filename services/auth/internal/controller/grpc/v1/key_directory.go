@@ -0,0 +1,243 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/gen/go/co3n/goose-proto/grpc/go/auth/v1/authv1grpc"
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type KeyDirectoryV1 struct {
+	authv1grpc.UnimplementedKeyDirectoryServiceServer
+
+	service *auth.Service
+	log     logger.Interface
+}
+
+// mapKeyDirectoryError maps errors common to usecases which manage caller's device keys
+func mapKeyDirectoryError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrInvalidIdentityKey), errors.Is(err, auth.ErrInvalidPrekeySignature):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, auth.ErrDeviceKeysNotFound), errors.Is(err, auth.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, auth.ErrIdentityKeyMismatch), errors.Is(err, auth.ErrPrekeyIdTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, auth.ErrTooManyPrekeys):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return ErrInternalError
+	}
+}
+
+func mapSignedPrekeyFromPb(prekey *pb.SignedPrekey) dtos.SignedPrekeyUpload {
+	return dtos.SignedPrekeyUpload{
+		KeyId:     int(prekey.GetKeyId()),
+		PublicKey: prekey.GetPublicKey(),
+		Signature: prekey.GetSignature(),
+	}
+}
+
+func mapOneTimePrekeysFromPb(prekeys []*pb.OneTimePrekey) []dtos.OneTimePrekeyUpload {
+	uploads := make([]dtos.OneTimePrekeyUpload, 0, len(prekeys))
+	for _, prekey := range prekeys {
+		uploads = append(uploads, dtos.OneTimePrekeyUpload{
+			KeyId:     int(prekey.GetKeyId()),
+			PublicKey: prekey.GetPublicKey(),
+		})
+	}
+	return uploads
+}
+
+func mapIdentityKey(key *entity.IdentityKey) *pb.IdentityKey {
+	return &pb.IdentityKey{
+		DeviceId:  key.DeviceId,
+		PublicKey: key.PublicKey,
+		CreatedAt: mapTimestamp(key.CreatedAt),
+	}
+}
+
+func mapPrekeyBundle(bundle *entity.PrekeyBundle) *pb.PrekeyBundle {
+	result := &pb.PrekeyBundle{
+		IdentityKey: mapIdentityKey(&bundle.IdentityKey),
+		SignedPrekey: &pb.SignedPrekey{
+			KeyId:     int32(bundle.SignedPrekey.KeyId),
+			PublicKey: bundle.SignedPrekey.PublicKey,
+			Signature: bundle.SignedPrekey.Signature,
+		},
+	}
+	if bundle.OneTimePrekey != nil {
+		result.OneTimePrekey = &pb.OneTimePrekey{
+			KeyId:     int32(bundle.OneTimePrekey.KeyId),
+			PublicKey: bundle.OneTimePrekey.PublicKey,
+		}
+	}
+	return result
+}
+
+func (k *KeyDirectoryV1) RegisterDeviceKeys(
+	ctx context.Context,
+	req *pb.RegisterDeviceKeysRequest,
+) (*pb.RegisterDeviceKeysResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.RegisterDeviceKeysRequest{
+		UserId:         caller.UserId,
+		DeviceId:       req.GetDeviceId(),
+		IdentityKey:    req.GetIdentityKey(),
+		SignedPrekey:   mapSignedPrekeyFromPb(req.GetSignedPrekey()),
+		OneTimePrekeys: mapOneTimePrekeysFromPb(req.GetOneTimePrekeys()),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	identityKey, err := k.service.RegisterDeviceKeys(ctx, reqDto)
+	if err != nil {
+		return nil, mapKeyDirectoryError(err)
+	}
+
+	return &pb.RegisterDeviceKeysResponse{IdentityKey: mapIdentityKey(identityKey)}, nil
+}
+
+func (k *KeyDirectoryV1) RotateSignedPrekey(
+	ctx context.Context,
+	req *pb.RotateSignedPrekeyRequest,
+) (*pb.RotateSignedPrekeyResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.RotateSignedPrekeyRequest{
+		UserId:       caller.UserId,
+		DeviceId:     req.GetDeviceId(),
+		SignedPrekey: mapSignedPrekeyFromPb(req.GetSignedPrekey()),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if _, err := k.service.RotateSignedPrekey(ctx, reqDto); err != nil {
+		return nil, mapKeyDirectoryError(err)
+	}
+
+	return &pb.RotateSignedPrekeyResponse{}, nil
+}
+
+func (k *KeyDirectoryV1) UploadOneTimePrekeys(
+	ctx context.Context,
+	req *pb.UploadOneTimePrekeysRequest,
+) (*pb.UploadOneTimePrekeysResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.UploadOneTimePrekeysRequest{
+		UserId:   caller.UserId,
+		DeviceId: req.GetDeviceId(),
+		Prekeys:  mapOneTimePrekeysFromPb(req.GetPrekeys()),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := k.service.UploadOneTimePrekeys(ctx, reqDto); err != nil {
+		return nil, mapKeyDirectoryError(err)
+	}
+
+	return &pb.UploadOneTimePrekeysResponse{}, nil
+}
+
+func (k *KeyDirectoryV1) GetPrekeyBundles(
+	ctx context.Context,
+	req *pb.GetPrekeyBundlesRequest,
+) (*pb.GetPrekeyBundlesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	bundles, err := k.service.GetPrekeyBundles(ctx, int(req.GetUserId()))
+	if err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) || isAccountStateError(err) {
+			// inactive accounts are not disclosed to other users
+			return nil, status.Error(codes.NotFound, auth.ErrUserNotFound.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetPrekeyBundlesResponse{Bundles: make([]*pb.PrekeyBundle, 0, len(bundles))}
+	for i := range bundles {
+		resp.Bundles = append(resp.Bundles, mapPrekeyBundle(&bundles[i]))
+	}
+	return resp, nil
+}
+
+func (k *KeyDirectoryV1) GetIdentityKeys(
+	ctx context.Context,
+	req *pb.GetIdentityKeysRequest,
+) (*pb.GetIdentityKeysResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	keys, err := k.service.GetIdentityKeys(ctx, int(req.GetUserId()))
+	if err != nil {
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetIdentityKeysResponse{IdentityKeys: make([]*pb.IdentityKey, 0, len(keys))}
+	for i := range keys {
+		resp.IdentityKeys = append(resp.IdentityKeys, mapIdentityKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (k *KeyDirectoryV1) GetDeviceKeysStatus(
+	ctx context.Context,
+	req *pb.GetDeviceKeysStatusRequest,
+) (*pb.GetDeviceKeysStatusResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	keysStatus, err := k.service.GetDeviceKeysStatus(ctx, caller.UserId, req.GetDeviceId())
+	if err != nil {
+		return nil, mapKeyDirectoryError(err)
+	}
+
+	return &pb.GetDeviceKeysStatusResponse{
+		Status: &pb.DeviceKeysStatus{
+			OneTimePrekeys:          int32(keysStatus.OneTimePrekeys),
+			OneTimePrekeysLow:       keysStatus.OneTimePrekeysLow,
+			SignedPrekeyCreatedAt:   mapTimestamp(keysStatus.SignedPrekeyCreatedAt),
+			SignedPrekeyRotationDue: keysStatus.SignedPrekeyRotationDue,
+		},
+	}, nil
+}
+
+func (k *KeyDirectoryV1) RemoveDeviceKeys(
+	ctx context.Context,
+	req *pb.RemoveDeviceKeysRequest,
+) (*pb.RemoveDeviceKeysResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := k.service.RemoveDeviceKeys(ctx, caller.UserId, req.GetDeviceId()); err != nil {
+		return nil, mapKeyDirectoryError(err)
+	}
+
+	return &pb.RemoveDeviceKeysResponse{}, nil
+}
+
+func newKeyDirectoryController(service *auth.Service, log logger.Interface) *KeyDirectoryV1 {
+	return &KeyDirectoryV1{service: service, log: log}
+}
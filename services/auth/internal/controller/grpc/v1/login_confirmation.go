@@ -0,0 +1,43 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func mapLoginConfirmationAnswer(answer entity.LoginConfirmationAnswer) pb.AnswerLoginConfirmationResponse_Answer {
+	switch answer {
+	case entity.LOGIN_CONFIRMATION_APPROVE:
+		return pb.AnswerLoginConfirmationResponse_ANSWER_APPROVE
+	case entity.LOGIN_CONFIRMATION_DENY:
+		return pb.AnswerLoginConfirmationResponse_ANSWER_DENY
+	default:
+		return pb.AnswerLoginConfirmationResponse_ANSWER_UNSPECIFIED
+	}
+}
+
+func (a *AuthV1) AnswerLoginConfirmation(
+	ctx context.Context,
+	req *pb.AnswerLoginConfirmationRequest,
+) (*pb.AnswerLoginConfirmationResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	answer, err := a.service.AnswerLoginConfirmation(ctx, req.GetToken())
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidLoginConfirmation) || errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.AnswerLoginConfirmationResponse{Answer: mapLoginConfirmationAnswer(answer)}, nil
+}
@@ -0,0 +1,83 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) RequestMagicLink(
+	ctx context.Context,
+	req *pb.RequestMagicLinkRequest,
+) (*pb.RequestMagicLinkResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := &dtos.RequestMagicLinkRequest{
+		Email:      req.GetEmail(),
+		ClientId:   req.GetClientId(),
+		IpAddr:     req.GetIpAddr(),
+		DeviceInfo: req.GetDeviceInfo(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := a.service.RequestMagicLink(ctx, reqDto); err != nil {
+		return nil, ErrInternalError
+	}
+
+	return &pb.RequestMagicLinkResponse{}, nil
+}
+
+func (a *AuthV1) SignInWithMagicLink(
+	ctx context.Context,
+	req *pb.SignInWithMagicLinkRequest,
+) (*pb.SignInWithMagicLinkResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := &dtos.MagicLinkSignInRequest{
+		ClientId:           req.GetClientId(),
+		Token:              req.GetToken(),
+		Code:               req.GetCode(),
+		RememberMe:         req.GetRememberMe(),
+		IpAddr:             req.GetIpAddr(),
+		DeviceInfo:         req.GetDeviceInfo(),
+		TrustedDeviceToken: req.GetTrustedDeviceToken(),
+		PublicKey:          req.GetPublicKey(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	result, err := a.service.SignInWithMagicLink(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidMagicLink) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, auth.ErrPasswordResetRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if isAccountStateError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.SignInWithMagicLinkResponse{
+		User:             mapUser(result.User),
+		ConfirmationCode: result.ConfirmationCode,
+	}
+	if result.Session != nil {
+		resp.Session = mapSession(result.Session)
+	}
+	return resp, nil
+}
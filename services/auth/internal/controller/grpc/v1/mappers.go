@@ -5,6 +5,7 @@ import (
 
 	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
 	usersv1 "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/users/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
 	"github.com/modulix-systems/goose-talk/internal/entity"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,6 +25,21 @@ func mapTwoFaMethod(method entity.TwoFaMethod) usersv1.TwoFactorAuth_TwoFaMethod
 	}
 }
 
+func mapTwoFaMethodFromPb(method usersv1.TwoFactorAuth_TwoFaMethod) entity.TwoFaMethod {
+	switch method {
+	case usersv1.TwoFactorAuth_TWO_FA_METHOD_TELEGRAM:
+		return entity.TWO_FA_TELEGRAM
+	case usersv1.TwoFactorAuth_TWO_FA_METHOD_EMAIL:
+		return entity.TWO_FA_EMAIL
+	case usersv1.TwoFactorAuth_TWO_FA_METHOD_SMS:
+		return entity.TWO_FA_SMS
+	case usersv1.TwoFactorAuth_TWO_FA_METHOD_TOTP:
+		return entity.TWO_FA_TOTP_APP
+	default:
+		return ""
+	}
+}
+
 func mapTwoFactorAuth(src *entity.TwoFactorAuth) *usersv1.TwoFactorAuth {
 	if src == nil {
 		return nil
@@ -81,3 +97,135 @@ func mapSession(src *entity.AuthSession) *pb.AuthSession {
 		DeviceInfo: src.DeviceInfo,
 	}
 }
+
+func mapAccessToken(src *entity.AccessToken) *pb.AccessToken {
+	scopes := make([]string, 0, len(src.Scopes))
+	for _, scope := range src.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	token := &pb.AccessToken{
+		Id:        int64(src.Id),
+		Name:      src.Name,
+		Prefix:    src.Prefix,
+		Scopes:    scopes,
+		CreatedAt: mapTimestamp(src.CreatedAt),
+		ExpiresAt: mapTimestamp(src.ExpiresAt),
+	}
+	if src.LastUsedAt != nil {
+		token.LastUsedAt = mapTimestamp(*src.LastUsedAt)
+	}
+	return token
+}
+
+func mapSecurityEvent(src *entity.SecurityEvent) *pb.SecurityEvent {
+	return &pb.SecurityEvent{
+		Id:         src.Id,
+		UserId:     int64(src.UserId),
+		Type:       string(src.Type),
+		CreatedAt:  mapTimestamp(src.CreatedAt),
+		IpAddr:     src.IpAddr,
+		DeviceInfo: src.DeviceInfo,
+		Location:   src.Location,
+		Details:    src.Details,
+	}
+}
+
+func mapSecurityEventsPage(src *dtos.SecurityEventsPage) *pb.GetSecurityEventsResponse {
+	resp := &pb.GetSecurityEventsResponse{
+		Events:     make([]*pb.SecurityEvent, 0, len(src.Events)),
+		NextCursor: src.NextCursor,
+	}
+	for i := range src.Events {
+		resp.Events = append(resp.Events, mapSecurityEvent(&src.Events[i]))
+	}
+	return resp
+}
+
+func mapDataExport(src *entity.DataExport) *pb.DataExport {
+	export := &pb.DataExport{
+		Id:          src.Id,
+		Status:      string(src.Status),
+		RequestedAt: mapTimestamp(src.RequestedAt),
+	}
+	if src.CompletedAt != nil {
+		export.CompletedAt = mapTimestamp(*src.CompletedAt)
+	}
+	if src.ExpiresAt != nil {
+		export.ExpiresAt = mapTimestamp(*src.ExpiresAt)
+	}
+	return export
+}
+
+func mapAdminUser(src *entity.User) *pb.AdminUser {
+	user := &pb.AdminUser{
+		User:              mapUser(src),
+		State:             string(src.EffectiveState()),
+		StateReason:       src.StateReason,
+		MustResetPassword: src.MustResetPassword,
+	}
+	if src.StateChangedAt != nil {
+		user.StateChangedAt = mapTimestamp(*src.StateChangedAt)
+	}
+	if src.StateUntil != nil {
+		user.StateUntil = mapTimestamp(*src.StateUntil)
+	}
+	return user
+}
+
+func mapAdminAction(src *entity.AdminAction) *pb.AdminAction {
+	return &pb.AdminAction{
+		Id:           src.Id,
+		AdminId:      int64(src.AdminId),
+		TargetUserId: int64(src.TargetUserId),
+		Action:       string(src.Action),
+		Details:      src.Details,
+		CreatedAt:    mapTimestamp(src.CreatedAt),
+	}
+}
+
+func mapRole(src *entity.Role) *pb.Role {
+	permissions := make([]string, 0, len(src.Permissions))
+	for _, permission := range src.Permissions {
+		permissions = append(permissions, string(permission))
+	}
+
+	return &pb.Role{
+		Name:        src.Name,
+		Description: src.Description,
+		Permissions: permissions,
+		CreatedAt:   mapTimestamp(src.CreatedAt),
+	}
+}
+
+func mapRoles(src []entity.Role) []*pb.Role {
+	roles := make([]*pb.Role, 0, len(src))
+	for i := range src {
+		roles = append(roles, mapRole(&src[i]))
+	}
+	return roles
+}
+
+func mapQRLoginUpdate(src *dtos.QRLoginUpdate) *pb.WatchQRLoginResponse {
+	if src.Session != nil {
+		return pb.WatchQRLoginResponse_builder{Session: mapSession(src.Session)}.Build()
+	}
+	return pb.WatchQRLoginResponse_builder{
+		Token: &pb.QRLoginToken{
+			Token:     src.Token.Value,
+			Url:       src.Url,
+			ExpiresAt: mapTimestamp(src.ExpiresAt),
+			QrCode:    src.QRCode,
+		},
+	}.Build()
+}
+
+func mapTrustedDevice(src *entity.TrustedDevice) *pb.TrustedDevice {
+	return &pb.TrustedDevice{
+		Id:         int64(src.Id),
+		Label:      src.Label,
+		CreatedAt:  mapTimestamp(src.CreatedAt),
+		LastUsedAt: mapTimestamp(src.LastUsedAt),
+		ExpiresAt:  mapTimestamp(src.ExpiresAt),
+	}
+}
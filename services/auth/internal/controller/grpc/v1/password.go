@@ -0,0 +1,58 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) RequestPasswordReset(
+	ctx context.Context,
+	req *pb.RequestPasswordResetRequest,
+) (*pb.RequestPasswordResetResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	if err := a.service.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, ErrInternalError
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (a *AuthV1) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := &dtos.ResetPasswordRequest{
+		Email:       req.GetEmail(),
+		Code:        req.GetCode(),
+		NewPassword: req.GetNewPassword(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	if err := a.service.ResetPassword(ctx, reqDto); err != nil {
+		var policyErr *auth.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			return nil, newValidationError(policyErr.Violations)
+		}
+		if errors.Is(err, auth.ErrOtpIsNotValid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if isAccountStateError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.ResetPasswordResponse{}, nil
+}
@@ -0,0 +1,100 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/gen/go/co3n/goose-proto/grpc/go/auth/v1/authv1grpc"
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+type PermissionsV1 struct {
+	authv1grpc.UnimplementedPermissionsServiceServer
+
+	service *auth.Service
+	log     logger.Interface
+}
+
+func (p *PermissionsV1) GetUserPermissions(
+	ctx context.Context,
+	req *pb.GetUserPermissionsRequest,
+) (*pb.GetUserPermissionsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	permissions, err := p.service.GetUserPermissions(ctx, int(req.GetUserId()))
+	if err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetUserPermissionsResponse{Permissions: make([]string, 0, len(permissions))}
+	for _, permission := range permissions {
+		resp.Permissions = append(resp.Permissions, string(permission))
+	}
+	return resp, nil
+}
+
+func newPermissionsController(service *auth.Service, log logger.Interface) *PermissionsV1 {
+	return &PermissionsV1{service: service, log: log}
+}
+
+// methodsPermissions declares permission every RPC requires in addition to credentials from methodsAuth.
+// RPCs of AuthService and KeyDirectoryService are available to every user, so they are added as public in init
+var methodsPermissions = rbac.Policy{
+	reflectionv1.ServerReflection_ServerReflectionInfo_FullMethodName:      rbac.Public,
+	reflectionv1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: rbac.Public,
+
+	authv1grpc.PermissionsService_GetUserPermissions_FullMethodName: rbac.Public,
+
+	authv1grpc.AdminService_SearchUsers_FullMethodName:          entity.PERMISSION_USERS_READ,
+	authv1grpc.AdminService_GetUserDetails_FullMethodName:       entity.PERMISSION_USERS_READ,
+	authv1grpc.AdminService_ChangeAccountState_FullMethodName:   entity.PERMISSION_USERS_WRITE,
+	authv1grpc.AdminService_ForceLogout_FullMethodName:          entity.PERMISSION_USERS_WRITE,
+	authv1grpc.AdminService_ResetTwoFa_FullMethodName:           entity.PERMISSION_USERS_WRITE,
+	authv1grpc.AdminService_TriggerPasswordReset_FullMethodName: entity.PERMISSION_USERS_WRITE,
+	authv1grpc.AdminService_SearchSecurityEvents_FullMethodName: entity.PERMISSION_USERS_READ,
+	authv1grpc.AdminService_GetAdminActions_FullMethodName:      entity.PERMISSION_ADMIN_ACTIONS_READ,
+	authv1grpc.AdminService_GetRoles_FullMethodName:             entity.PERMISSION_ROLES_READ,
+	authv1grpc.AdminService_GetUserRoles_FullMethodName:         entity.PERMISSION_ROLES_READ,
+	authv1grpc.AdminService_SaveRole_FullMethodName:             entity.PERMISSION_ROLES_WRITE,
+	authv1grpc.AdminService_DeleteRole_FullMethodName:           entity.PERMISSION_ROLES_WRITE,
+	authv1grpc.AdminService_AssignRole_FullMethodName:           entity.PERMISSION_ROLES_WRITE,
+	authv1grpc.AdminService_RevokeRole_FullMethodName:           entity.PERMISSION_ROLES_WRITE,
+	authv1grpc.AdminService_CreateInvite_FullMethodName:         entity.PERMISSION_INVITES_WRITE,
+}
+
+func init() {
+	for _, desc := range []grpc.ServiceDesc{authv1grpc.AuthService_ServiceDesc, authv1grpc.KeyDirectoryService_ServiceDesc} {
+		for _, method := range desc.Methods {
+			methodsPermissions["/"+desc.ServiceName+"/"+method.MethodName] = rbac.Public
+		}
+		for _, stream := range desc.Streams {
+			methodsPermissions["/"+desc.ServiceName+"/"+stream.StreamName] = rbac.Public
+		}
+	}
+}
+
+// NewEnforcer returns enforcer of methodsPermissions, its interceptors must be chained after AuthInterceptor
+func NewEnforcer(service *auth.Service) *rbac.Enforcer {
+	return rbac.NewEnforcer(
+		methodsPermissions,
+		func(ctx context.Context) (int, bool) {
+			caller := callerFromCtx(ctx)
+			return caller.UserId, caller.UserId != 0
+		},
+		rbac.ResolverFunc(service.GetUserPermissions),
+	)
+}
@@ -0,0 +1,51 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) WatchQRLogin(req *pb.WatchQRLoginRequest, stream grpc.ServerStreamingServer[pb.WatchQRLoginResponse]) error {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(stream.Context())
+	ctx := logger.CtxWithCorrelationID(stream.Context(), correlationId)
+
+	reqDto := &dtos.WatchQRLoginRequest{
+		ClientId:     req.GetClientId(),
+		IpAddr:       req.GetIpAddr(),
+		DeviceInfo:   req.GetDeviceInfo(),
+		QRCodeFormat: entity.QRCodeFormat(req.GetQrCodeFormat()),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return newValidationError(errs)
+	}
+
+	// errors of sending are returned as is, they are already reported by stream
+	var sendErr error
+	err := a.service.WatchQRLogin(ctx, reqDto, func(update *dtos.QRLoginUpdate) error {
+		sendErr = stream.Send(mapQRLoginUpdate(update))
+		return sendErr
+	})
+	if err != nil {
+		if sendErr != nil {
+			return sendErr
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		if errors.Is(err, auth.ErrUnsupportedQRCodeFormat) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return ErrInternalError
+	}
+	return nil
+}
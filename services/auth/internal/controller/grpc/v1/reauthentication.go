@@ -0,0 +1,133 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) DeleteAllSessions(
+	ctx context.Context,
+	req *pb.DeleteAllSessionsRequest,
+) (*pb.DeleteAllSessionsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.DeleteAllSessions(ctx, caller.UserId, caller.SessionId); err != nil {
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.DeleteAllSessionsResponse{}, nil
+}
+
+func (a *AuthV1) DeactivateAccount(
+	ctx context.Context,
+	req *pb.DeactivateAccountRequest,
+) (*pb.DeactivateAccountResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.DeactivateAccount(ctx, caller.UserId, caller.SessionId); err != nil {
+		if errors.Is(err, auth.ErrInvalidAccountStateTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.DeactivateAccountResponse{}, nil
+}
+
+func (a *AuthV1) DisableTwoFa(ctx context.Context, req *pb.DisableTwoFaRequest) (*pb.DisableTwoFaResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.DisableTwoFa(ctx, caller.UserId, caller.SessionId); err != nil {
+		if errors.Is(err, auth.Err2FANotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, mapRecentAuthError(err)
+	}
+
+	return &pb.DisableTwoFaResponse{}, nil
+}
+
+func (a *AuthV1) RequestReauthenticationCode(
+	ctx context.Context,
+	req *pb.RequestReauthenticationCodeRequest,
+) (*pb.RequestReauthenticationCodeResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.RequestReauthenticationCode(ctx, caller.UserId, caller.SessionId); err != nil {
+		if errors.Is(err, auth.Err2FANotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.RequestReauthenticationCodeResponse{}, nil
+}
+
+func mapReauthenticationMethod(method pb.ReauthenticateRequest_Method) entity.AuthMethod {
+	switch method {
+	case pb.ReauthenticateRequest_METHOD_PASSWORD:
+		return entity.AUTH_METHOD_PASSWORD
+	case pb.ReauthenticateRequest_METHOD_TWO_FA:
+		return entity.AUTH_METHOD_TWO_FA
+	default:
+		return ""
+	}
+}
+
+func (a *AuthV1) Reauthenticate(ctx context.Context, req *pb.ReauthenticateRequest) (*pb.ReauthenticateResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	reqDto := &dtos.ReauthenticateRequest{
+		UserId:    caller.UserId,
+		SessionId: caller.SessionId,
+		Method:    mapReauthenticationMethod(req.GetMethod()),
+		Password:  req.GetPassword(),
+		Code:      req.GetCode(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	session, err := a.service.Reauthenticate(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidCredentials) || errors.Is(err, auth.ErrOtpIsNotValid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, auth.Err2FANotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if isAccountStateError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, auth.ErrSessionNotFound) ||
+			errors.Is(err, auth.ErrUserNotFound) ||
+			errors.Is(err, auth.ErrTooManyReauthenticationAttempts) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.ReauthenticateResponse{Session: mapSession(session)}, nil
+}
@@ -16,4 +16,13 @@ func Register(
 ) {
 	auth := newAuthController(authService, log, validate)
 	pb.RegisterAuthServiceServer(registrar, auth)
+
+	admin := newAdminController(authService, log)
+	pb.RegisterAdminServiceServer(registrar, admin)
+
+	permissions := newPermissionsController(authService, log)
+	pb.RegisterPermissionsServiceServer(registrar, permissions)
+
+	keyDirectory := newKeyDirectoryController(authService, log)
+	pb.RegisterKeyDirectoryServiceServer(registrar, keyDirectory)
 }
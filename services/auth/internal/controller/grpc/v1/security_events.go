@@ -0,0 +1,32 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) GetSecurityEvents(
+	ctx context.Context,
+	req *pb.GetSecurityEventsRequest,
+) (*pb.GetSecurityEventsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	page, err := a.service.GetUserSecurityEvents(ctx, caller.UserId, req.GetBeforeId(), int(req.GetLimit()))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidSecurityEventsFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return mapSecurityEventsPage(page), nil
+}
@@ -0,0 +1,64 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) PingSession(ctx context.Context, req *pb.PingSessionRequest) (*pb.PingSessionResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	session, err := a.service.PingSession(ctx, caller.UserId, caller.SessionId)
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.PingSessionResponse{Session: mapSession(session)}, nil
+}
+
+func (a *AuthV1) GetActiveSessions(
+	ctx context.Context,
+	req *pb.GetActiveSessionsRequest,
+) (*pb.GetActiveSessionsResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	sessions, err := a.service.GetActiveSessions(ctx, caller.UserId)
+	if err != nil {
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetActiveSessionsResponse{Sessions: make([]*pb.AuthSession, 0, len(sessions))}
+	for i := range sessions {
+		resp.Sessions = append(resp.Sessions, mapSession(&sessions[i]))
+	}
+	return resp, nil
+}
+
+func (a *AuthV1) DeleteSession(ctx context.Context, req *pb.DeleteSessionRequest) (*pb.DeleteSessionResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.DeleteSession(ctx, caller.UserId, req.GetSessionId()); err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.DeleteSessionResponse{}, nil
+}
@@ -0,0 +1,106 @@
+package rpc_v1
+
+import (
+	"context"
+	"errors"
+
+	pb "buf.build/gen/go/co3n/goose-proto/protocolbuffers/go/auth/v1"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/internal/utils"
+	"github.com/modulix-systems/goose-talk/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *AuthV1) VerifyTwoFa(ctx context.Context, req *pb.VerifyTwoFaRequest) (*pb.VerifyTwoFaResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+
+	reqDto := &dtos.Verify2FARequest{
+		TwoFATyp:               mapTwoFaMethodFromPb(req.GetMethod()),
+		Email:                  req.GetEmail(),
+		Code:                   req.GetCode(),
+		SignInConfirmationCode: req.GetSignInConfirmationCode(),
+		RememberMe:             req.GetRememberMe(),
+		IpAddr:                 req.GetIpAddr(),
+		DeviceInfo:             req.GetDeviceInfo(),
+		TrustDevice:            req.GetTrustDevice(),
+		PublicKey:              req.GetPublicKey(),
+	}
+	if errs := reqDto.Validate(); len(errs) > 0 {
+		return nil, newValidationError(errs)
+	}
+
+	result, err := a.service.VerifyTwoFa(ctx, reqDto)
+	if err != nil {
+		if errors.Is(err, auth.ErrOtpIsNotValid) || errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.InvalidArgument, auth.ErrOtpIsNotValid.Error())
+		}
+		if errors.Is(err, auth.Err2FANotEnabled) || errors.Is(err, auth.ErrPasswordResetRequired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if isAccountStateError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.VerifyTwoFaResponse{
+		Session:            mapSession(result.Session),
+		TrustedDeviceToken: result.TrustedDeviceToken,
+	}, nil
+}
+
+func (a *AuthV1) GetTrustedDevices(
+	ctx context.Context,
+	req *pb.GetTrustedDevicesRequest,
+) (*pb.GetTrustedDevicesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	devices, err := a.service.GetTrustedDevices(ctx, caller.UserId)
+	if err != nil {
+		return nil, ErrInternalError
+	}
+
+	resp := &pb.GetTrustedDevicesResponse{Devices: make([]*pb.TrustedDevice, 0, len(devices))}
+	for i := range devices {
+		resp.Devices = append(resp.Devices, mapTrustedDevice(&devices[i]))
+	}
+	return resp, nil
+}
+
+func (a *AuthV1) RevokeTrustedDevice(
+	ctx context.Context,
+	req *pb.RevokeTrustedDeviceRequest,
+) (*pb.RevokeTrustedDeviceResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.RevokeTrustedDevice(ctx, caller.UserId, int(req.GetDeviceId())); err != nil {
+		if errors.Is(err, auth.ErrTrustedDeviceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, ErrInternalError
+	}
+
+	return &pb.RevokeTrustedDeviceResponse{}, nil
+}
+
+func (a *AuthV1) RevokeAllTrustedDevices(
+	ctx context.Context,
+	req *pb.RevokeAllTrustedDevicesRequest,
+) (*pb.RevokeAllTrustedDevicesResponse, error) {
+	correlationId := utils.GetCorrelationIdFromGrpcCtx(ctx)
+	ctx = logger.CtxWithCorrelationID(ctx, correlationId)
+	caller := callerFromCtx(ctx)
+
+	if err := a.service.RevokeAllTrustedDevices(ctx, caller.UserId); err != nil {
+		return nil, ErrInternalError
+	}
+
+	return &pb.RevokeAllTrustedDevicesResponse{}, nil
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// AccountDeletion periodically sends reminders about upcoming deletions,
+// erases accounts whose grace period is over and announces erased accounts to other services
+type AccountDeletion struct {
+	service   *auth.Service
+	log       logger.Interface
+	interval  time.Duration
+	batchSize int
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func NewAccountDeletion(service *auth.Service, log logger.Interface, interval time.Duration, batchSize int) *AccountDeletion {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &AccountDeletion{
+		service:   service,
+		log:       log,
+		interval:  interval,
+		batchSize: batchSize,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+func (j *AccountDeletion) Run() {
+	defer close(j.done)
+	j.log.Info("Account deletion job started", "interval", j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		// every run gets its own generated correlation id
+		ctx := logger.CtxWithCorrelationID(j.ctx, "")
+		if err := j.service.ProcessAccountDeletions(ctx, j.batchSize); err != nil {
+			j.log.Error(fmt.Errorf("jobs - AccountDeletion.Run - service.ProcessAccountDeletions: %w", err))
+		}
+
+		select {
+		case <-j.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *AccountDeletion) Stop() {
+	j.log.Info("Stopping account deletion job")
+	j.cancel()
+	<-j.done
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// DataExports periodically assembles archives of exports whose data is collected
+// and removes archives which may no longer be downloaded
+type DataExports struct {
+	service   *auth.Service
+	log       logger.Interface
+	interval  time.Duration
+	batchSize int
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func NewDataExports(service *auth.Service, log logger.Interface, interval time.Duration, batchSize int) *DataExports {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &DataExports{
+		service:   service,
+		log:       log,
+		interval:  interval,
+		batchSize: batchSize,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+func (j *DataExports) Run() {
+	defer close(j.done)
+	j.log.Info("Data exports job started", "interval", j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		// every run gets its own generated correlation id
+		ctx := logger.CtxWithCorrelationID(j.ctx, "")
+		if err := j.service.ProcessDataExports(ctx, j.batchSize); err != nil {
+			j.log.Error(fmt.Errorf("jobs - DataExports.Run - service.ProcessDataExports: %w", err))
+		}
+
+		select {
+		case <-j.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *DataExports) Stop() {
+	j.log.Info("Stopping data exports job")
+	j.cancel()
+	<-j.done
+}
@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// Reencryption periodically re-encrypts sensitive values with active master key,
+// so retired master keys can be removed from configuration once it reports nothing to do
+type Reencryption struct {
+	service   *auth.Service
+	log       logger.Interface
+	interval  time.Duration
+	batchSize int
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func NewReencryption(service *auth.Service, log logger.Interface, interval time.Duration, batchSize int) *Reencryption {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Reencryption{
+		service:   service,
+		log:       log,
+		interval:  interval,
+		batchSize: batchSize,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+func (j *Reencryption) Run() {
+	defer close(j.done)
+	j.log.Info("Re-encryption job started", "interval", j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		// every run gets its own generated correlation id
+		ctx := logger.CtxWithCorrelationID(j.ctx, "")
+		if _, err := j.service.ReencryptTotpSecrets(ctx, j.batchSize); err != nil {
+			j.log.Error(fmt.Errorf("jobs - Reencryption.Run - service.ReencryptTotpSecrets: %w", err))
+		}
+
+		select {
+		case <-j.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Reencryption) Stop() {
+	j.log.Info("Stopping re-encryption job")
+	j.cancel()
+	<-j.done
+}
@@ -0,0 +1,58 @@
+package rmq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	usersContracts "github.com/modulix-systems/goose-talk/contracts/rmqcontracts/users"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// DataExportPartsHandler stores data other services collected for requested exports
+type DataExportPartsHandler struct {
+	authService *auth.Service
+	log         logger.Interface
+}
+
+func NewDataExportPartsHandler(authService *auth.Service, log logger.Interface) *DataExportPartsHandler {
+	return &DataExportPartsHandler{authService, log}
+}
+
+// Handle acknowledges parts which can never be stored, e.g malformed or sent after export was assembled,
+// so they are not redelivered forever
+func (h *DataExportPartsHandler) Handle(delivery amqp091.Delivery) error {
+	var part usersContracts.DataExportPart
+	if err := json.Unmarshal(delivery.Body, &part); err != nil {
+		h.log.Error("rmq - DataExportPartsHandler.Handle - error parse delivery", "err", err, "correlationId", delivery.CorrelationId)
+		return nil
+	}
+
+	h.log.Info(
+		"rmq - DataExportPartsHandler.Handle - handling data export part",
+		"exportId", part.ExportId, "service", part.Service, "correlationId", delivery.CorrelationId,
+	)
+
+	ctx := logger.CtxWithCorrelationID(context.Background(), delivery.CorrelationId)
+	err := h.authService.SaveDataExportPart(ctx, &entity.DataExportPart{
+		ExportId: part.ExportId,
+		Service:  part.Service,
+		Data:     part.Data,
+	})
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidDataExportPart) || errors.Is(err, auth.ErrDataExportNotFound) {
+			h.log.Warn(
+				"rmq - DataExportPartsHandler.Handle - data export part rejected",
+				"err", err, "exportId", part.ExportId, "service", part.Service, "correlationId", delivery.CorrelationId,
+			)
+			return nil
+		}
+		h.log.Error("rmq - DataExportPartsHandler.Handle - error saving data export part", "err", err)
+		return err
+	}
+
+	return nil
+}
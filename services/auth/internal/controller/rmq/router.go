@@ -0,0 +1,18 @@
+package rmq
+
+import (
+	usersContracts "github.com/modulix-systems/goose-talk/contracts/rmqcontracts/users"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rabbitmq"
+)
+
+func Register(
+	server *rabbitmq.Server,
+	authService *auth.Service,
+	log logger.Interface,
+) {
+	contracts := usersContracts.New()
+	dataExportPartsHandler := NewDataExportPartsHandler(authService, log)
+	server.RegisterQueue(contracts.Queues.DataExportParts, dataExportPartsHandler)
+}
@@ -0,0 +1,186 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+	"github.com/modulix-systems/goose-talk/internal/services/auth"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const helpText = `Available commands:
+/sessions - list active sessions
+/logout <id> - terminate session with provided id
+/logout_all - terminate all sessions
+/lock - deactivate account and terminate all sessions`
+
+type Handler struct {
+	service *auth.Service
+	bot     gateways.TelegramBotClient
+	log     logger.Interface
+}
+
+func NewHandler(service *auth.Service, bot gateways.TelegramBotClient, log logger.Interface) *Handler {
+	return &Handler{service, bot, log}
+}
+
+func (h *Handler) Handle(ctx context.Context, update gateways.TelegramUpdate) {
+	ctx = logger.CtxWithCorrelationID(ctx, "tg-update-"+strconv.Itoa(update.Id))
+	switch {
+	case update.Message != nil:
+		h.handleMessage(ctx, update.Message)
+	case update.Callback != nil:
+		h.handleCallback(ctx, update.Callback)
+	}
+}
+
+func (h *Handler) reply(ctx context.Context, chatId string, text string) {
+	if err := h.bot.SendTextMsg(ctx, chatId, text); err != nil {
+		h.log.Error("tgbot - Handler.reply - bot.SendTextMsg", "err", err, "chatId", chatId)
+	}
+}
+
+func (h *Handler) handleMessage(ctx context.Context, msg *gateways.TelegramMsg) {
+	command, arg, _ := strings.Cut(strings.TrimSpace(msg.Text), " ")
+	arg = strings.TrimSpace(arg)
+	h.log.Info("tgbot - Handler.handleMessage - handling command", "command", command, "chatId", msg.ChatId)
+
+	if command == "/start" {
+		h.handleStart(ctx, msg.ChatId, arg)
+		return
+	}
+
+	user, err := h.service.GetUserByTelegramChat(ctx, msg.ChatId)
+	if err != nil {
+		h.replyError(ctx, msg.ChatId, err)
+		return
+	}
+
+	switch command {
+	case "/sessions":
+		h.handleSessions(ctx, msg.ChatId, user)
+	case "/logout":
+		h.handleLogout(ctx, msg.ChatId, user, arg)
+	case "/logout_all":
+		h.handleLogoutAll(ctx, msg.ChatId, user)
+	case "/lock":
+		h.handleLock(ctx, msg.ChatId, user)
+	default:
+		h.reply(ctx, msg.ChatId, helpText)
+	}
+}
+
+func (h *Handler) handleStart(ctx context.Context, chatId string, code string) {
+	if code == "" {
+		h.reply(ctx, chatId, helpText)
+		return
+	}
+	if err := h.service.LinkTelegramChat(ctx, code, chatId); err != nil {
+		h.replyError(ctx, chatId, err)
+	}
+}
+
+func (h *Handler) handleSessions(ctx context.Context, chatId string, user *entity.User) {
+	sessions, err := h.service.GetActiveSessions(ctx, user.Id)
+	if err != nil {
+		h.replyError(ctx, chatId, err)
+		return
+	}
+	if len(sessions) == 0 {
+		h.reply(ctx, chatId, "You have no active sessions")
+		return
+	}
+
+	lines := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		lines = append(lines, fmt.Sprintf(
+			"ID: %s\nDevice: %s\nLocation: %s\nIP: %s\nLast seen: %s",
+			session.Id, session.DeviceName(), session.Location, session.IpAddr, session.LastSeenAt.Format("2006-01-02 15:04 MST"),
+		))
+	}
+	h.reply(ctx, chatId, strings.Join(lines, "\n\n"))
+}
+
+func (h *Handler) handleLogout(ctx context.Context, chatId string, user *entity.User, sessionId string) {
+	if sessionId == "" {
+		h.reply(ctx, chatId, "Usage: /logout <id>. Use /sessions to find session id")
+		return
+	}
+	if err := h.service.DeleteSession(ctx, user.Id, sessionId); err != nil {
+		h.replyError(ctx, chatId, err)
+		return
+	}
+	h.reply(ctx, chatId, "Session has been terminated")
+}
+
+func (h *Handler) handleLogoutAll(ctx context.Context, chatId string, user *entity.User) {
+	if err := h.service.DeleteAllSessionsFromTelegram(ctx, user.Id); err != nil {
+		h.replyError(ctx, chatId, err)
+		return
+	}
+	h.reply(ctx, chatId, "All sessions have been terminated")
+}
+
+func (h *Handler) handleLock(ctx context.Context, chatId string, user *entity.User) {
+	if err := h.service.DeactivateAccountFromTelegram(ctx, user.Id); err != nil {
+		h.replyError(ctx, chatId, err)
+		return
+	}
+	if err := h.service.DeleteAllSessionsFromTelegram(ctx, user.Id); err != nil {
+		h.replyError(ctx, chatId, err)
+		return
+	}
+	h.reply(ctx, chatId, "Your account has been deactivated and all sessions have been terminated")
+}
+
+func (h *Handler) handleCallback(ctx context.Context, callback *gateways.TelegramCallback) {
+	answer := "Session has been terminated"
+	defer func() {
+		if err := h.bot.AnswerCallback(ctx, callback.Id, answer); err != nil {
+			h.log.Error("tgbot - Handler.handleCallback - bot.AnswerCallback", "err", err, "chatId", callback.ChatId)
+		}
+	}()
+
+	sessionId, ok := auth.ParseTelegramRevokeSessionCallback(callback.Data)
+	if !ok {
+		answer = "Unknown action"
+		return
+	}
+
+	user, err := h.service.GetUserByTelegramChat(ctx, callback.ChatId)
+	if err != nil {
+		answer = errorText(err)
+		return
+	}
+
+	if err = h.service.DeleteSession(ctx, user.Id, sessionId); err != nil {
+		answer = errorText(err)
+		return
+	}
+	h.reply(ctx, callback.ChatId, "Session has been terminated. If you didn't sign in, consider changing your password")
+}
+
+func (h *Handler) replyError(ctx context.Context, chatId string, err error) {
+	h.reply(ctx, chatId, errorText(err))
+}
+
+func errorText(err error) string {
+	switch {
+	case errors.Is(err, auth.ErrTelegramNotLinked),
+		errors.Is(err, auth.ErrInvalidTelegramLinkCode),
+		errors.Is(err, auth.ErrDeactivatedAccount),
+		errors.Is(err, auth.ErrAccountLocked),
+		errors.Is(err, auth.ErrAccountSuspended),
+		errors.Is(err, auth.ErrAccountBanned),
+		errors.Is(err, auth.ErrSessionNotFound),
+		errors.Is(err, auth.ErrUserNotFound):
+		return err.Error()
+	default:
+		return "Something went wrong. Please try again later"
+	}
+}
@@ -0,0 +1,73 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const _retryTimeout = 5 * time.Second
+
+// Server long polls telegram bot updates and dispatches them to the handler one by one
+type Server struct {
+	bot         gateways.TelegramBotClient
+	handler     *Handler
+	log         logger.Interface
+	pollTimeout time.Duration
+	ctx         context.Context
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+func NewServer(bot gateways.TelegramBotClient, handler *Handler, log logger.Interface, pollTimeout time.Duration) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{
+		bot:         bot,
+		handler:     handler,
+		log:         log,
+		pollTimeout: pollTimeout,
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+func (s *Server) Run() {
+	defer close(s.done)
+	s.log.Info("Telegram bot is ready to accept updates")
+
+	offset := 0
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		default:
+		}
+
+		updates, err := s.bot.GetUpdates(s.ctx, offset, s.pollTimeout)
+		if err != nil {
+			s.log.Error(fmt.Errorf("tgbot - Server.Run - bot.GetUpdates: %w", err), "offset", offset)
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(_retryTimeout):
+			}
+			continue
+		}
+
+		for _, update := range updates {
+			// confirm update even if handling fails to not receive it again
+			offset = update.Id + 1
+			s.handler.Handle(s.ctx, update)
+		}
+	}
+}
+
+func (s *Server) Stop() {
+	s.log.Info("Stopping telegram bot")
+	s.cancel()
+	<-s.done
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+type CreateAccessTokenRequest struct {
+	UserId int `validate:"required"`
+	// SessionId is a session which initiated the action, it must be recently authenticated
+	SessionId string                    `validate:"required"`
+	Name      string                    `validate:"required,max=64"`
+	Scopes    []entity.AccessTokenScope `validate:"required,min=1,dive,oneof=profile:read profile:write sessions:read sessions:write security_events:read"`
+	ExpiresAt time.Time                 `validate:"required"`
+}
+
+func (req *CreateAccessTokenRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type CreateAccessTokenResponse struct {
+	AccessToken *entity.AccessToken
+	// Token is a plain token value, it is not possible to obtain it again
+	Token string
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+type ReactivateAccountRequest struct {
+	Email string `validate:"required,email"`
+	Code  string `validate:"required,len=6"`
+}
+
+func (req *ReactivateAccountRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// ChangeAccountStateRequest is admin's decision about account.
+// Until makes suspension temporary, it is ignored for other states
+type ChangeAccountStateRequest struct {
+	AdminId int `validate:"required"`
+	// SessionId is admin's session which initiated the action, it must be recently authenticated
+	SessionId string              `validate:"required"`
+	UserId    int                 `validate:"required"`
+	State     entity.AccountState `validate:"required,oneof=active suspended banned"`
+	Reason    string              `validate:"required_unless=State active,max=500"`
+	Until     *time.Time
+}
+
+func (req *ChangeAccountStateRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
@@ -0,0 +1,65 @@
+package dtos
+
+import (
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+type AdminSearchUsersRequest struct {
+	AdminId int `validate:"required"`
+	// SessionId is admin's session which initiated the action, it must be recently authenticated
+	SessionId string `validate:"required"`
+	// Query is matched against user id, email and username prefix
+	Query string `validate:"required,max=254"`
+	Limit int    `validate:"omitempty,min=1,max=100"`
+}
+
+func (req *AdminSearchUsersRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// AdminUserRequest is admin's action targeting single account
+type AdminUserRequest struct {
+	AdminId int `validate:"required"`
+	// SessionId is admin's session which initiated the action, it must be recently authenticated
+	SessionId string `validate:"required"`
+	UserId    int    `validate:"required"`
+}
+
+func (req *AdminUserRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// AdminUserDetails is everything support staff needs to know about account
+// in order to help its owner. User includes 2FA and passkey credentials
+type AdminUserDetails struct {
+	User     *entity.User
+	Sessions []entity.AuthSession
+}
+
+// AdminActionsFilter narrows down admin audit log query.
+// Zero values are ignored. Actions are returned from newest to oldest
+type AdminActionsFilter struct {
+	AdminId      int
+	TargetUserId int
+	Actions      []entity.AdminActionType
+	// BeforeId is a pagination cursor - NextCursor of previous page
+	BeforeId int64 `validate:"omitempty,min=1"`
+	Limit    int   `validate:"omitempty,min=1,max=100"`
+}
+
+func (req *AdminActionsFilter) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type AdminActionsPage struct {
+	Actions []entity.AdminAction
+	// NextCursor is zero if there are no more actions
+	NextCursor int64
+}
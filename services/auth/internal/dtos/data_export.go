@@ -0,0 +1,26 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+)
+
+// DataExportManifest describes content of personal data archive
+type DataExportManifest struct {
+	ExportId    string    `json:"export_id"`
+	UserId      int       `json:"user_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	GeneratedAt time.Time `json:"generated_at"`
+	// Services sent their data which is included into archive
+	Services []string `json:"services"`
+	// MissingServices did not send their data in time
+	MissingServices []string `json:"missing_services"`
+}
+
+// TwoFactorAuthExport is 2FA configuration without secrets
+type TwoFactorAuthExport struct {
+	Method  entity.TwoFaMethod `json:"method"`
+	Contact string             `json:"contact"`
+	Enabled bool               `json:"enabled"`
+}
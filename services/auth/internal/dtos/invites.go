@@ -0,0 +1,31 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+type CreateInviteRequest struct {
+	UserId int `validate:"required"`
+	// SessionId is a session which initiated the action, it must be recently authenticated
+	// if invite is created by admin
+	SessionId string `validate:"required"`
+	// Email optionally binds invite to the only email which may sign up with it
+	Email     string    `validate:"omitempty,email"`
+	MaxUses   int       `validate:"required,min=1"`
+	ExpiresAt time.Time `validate:"required"`
+}
+
+func (req *CreateInviteRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type CreateInviteResponse struct {
+	Invite *entity.Invite
+	// Code is a plain invite code, it is not possible to obtain it again
+	Code string
+}
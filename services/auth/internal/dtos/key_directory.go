@@ -0,0 +1,70 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+// SignedPrekeyUpload is X25519 public key signed by device's identity key
+type SignedPrekeyUpload struct {
+	KeyId int `validate:"required,min=1"`
+	// PublicKey is base64url encoded raw 32 bytes X25519 key
+	PublicKey string `validate:"required,len=43,base64rawurl"`
+	// Signature is base64url encoded signature of decoded PublicKey bytes
+	Signature string `validate:"required"`
+}
+
+type OneTimePrekeyUpload struct {
+	KeyId int `validate:"required,min=1"`
+	// PublicKey is base64url encoded raw 32 bytes X25519 key
+	PublicKey string `validate:"required,len=43,base64rawurl"`
+}
+
+// RegisterDeviceKeysRequest publishes device in key directory.
+// IdentityKey is base64url encoded PKIX Ed25519 or P-256 key
+type RegisterDeviceKeysRequest struct {
+	UserId         int                   `validate:"required"`
+	DeviceId       string                `validate:"required,max=64"`
+	IdentityKey    string                `validate:"required"`
+	SignedPrekey   SignedPrekeyUpload    `validate:"required"`
+	OneTimePrekeys []OneTimePrekeyUpload `validate:"max=100,dive"`
+}
+
+func (req *RegisterDeviceKeysRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type RotateSignedPrekeyRequest struct {
+	UserId       int                `validate:"required"`
+	DeviceId     string             `validate:"required"`
+	SignedPrekey SignedPrekeyUpload `validate:"required"`
+}
+
+func (req *RotateSignedPrekeyRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type UploadOneTimePrekeysRequest struct {
+	UserId   int                   `validate:"required"`
+	DeviceId string                `validate:"required"`
+	Prekeys  []OneTimePrekeyUpload `validate:"required,min=1,max=100,dive"`
+}
+
+func (req *UploadOneTimePrekeysRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// DeviceKeysStatus tells device whether it should replenish or rotate its prekeys
+type DeviceKeysStatus struct {
+	OneTimePrekeys          int
+	OneTimePrekeysLow       bool
+	SignedPrekeyCreatedAt   time.Time
+	SignedPrekeyRotationDue bool
+}
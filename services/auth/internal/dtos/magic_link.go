@@ -0,0 +1,37 @@
+package dtos
+
+import "github.com/modulix-systems/goose-talk/pkg/validator"
+
+type RequestMagicLinkRequest struct {
+	Email string `validate:"required,email"`
+	// ClientId identifies client which requested the link, only this client may complete sign in
+	ClientId   string `validate:"required"`
+	IpAddr     string `validate:"required,ip"`
+	DeviceInfo string `validate:"required"`
+}
+
+func (req *RequestMagicLinkRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// MagicLinkSignInRequest completes passwordless sign in with either token from emailed link or emailed code
+type MagicLinkSignInRequest struct {
+	ClientId   string `validate:"required"`
+	Token      string `validate:"required_without=Code"`
+	Code       string `validate:"required_without=Token"`
+	RememberMe bool
+	IpAddr     string `validate:"required,ip"`
+	DeviceInfo string `validate:"required"`
+	// TrustedDeviceToken obtained in VerifyTwoFa allows to skip 2FA
+	TrustedDeviceToken string
+	// PublicKey optionally binds created session to client's key, see AuthSession.PublicKey
+	PublicKey string
+}
+
+func (req *MagicLinkSignInRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
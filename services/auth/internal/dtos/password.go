@@ -0,0 +1,30 @@
+package dtos
+
+import "github.com/modulix-systems/goose-talk/pkg/validator"
+
+type ResetPasswordRequest struct {
+	Email string `validate:"required,email"`
+	Code  string `validate:"required,len=6"`
+	// NewPassword strength is checked against password policy by service
+	NewPassword string `validate:"required"`
+}
+
+func (req *ResetPasswordRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type ChangePasswordRequest struct {
+	UserId int `validate:"required"`
+	// SessionId is a session which initiated the change, other sessions are revoked
+	SessionId       string `validate:"required"`
+	CurrentPassword string `validate:"required"`
+	NewPassword     string `validate:"required,nefield=CurrentPassword"`
+}
+
+func (req *ChangePasswordRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
@@ -1,7 +1,39 @@
 package dtos
 
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
 type ExportLoginTokenRequest struct {
 	ClientId   string
 	IpAddr     string
 	DeviceInfo string
 }
+
+type WatchQRLoginRequest struct {
+	ClientId   string `validate:"required"`
+	IpAddr     string `validate:"required,ip"`
+	DeviceInfo string `validate:"required"`
+	// QRCodeFormat renders token url as image within every token update if set
+	QRCodeFormat entity.QRCodeFormat `validate:"omitempty,oneof=png svg"`
+}
+
+func (req *WatchQRLoginRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// QRLoginUpdate is either a new token which must replace displayed one before ExpiresAt
+// or a session which was created once token was accepted by another device
+type QRLoginUpdate struct {
+	Token     *entity.QRCodeLoginToken
+	Url       string
+	ExpiresAt time.Time
+	// QRCode is token url rendered in requested format
+	QRCode  []byte
+	Session *entity.AuthSession
+}
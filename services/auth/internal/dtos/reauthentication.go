@@ -0,0 +1,21 @@
+package dtos
+
+import (
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+type ReauthenticateRequest struct {
+	UserId    int               `validate:"required"`
+	SessionId string            `validate:"required"`
+	Method    entity.AuthMethod `validate:"required,oneof=password two_fa"`
+	Password  string            `validate:"required_if=Method password"`
+	// Code is obtained with RequestReauthenticationCode unless TOTP app is used
+	Code string `validate:"required_if=Method two_fa"`
+}
+
+func (req *ReauthenticateRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
@@ -0,0 +1,37 @@
+package dtos
+
+import (
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+	"github.com/modulix-systems/goose-talk/rbac"
+)
+
+// SaveRoleRequest creates role or replaces description and permissions of existing one
+type SaveRoleRequest struct {
+	AdminId int `validate:"required"`
+	// SessionId is admin's session which initiated the action, it must be recently authenticated
+	SessionId   string            `validate:"required"`
+	Name        string            `validate:"required,max=64"`
+	Description string            `validate:"max=500"`
+	Permissions []rbac.Permission `validate:"dive,required,max=128"`
+}
+
+func (req *SaveRoleRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+// UserRoleRequest assigns role to user or revokes it
+type UserRoleRequest struct {
+	AdminId int `validate:"required"`
+	// SessionId is admin's session which initiated the action, it must be recently authenticated
+	SessionId string `validate:"required"`
+	UserId    int    `validate:"required"`
+	RoleName  string `validate:"required,max=64"`
+}
+
+func (req *UserRoleRequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+// SecurityEventsFilter narrows down audit log query.
+// Zero values are ignored. Events are returned from newest to oldest
+type SecurityEventsFilter struct {
+	UserId int    `validate:"omitempty,min=1"`
+	IpAddr string `validate:"omitempty,ip"`
+	Types  []entity.SecurityEventType
+	// BeforeId is a pagination cursor - NextCursor of previous page
+	BeforeId int64 `validate:"omitempty,min=1"`
+	Limit    int   `validate:"omitempty,min=1,max=100"`
+}
+
+func (req *SecurityEventsFilter) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
+
+type SecurityEventsPage struct {
+	Events []entity.SecurityEvent
+	// NextCursor is zero if there are no more events
+	NextCursor int64
+}
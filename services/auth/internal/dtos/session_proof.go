@@ -0,0 +1,30 @@
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+// SessionProof proves that request within bound session is made by holder of session's private key
+type SessionProof struct {
+	// Nonce is a random client generated value unique within session
+	Nonce     string    `validate:"required,min=16,max=128"`
+	Timestamp time.Time `validate:"required"`
+	// Method is a full name of the RPC being performed, it is set by server so proof can not be reused for another RPC
+	Method string `validate:"required"`
+	// Signature is base64url encoded signature of Message
+	Signature string `validate:"required"`
+}
+
+// Message returns payload which client signs with session key
+func (p *SessionProof) Message(sessionId string) []byte {
+	return fmt.Appendf(nil, "%s\n%s\n%d\n%s", sessionId, p.Method, p.Timestamp.Unix(), p.Nonce)
+}
+
+func (p *SessionProof) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(p)
+	return validate.Errors
+}
@@ -8,12 +8,28 @@ type SignInRequest struct {
 	RememberMe bool
 	IpAddr     string `validate:"required,ip"`
 	DeviceInfo string `validate:"required"`
+	// TrustedDeviceToken obtained in VerifyTwoFa allows to skip 2FA
+	TrustedDeviceToken string
+	// PublicKey optionally binds created session to client's key, see AuthSession.PublicKey
+	PublicKey string
 }
 
 type SignInResponse struct {
 	// ConfirmationCode is present if user has totp 2fa verification method
 	// it should be used in Verify2FA to prove that user has went through signin first before trying to verify 2fa
 	ConfirmationCode string
-	User             *entity.User
-	Session          *entity.AuthSession
+	// ChallengeRequired is true if sign in looks suspicious and code was sent to user.
+	// It should be passed to VerifyLoginChallenge to obtain a session
+	ChallengeRequired bool
+	User              *entity.User
+	Session           *entity.AuthSession
+}
+
+type VerifyLoginChallengeRequest struct {
+	Login      string `validate:"required"`
+	Code       string `validate:"required"`
+	RememberMe bool
+	IpAddr     string `validate:"required,ip"`
+	DeviceInfo string `validate:"required"`
+	PublicKey  string
 }
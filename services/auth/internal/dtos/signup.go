@@ -8,8 +8,9 @@ import (
 )
 
 type SignUpRequest struct {
-	Username         string `validate:"required"`
-	Password         string `validate:"required,min=8"`
+	Username string `validate:"required"`
+	// Password strength is checked against password policy by service
+	Password         string `validate:"required"`
 	Email            string `validate:"required,email"`
 	FirstName        string
 	LastName         string
@@ -19,6 +20,8 @@ type SignUpRequest struct {
 	BirthDate        time.Time
 	AboutMe          string
 	PhotoUrl         string `validate:"omitempty,url"`
+	// InviteCode is required if registration is invite-only
+	InviteCode string `validate:"omitempty,startswith=gt_inv_,max=64" errorMsg:"Enter a valid invite code"`
 }
 
 func (req *SignUpRequest) Validate() validator.ValidationErrors {
@@ -1,22 +1,37 @@
 package dtos
 
-import "github.com/modulix-systems/goose-talk/internal/entity"
+import (
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
 
 type (
 	Verify2FARequest struct {
-		TwoFATyp   entity.TwoFaMethod
-		Email      string
-		Code       string
+		TwoFATyp   entity.TwoFaMethod `validate:"required,oneof=email sms telegram totp_app"`
+		Email      string             `validate:"required,email"`
+		Code       string             `validate:"required"`
 		RememberMe bool
-		IpAddr     string
-		DeviceInfo string
+		IpAddr     string `validate:"required,ip"`
+		DeviceInfo string `validate:"required"`
 		// SignInConfirmationCode must be present only if TOTP 2fa type is used
-		SignInConfirmationCode string
+		SignInConfirmationCode string `validate:"required_if=TwoFATyp totp_app"`
+		// TrustDevice requests token allowing to skip 2FA on this device next time
+		TrustDevice bool
+		// PublicKey optionally binds created session to client's key, see AuthSession.PublicKey
+		PublicKey string
+	}
+	Verify2FAResponse struct {
+		Session *entity.AuthSession
+		// TrustedDeviceToken is present only if device trust was requested and issued.
+		// It should be passed to SignIn from the same device
+		TrustedDeviceToken string
 	}
 	Add2FARequest struct {
-		UserId  int
-		Typ     entity.TwoFaMethod
-		Contact string
+		UserId int
+		// SessionId is a session which initiated the action, it must be recently authenticated
+		SessionId string
+		Typ       entity.TwoFaMethod
+		Contact   string
 	}
 	Confirm2FARequest struct {
 		UserId  int
@@ -28,3 +43,9 @@ type (
 		ConfirmationCode string
 	}
 )
+
+func (req *Verify2FARequest) Validate() validator.ValidationErrors {
+	validate := validator.New()
+	validate.ValidateStruct(req)
+	return validate.Errors
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// AccessTokenScope limits set of actions personal access token can be used for
+type AccessTokenScope string
+
+const (
+	ACCESS_TOKEN_SCOPE_PROFILE_READ         AccessTokenScope = "profile:read"
+	ACCESS_TOKEN_SCOPE_PROFILE_WRITE        AccessTokenScope = "profile:write"
+	ACCESS_TOKEN_SCOPE_SESSIONS_READ        AccessTokenScope = "sessions:read"
+	ACCESS_TOKEN_SCOPE_SESSIONS_WRITE       AccessTokenScope = "sessions:write"
+	ACCESS_TOKEN_SCOPE_SECURITY_EVENTS_READ AccessTokenScope = "security_events:read"
+)
+
+// AccessToken is a named non-interactive credential for bots and integrations.
+// Token is shown to user only once, its hash is kept along with Prefix which helps to identify it
+type AccessToken struct {
+	Id         int                `json:"id"`
+	UserId     int                `json:"user_id"`
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix"`
+	TokenHash  string             `json:"-"`
+	Scopes     []AccessTokenScope `json:"scopes"`
+	CreatedAt  time.Time          `json:"created_at"`
+	LastUsedAt *time.Time         `json:"last_used_at"`
+	ExpiresAt  time.Time          `json:"expires_at"`
+}
+
+func (t *AccessToken) HasScope(scope AccessTokenScope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
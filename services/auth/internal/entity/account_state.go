@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// AccountState is a lifecycle state of user's account
+type AccountState string
+
+const (
+	ACCOUNT_STATE_ACTIVE AccountState = "active"
+	// ACCOUNT_STATE_DEACTIVATED account was deactivated by its owner, owner may reactivate it
+	ACCOUNT_STATE_DEACTIVATED AccountState = "deactivated"
+	// ACCOUNT_STATE_LOCKED account is temporarily locked after abuse e.g too many failed sign in attempts.
+	// Lock is lifted once it expires or owner unlocks it
+	ACCOUNT_STATE_LOCKED AccountState = "locked"
+	// ACCOUNT_STATE_SUSPENDED account is suspended by admin, optionally until given time
+	ACCOUNT_STATE_SUSPENDED AccountState = "suspended"
+	// ACCOUNT_STATE_BANNED account is permanently blocked by admin
+	ACCOUNT_STATE_BANNED AccountState = "banned"
+	// ACCOUNT_STATE_PENDING_DELETION account is going to be erased, owner may still sign in to cancel it
+	ACCOUNT_STATE_PENDING_DELETION AccountState = "pending_deletion"
+)
+
+// accountStateTransitions lists states account may be moved to from each state
+var accountStateTransitions = map[AccountState][]AccountState{
+	ACCOUNT_STATE_ACTIVE: {
+		ACCOUNT_STATE_DEACTIVATED, ACCOUNT_STATE_LOCKED, ACCOUNT_STATE_SUSPENDED,
+		ACCOUNT_STATE_BANNED, ACCOUNT_STATE_PENDING_DELETION,
+	},
+	ACCOUNT_STATE_DEACTIVATED:      {ACCOUNT_STATE_ACTIVE, ACCOUNT_STATE_SUSPENDED, ACCOUNT_STATE_BANNED},
+	ACCOUNT_STATE_LOCKED:           {ACCOUNT_STATE_ACTIVE, ACCOUNT_STATE_SUSPENDED, ACCOUNT_STATE_BANNED},
+	ACCOUNT_STATE_SUSPENDED:        {ACCOUNT_STATE_ACTIVE, ACCOUNT_STATE_BANNED},
+	ACCOUNT_STATE_BANNED:           {ACCOUNT_STATE_ACTIVE},
+	ACCOUNT_STATE_PENDING_DELETION: {ACCOUNT_STATE_ACTIVE, ACCOUNT_STATE_SUSPENDED, ACCOUNT_STATE_BANNED},
+}
+
+// CanTransitionTo reports whether account in state s may be moved to target state
+func (s AccountState) CanTransitionTo(target AccountState) bool {
+	return slices.Contains(accountStateTransitions[s], target)
+}
+
+// AccountStatesFrom returns states from which account may be moved to target state
+func AccountStatesFrom(target AccountState) []AccountState {
+	states := make([]AccountState, 0, len(accountStateTransitions))
+	for state := range accountStateTransitions {
+		if state.CanTransitionTo(target) {
+			states = append(states, state)
+		}
+	}
+	slices.Sort(states)
+	return states
+}
+
+// AccountStateChange moves account to State. Until is set for temporary states only
+type AccountStateChange struct {
+	State  AccountState
+	Reason string
+	Until  *time.Time
+}
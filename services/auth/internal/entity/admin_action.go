@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type AdminActionType string
+
+const (
+	ADMIN_ACTION_SEARCH_USERS         AdminActionType = "search_users"
+	ADMIN_ACTION_VIEW_USER            AdminActionType = "view_user"
+	ADMIN_ACTION_CHANGE_ACCOUNT_STATE AdminActionType = "change_account_state"
+	ADMIN_ACTION_FORCE_LOGOUT         AdminActionType = "force_logout"
+	ADMIN_ACTION_RESET_TWO_FA         AdminActionType = "reset_two_fa"
+	ADMIN_ACTION_RESET_PASSWORD       AdminActionType = "reset_password"
+	ADMIN_ACTION_SAVE_ROLE            AdminActionType = "save_role"
+	ADMIN_ACTION_DELETE_ROLE          AdminActionType = "delete_role"
+	ADMIN_ACTION_ASSIGN_ROLE          AdminActionType = "assign_role"
+	ADMIN_ACTION_REVOKE_ROLE          AdminActionType = "revoke_role"
+	ADMIN_ACTION_CREATE_INVITE        AdminActionType = "create_invite"
+	ADMIN_ACTION_VIEW_SECURITY_EVENTS AdminActionType = "view_security_events"
+)
+
+// AdminAction is an immutable audit log record of action performed by admin.
+// TargetUserId is zero if action did not target particular account e.g search
+type AdminAction struct {
+	Id           int64           `json:"id"`
+	AdminId      int             `json:"admin_id"`
+	TargetUserId int             `json:"target_user_id"`
+	Action       AdminActionType `json:"action"`
+	// Details holds action specific data e.g search query or new account state
+	Details   map[string]string `json:"details"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -1,6 +1,28 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// SessionLimitPolicy defines what happens when user reaches max number of concurrent sessions
+type SessionLimitPolicy string
+
+const (
+	// SESSION_LIMIT_POLICY_EVICT deletes least recently seen sessions to make room for a new one
+	SESSION_LIMIT_POLICY_EVICT SessionLimitPolicy = "evict"
+	// SESSION_LIMIT_POLICY_REJECT refuses to create a new session
+	SESSION_LIMIT_POLICY_REJECT SessionLimitPolicy = "reject"
+)
+
+// AuthMethod is a way user proved their identity with
+type AuthMethod string
+
+const (
+	AUTH_METHOD_PASSWORD   AuthMethod = "password"
+	AUTH_METHOD_TWO_FA     AuthMethod = "two_fa"
+	AUTH_METHOD_MAGIC_LINK AuthMethod = "magic_link"
+)
 
 // AuthSession is a rolling auth session
 // which stores information about user's login within single device
@@ -9,10 +31,45 @@ type AuthSession struct {
 	UserId      int       `json:"user_id"`
 	LastSeenAt  time.Time `json:"last_seen_at"`
 	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
 	IsLongLived bool
 
+	// AuthenticatedAt is the last time user proved their identity within the session with AuthMethod.
+	// It is zero if session was created without strong authentication e.g by QR code login
+	AuthenticatedAt time.Time  `json:"authenticated_at"`
+	AuthMethod      AuthMethod `json:"auth_method"`
+
+	// PublicKey is base64url encoded PKIX public key of the client the session is bound to.
+	// Requests within bound session must be signed with corresponding private key
+	PublicKey string `json:"public_key"`
+
 	// Login metadata
 	Location   string `json:"location"`
 	IpAddr     string `json:"ip_addr"`
 	DeviceInfo string `json:"device_info"`
+	// Device is parsed from DeviceInfo
+	Device Device `json:"device"`
+}
+
+// DeviceKey identifies device which session was created from.
+// Sessions with equal ip and device key are considered to be created from the same device.
+// Raw device info is used if it could not be parsed
+func (s *AuthSession) DeviceKey() string {
+	if s.Device.IsUnknown() {
+		return strings.ToLower(s.DeviceInfo)
+	}
+	return s.Device.Family()
+}
+
+// DeviceName returns human readable device description falling back to raw device info
+func (s *AuthSession) DeviceName() string {
+	if name := s.Device.Name(); name != "" {
+		return name
+	}
+	return s.DeviceInfo
+}
+
+// IsRecentlyAuthenticated reports whether user proved their identity within given window
+func (s *AuthSession) IsRecentlyAuthenticated(window time.Duration) bool {
+	return !s.AuthenticatedAt.IsZero() && time.Since(s.AuthenticatedAt) <= window
 }
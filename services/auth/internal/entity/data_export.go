@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type DataExportStatus string
+
+const (
+	// DATA_EXPORT_STATUS_PENDING export waits for other services to send their data
+	DATA_EXPORT_STATUS_PENDING DataExportStatus = "pending"
+	// DATA_EXPORT_STATUS_READY archive is assembled and may be downloaded
+	DATA_EXPORT_STATUS_READY DataExportStatus = "ready"
+	// DATA_EXPORT_STATUS_EXPIRED archive is removed
+	DATA_EXPORT_STATUS_EXPIRED DataExportStatus = "expired"
+	// DATA_EXPORT_STATUS_FAILED archive could not be assembled within allowed number of attempts
+	DATA_EXPORT_STATUS_FAILED DataExportStatus = "failed"
+)
+
+// DataExport is user's request for archive with all personal data held about them.
+// CompletedAt, ExpiresAt and FileName are set once archive is assembled.
+// Attempts counts failed assemblies, next one is not made before NextAttemptAt
+type DataExport struct {
+	Id            string           `json:"id"`
+	UserId        int              `json:"user_id"`
+	Status        DataExportStatus `json:"status"`
+	RequestedAt   time.Time        `json:"requested_at"`
+	CollectUntil  time.Time        `json:"collect_until"`
+	CompletedAt   *time.Time       `json:"completed_at"`
+	ExpiresAt     *time.Time       `json:"expires_at"`
+	FileName      string           `json:"file_name"`
+	Attempts      int              `json:"attempts"`
+	NextAttemptAt *time.Time       `json:"next_attempt_at"`
+}
+
+// IsExpired reports whether archive may no longer be downloaded
+func (e *DataExport) IsExpired() bool {
+	return e.Status == DATA_EXPORT_STATUS_EXPIRED || (e.ExpiresAt != nil && !e.ExpiresAt.After(time.Now()))
+}
+
+// DataExportPart is personal data sent by another service for export
+type DataExportPart struct {
+	ExportId   string          `json:"export_id"`
+	Service    string          `json:"service"`
+	Data       json.RawMessage `json:"data"`
+	ReceivedAt time.Time       `json:"received_at"`
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+type DeviceType string
+
+const (
+	DEVICE_TYPE_UNKNOWN DeviceType = "unknown"
+	DEVICE_TYPE_DESKTOP DeviceType = "desktop"
+	DEVICE_TYPE_MOBILE  DeviceType = "mobile"
+	DEVICE_TYPE_TABLET  DeviceType = "tablet"
+	DEVICE_TYPE_BOT     DeviceType = "bot"
+)
+
+// Device is structured information about client extracted from its user agent.
+// Any field can be empty if user agent does not contain it
+type Device struct {
+	Type DeviceType `json:"type"`
+	// Platform is an operating system name e.g Windows, macOS, Android
+	Platform  string `json:"platform"`
+	OsVersion string `json:"os_version"`
+	// Client is a browser or application name
+	Client        string `json:"client"`
+	ClientVersion string `json:"client_version"`
+}
+
+func (d *Device) IsUnknown() bool {
+	return d.Platform == "" && d.Client == ""
+}
+
+// Name returns human readable device description e.g "Chrome 120 on Windows 10".
+// Empty string is returned for unknown device
+func (d *Device) Name() string {
+	platform := strings.TrimSpace(d.Platform + " " + d.OsVersion)
+	client := strings.TrimSpace(d.Client + " " + majorVersion(d.ClientVersion))
+	switch {
+	case client != "" && platform != "":
+		return fmt.Sprintf("%s on %s", client, platform)
+	case client != "":
+		return client
+	default:
+		return platform
+	}
+}
+
+// Family identifies device regardless of client and os versions,
+// so browser or system updates do not make device look like a new one
+func (d *Device) Family() string {
+	return strings.ToLower(fmt.Sprintf("%s|%s|%s", d.Type, d.Platform, d.Client))
+}
+
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+)
+
+const earthRadiusKm = 6371.0
+
+const (
+	UNKNOWN_LOCATION       = "Unknown"
+	LOCAL_NETWORK_LOCATION = "Local network"
+)
+
+// GeoLocation is an approximate location of ip address
+type GeoLocation struct {
+	City        string  `json:"city"`
+	Country     string  `json:"country"`
+	CountryCode string  `json:"country_code"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	// IsPrivate is true for loopback and private network addresses which can not be located
+	IsPrivate bool `json:"is_private"`
+}
+
+// String returns human readable location e.g "Berlin, Germany"
+func (l *GeoLocation) String() string {
+	switch {
+	case l == nil:
+		return UNKNOWN_LOCATION
+	case l.IsPrivate:
+		return LOCAL_NETWORK_LOCATION
+	case l.City != "" && l.Country != "":
+		return fmt.Sprintf("%s, %s", l.City, l.Country)
+	case l.Country != "":
+		return l.Country
+	default:
+		return UNKNOWN_LOCATION
+	}
+}
+
+// HasCoordinates reports whether location can be used for distance calculation
+func (l *GeoLocation) HasCoordinates() bool {
+	return l != nil && !l.IsPrivate && (l.Latitude != 0 || l.Longitude != 0)
+}
+
+// DistanceKm returns great-circle distance between two locations
+func (l *GeoLocation) DistanceKm(other *GeoLocation) float64 {
+	lat1, lat2 := l.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	deltaLat := lat2 - lat1
+	deltaLon := (other.Longitude - l.Longitude) * math.Pi / 180
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
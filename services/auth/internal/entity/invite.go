@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Invite allows to sign up while registration is invite-only.
+// Code is shown to inviter only once, its hash is kept along with Prefix which helps to identify it.
+// Invite bound to Email may only be redeemed by user signing up with that email
+type Invite struct {
+	Id        int        `json:"id"`
+	InviterId int        `json:"inviter_id"`
+	Prefix    string     `json:"prefix"`
+	CodeHash  string     `json:"-"`
+	Email     string     `json:"email"`
+	MaxUses   int        `json:"max_uses"`
+	Uses      int        `json:"uses"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+func (i *Invite) IsRedeemableBy(email string) bool {
+	return i.RevokedAt == nil &&
+		time.Now().Before(i.ExpiresAt) &&
+		i.Uses < i.MaxUses &&
+		(i.Email == "" || strings.EqualFold(i.Email, email))
+}
+
+// InviteRedemption tracks user who signed up with invite
+type InviteRedemption struct {
+	InviteId   int       `json:"invite_id"`
+	UserId     int       `json:"user_id"`
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
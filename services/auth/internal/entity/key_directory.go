@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// IdentityKey is a long-term public key of user's device.
+// Keys are uploaded by clients, server never sees private parts
+type IdentityKey struct {
+	UserId    int       `json:"user_id"`
+	DeviceId  string    `json:"device_id"`
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SignedPrekey is a medium-term prekey signed by device's identity key.
+// Device keeps only the latest one, previous is replaced on rotation
+type SignedPrekey struct {
+	UserId    int       `json:"user_id"`
+	DeviceId  string    `json:"device_id"`
+	KeyId     int       `json:"key_id"`
+	PublicKey string    `json:"public_key"`
+	Signature string    `json:"signature"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OneTimePrekey is handed out in exactly one prekey bundle
+type OneTimePrekey struct {
+	UserId    int    `json:"user_id"`
+	DeviceId  string `json:"device_id"`
+	KeyId     int    `json:"key_id"`
+	PublicKey string `json:"public_key"`
+}
+
+// PrekeyBundle is everything needed to start X3DH key agreement with a device.
+// OneTimePrekey is nil if device has run out of them
+type PrekeyBundle struct {
+	IdentityKey   IdentityKey    `json:"identity_key"`
+	SignedPrekey  SignedPrekey   `json:"signed_prekey"`
+	OneTimePrekey *OneTimePrekey `json:"one_time_prekey"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+type LoginConfirmationAnswer string
+
+const (
+	LOGIN_CONFIRMATION_APPROVE LoginConfirmationAnswer = "approve"
+	LOGIN_CONFIRMATION_DENY    LoginConfirmationAnswer = "deny"
+)
+
+// LoginConfirmation is a pending "was this you?" question about session created from a new device.
+// It is answered once with one of signed tokens sent to user, Nonce binds tokens to the question
+type LoginConfirmation struct {
+	SessionId string
+	UserId    int
+	Nonce     string
+}
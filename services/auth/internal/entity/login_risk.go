@@ -0,0 +1,23 @@
+package entity
+
+type LoginRiskSignal string
+
+const (
+	LOGIN_RISK_IMPOSSIBLE_TRAVEL LoginRiskSignal = "impossible_travel"
+	LOGIN_RISK_NEW_COUNTRY       LoginRiskSignal = "new_country"
+	LOGIN_RISK_NEW_DEVICE        LoginRiskSignal = "new_device"
+	LOGIN_RISK_RISKY_NETWORK     LoginRiskSignal = "risky_network"
+)
+
+// LoginRisk is a result of comparing sign in attempt with user's login history
+type LoginRisk struct {
+	Score   int
+	Signals []LoginRiskSignal
+	// Location of sign in attempt, nil if it could not be resolved
+	Location *GeoLocation
+}
+
+func (r *LoginRisk) AddSignal(signal LoginRiskSignal, weight int) {
+	r.Signals = append(r.Signals, signal)
+	r.Score += weight
+}
@@ -0,0 +1,11 @@
+package entity
+
+// MagicLink is a pending passwordless sign in. It is completed either by following emailed link
+// or by entering emailed code, both only from the client which requested it.
+// Token and code are stored hashed
+type MagicLink struct {
+	ClientId  string
+	UserId    int
+	TokenHash string
+	Code      []byte
+}
@@ -1,12 +1,29 @@
 package entity
 
+// QRCodeFormat is an image format QR code is rendered in
+type QRCodeFormat string
+
+const (
+	QR_CODE_FORMAT_PNG QRCodeFormat = "png"
+	QR_CODE_FORMAT_SVG QRCodeFormat = "svg"
+)
+
 // Entity for QR code login flow. Inspired from telegram (https://core.telegram.org/api/qr-login)
 type QRCodeLoginToken struct {
 	Value string `json:"value"`
 	// ClientId is unique identifier for client which requested token
 	ClientId string
+	// WatchId is a secret of the stream which waits for token to be accepted, empty if client polls instead.
+	// Unlike token and client id it is never shown in QR code, so only waiting client learns about created session
+	WatchId string `json:"-"`
 
 	// Login metadata
 	IpAddr     string `json:"-"`
 	DeviceInfo string `json:"-"`
 }
+
+// QRLoginAcceptance is published once token is accepted to deliver created session to waiting client
+type QRLoginAcceptance struct {
+	UserId    int    `json:"user_id"`
+	SessionId string `json:"session_id"`
+}
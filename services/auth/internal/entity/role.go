@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/modulix-systems/goose-talk/rbac"
+)
+
+const ROLE_ADMIN = "admin"
+
+// Permissions enforced by auth service itself, other services declare their own
+const (
+	PERMISSION_USERS_READ         rbac.Permission = "users:read"
+	PERMISSION_USERS_WRITE        rbac.Permission = "users:write"
+	PERMISSION_ADMIN_ACTIONS_READ rbac.Permission = "admin_actions:read"
+	PERMISSION_ROLES_READ         rbac.Permission = "roles:read"
+	PERMISSION_ROLES_WRITE        rbac.Permission = "roles:write"
+	PERMISSION_INVITES_WRITE      rbac.Permission = "invites:write"
+)
+
+// Role is a named set of permissions which may be assigned to users
+type Role struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []rbac.Permission `json:"permissions"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
@@ -0,0 +1,59 @@
+package entity
+
+import "time"
+
+type SecurityEventType string
+
+const (
+	SECURITY_EVENT_SIGN_UP                 SecurityEventType = "sign_up"
+	SECURITY_EVENT_SIGN_IN_SUCCEEDED       SecurityEventType = "sign_in_succeeded"
+	SECURITY_EVENT_SIGN_IN_FAILED          SecurityEventType = "sign_in_failed"
+	SECURITY_EVENT_TWO_FA_ENABLED          SecurityEventType = "two_fa_enabled"
+	SECURITY_EVENT_TWO_FA_DISABLED         SecurityEventType = "two_fa_disabled"
+	SECURITY_EVENT_SESSION_REVOKED         SecurityEventType = "session_revoked"
+	SECURITY_EVENT_ALL_SESSIONS_REVOKED    SecurityEventType = "all_sessions_revoked"
+	SECURITY_EVENT_SESSION_EVICTED         SecurityEventType = "session_evicted"
+	SECURITY_EVENT_REAUTHENTICATED         SecurityEventType = "reauthenticated"
+	SECURITY_EVENT_REAUTHENTICATION_FAILED SecurityEventType = "reauthentication_failed"
+	SECURITY_EVENT_QR_LOGIN                SecurityEventType = "qr_login"
+	SECURITY_EVENT_PASSKEY_REGISTERED      SecurityEventType = "passkey_registered"
+	// SECURITY_EVENT_ACCOUNT_DEACTIVATED was recorded before account states were introduced,
+	// state transitions are recorded as SECURITY_EVENT_ACCOUNT_STATE_CHANGED
+	SECURITY_EVENT_ACCOUNT_DEACTIVATED    SecurityEventType = "account_deactivated"
+	SECURITY_EVENT_SUSPICIOUS_LOGIN       SecurityEventType = "suspicious_login"
+	SECURITY_EVENT_LOGIN_CONFIRMED        SecurityEventType = "login_confirmed"
+	SECURITY_EVENT_LOGIN_DENIED           SecurityEventType = "login_denied"
+	SECURITY_EVENT_PASSWORD_RESET         SecurityEventType = "password_reset"
+	SECURITY_EVENT_PASSWORD_CHANGED       SecurityEventType = "password_changed"
+	SECURITY_EVENT_TRUSTED_DEVICE_ADDED   SecurityEventType = "trusted_device_added"
+	SECURITY_EVENT_TRUSTED_DEVICE_REVOKED SecurityEventType = "trusted_device_revoked"
+	SECURITY_EVENT_SESSION_PROOF_FAILED   SecurityEventType = "session_proof_failed"
+	SECURITY_EVENT_ACCESS_TOKEN_CREATED   SecurityEventType = "access_token_created"
+	SECURITY_EVENT_ACCESS_TOKEN_REVOKED   SecurityEventType = "access_token_revoked"
+	SECURITY_EVENT_DEVICE_KEYS_REGISTERED SecurityEventType = "device_keys_registered"
+	SECURITY_EVENT_DEVICE_KEYS_REMOVED    SecurityEventType = "device_keys_removed"
+	SECURITY_EVENT_DELETION_SCHEDULED     SecurityEventType = "account_deletion_scheduled"
+	SECURITY_EVENT_DELETION_CANCELLED     SecurityEventType = "account_deletion_cancelled"
+	SECURITY_EVENT_DATA_EXPORT_REQUESTED  SecurityEventType = "data_export_requested"
+	SECURITY_EVENT_DATA_EXPORT_DOWNLOADED SecurityEventType = "data_export_downloaded"
+	SECURITY_EVENT_ACCOUNT_STATE_CHANGED  SecurityEventType = "account_state_changed"
+	SECURITY_EVENT_ROLE_ASSIGNED          SecurityEventType = "role_assigned"
+	SECURITY_EVENT_ROLE_REVOKED           SecurityEventType = "role_revoked"
+)
+
+// SecurityEvent is an immutable audit log record of security relevant action.
+// UserId is zero if action targeted unknown account (e.g sign in with non-existent login)
+type SecurityEvent struct {
+	Id        int64             `json:"id"`
+	UserId    int               `json:"user_id"`
+	Type      SecurityEventType `json:"type"`
+	CreatedAt time.Time         `json:"created_at"`
+
+	// Client metadata, empty if action was not initiated by client with known address
+	IpAddr     string `json:"ip_addr"`
+	DeviceInfo string `json:"device_info"`
+	Location   string `json:"location"`
+
+	// Details holds event specific data e.g failure reason or revoked session id
+	Details map[string]string `json:"details"`
+}
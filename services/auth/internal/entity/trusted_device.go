@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// TrustedDevice is a device on which user chose to skip 2FA until ExpiresAt.
+// Device proves itself with a token issued once, only its hash is kept
+type TrustedDevice struct {
+	Id         int       `json:"id"`
+	UserId     int       `json:"user_id"`
+	TokenHash  string    `json:"-"`
+	Label      string    `json:"label"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
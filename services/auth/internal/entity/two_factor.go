@@ -9,14 +9,31 @@ const (
 	TWO_FA_TOTP_APP TwoFaMethod = "totp_app"
 )
 
+// OTPPurpose scopes verification code to the flow which issued it,
+// so that code sent for one flow can not be used in another one
+type OTPPurpose string
+
+const (
+	OTP_PURPOSE_LOGIN_CHALLENGE      OTPPurpose = "login-challenge"
+	OTP_PURPOSE_PASSWORD_RESET       OTPPurpose = "password-reset"
+	OTP_PURPOSE_REAUTHENTICATION     OTPPurpose = "reauthentication"
+	OTP_PURPOSE_ACCOUNT_REACTIVATION OTPPurpose = "account-reactivation"
+)
+
 type (
 	// OTP represents storage for verifications codes
-	// Only one code can be present for one user/email.
-	// UserEmail and UserId are optional but at least one of them must be present
+	// Only one code can be present for one user/email and purpose.
+	// UserEmail and UserId are optional but at least one of them must be present.
+	// Codes with purpose are issued only for users
 	OTP struct {
 		Code      []byte
-		UserEmail string `json:"user_email"`
-		UserId    int    `json:"user_id"`
+		UserEmail string     `json:"user_email"`
+		UserId    int        `json:"user_id"`
+		Purpose   OTPPurpose `json:"purpose"`
+		// Binding is a hash of context code was issued in e.g sign in attempt, code is valid only within it
+		Binding string `json:"binding"`
+		// Attempts is a number of failed attempts to use the code
+		Attempts int `json:"attempts"`
 	}
 
 	// TwoFactorAuth entity representing 2FA auth
@@ -35,4 +52,14 @@ type (
 		// By default true, but can be disabled on user's demand
 		Enabled bool `json:"enabled"`
 	}
+
+	// TelegramLink is a pending binding of user's account to telegram chat.
+	// Created when user requests telegram 2FA and completed once user sends start code to the bot
+	TelegramLink struct {
+		// Code is sent to the bot within start link
+		Code   string
+		UserId int
+		// ChatId is empty until user sends start code to the bot
+		ChatId string
+	}
 )
@@ -16,13 +16,42 @@ type User struct {
 	Friends            []User
 	CreatedAt          time.Time      `json:"created_at" db:"created_at"`
 	UpdatedAt          time.Time      `json:"updated_at" db:"updated_at"`
-	IsActive           bool           `json:"is_active"`
 	BirthDate          time.Time      `json:"birth_date"`
 	AboutMe            string         `json:"about_me"`
 	TwoFactorAuth      *TwoFactorAuth `json:"two_factor_auth" db:"-"`
 	PasskeyCredentials []PasskeyCredential
-	PrivateKey         string `json:"-"`
-	Language           string `json:"language"`
+	// PrivateKey is a legacy server generated key, only used to decrypt TOTP secrets
+	// which were not yet moved to key ring. It is dropped once the secret is re-encrypted.
+	// End-to-end encryption keys live in key directory
+	PrivateKey string `json:"-"`
+	Language   string `json:"language"`
+	// MustResetPassword is set when user reported sign in as not theirs, password sign in is refused until reset
+	MustResetPassword bool `json:"must_reset_password"`
+	// DeletionScheduledAt is set while account is waiting to be erased, user may cancel deletion until then
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	// State is a lifecycle state of account, use IsActive to check whether account may be used
+	State AccountState `json:"state"`
+	// StateReason explains why account was moved to its state e.g by admin
+	StateReason    string     `json:"state_reason"`
+	StateChangedAt *time.Time `json:"state_changed_at"`
+	// StateUntil is set for temporary states, account is active again once it passes
+	StateUntil *time.Time `json:"state_until"`
+}
+
+// EffectiveState returns account state taking expiration of temporary states into account
+func (u *User) EffectiveState() AccountState {
+	if u.StateUntil != nil && !u.StateUntil.After(time.Now()) &&
+		(u.State == ACCOUNT_STATE_LOCKED || u.State == ACCOUNT_STATE_SUSPENDED) {
+		return ACCOUNT_STATE_ACTIVE
+	}
+	return u.State
+}
+
+// IsActive reports whether user may sign in and use their account.
+// Account pending deletion stays usable so owner is able to cancel deletion
+func (u *User) IsActive() bool {
+	state := u.EffectiveState()
+	return state == ACCOUNT_STATE_ACTIVE || state == ACCOUNT_STATE_PENDING_DELETION
 }
 
 func (u *User) Is2FAEnabled() bool {
@@ -40,3 +69,11 @@ func (u *User) GetDisplayName() string {
 
 	return displayName
 }
+
+// DeletedUser is a tombstone of erased account. EventPublishedAt is nil
+// until other services are notified that user's data must be purged
+type DeletedUser struct {
+	UserId           int        `json:"user_id"`
+	DeletedAt        time.Time  `json:"deleted_at"`
+	EventPublishedAt *time.Time `json:"event_published_at"`
+}
@@ -0,0 +1,92 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	securityContracts "github.com/modulix-systems/goose-talk/contracts/rmqcontracts/security"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rabbitmq"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// Client publishes alerts for security team
+type Client struct {
+	channel   *amqp091.Channel
+	contracts *securityContracts.Contracts
+	log       logger.Interface
+}
+
+func New(rmq *rabbitmq.RabbitMQ, log logger.Interface) (*Client, error) {
+	op := "alerts.Client.New"
+	channel, err := rmq.NewChannel()
+	if err != nil {
+		return nil, fmt.Errorf("%s - rmq.NewChannel: %w", op, err)
+	}
+
+	contracts := securityContracts.New()
+
+	if _, err := rmq.QueueDeclare(contracts.Queues.Alerts, channel); err != nil {
+		return nil, fmt.Errorf("%s - rmq.QueueDeclare declare alerts queue: %w", op, err)
+	}
+
+	return &Client{channel: channel, contracts: contracts, log: log}, nil
+}
+
+func (c *Client) publishAlert(ctx context.Context, typ securityContracts.AlertType, payload any) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "alerts.Client.publishAlert"
+	log := c.log.With("op", op, "correlationId", correlationId, "typ", typ)
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		log.Error("marshal payload failed", "err", err)
+		return fmt.Errorf("%s - marshal payload %s: %w", op, typ, err)
+	}
+	message := securityContracts.AlertMessage{
+		Type:       typ,
+		OccurredAt: time.Now().UTC(),
+		Data:       payloadJson,
+	}
+	messageJson, err := json.Marshal(message)
+	if err != nil {
+		log.Error("marshal alert message failed", "err", err)
+		return fmt.Errorf("%s - marshal alert message: %w", op, err)
+	}
+
+	publishing := amqp091.Publishing{Body: messageJson, CorrelationId: correlationId}
+	if err = c.channel.PublishWithContext(ctx, "", c.contracts.Queues.Alerts.Name, false, false, publishing); err != nil {
+		log.Error("rmq publish failed", "err", err)
+		return fmt.Errorf("%s - publish to queue: %w", op, err)
+	}
+	log.Info("Security alert published")
+
+	return nil
+}
+
+func (c *Client) PublishSuspiciousLogin(
+	ctx context.Context,
+	user *entity.User,
+	risk *entity.LoginRisk,
+	ip, deviceInfo string,
+	challenged bool,
+) error {
+	signals := make([]string, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = string(signal)
+	}
+	payload := securityContracts.SuspiciousLoginAlert{
+		UserId:     user.Id,
+		Email:      user.Email,
+		IpAddr:     ip,
+		DeviceInfo: deviceInfo,
+		Location:   risk.Location.String(),
+		Score:      risk.Score,
+		Signals:    signals,
+		Challenged: challenged,
+	}
+
+	return c.publishAlert(ctx, securityContracts.ALERT_TYPE_SUSPICIOUS_LOGIN, payload)
+}
@@ -2,9 +2,12 @@ package gateways
 
 import (
 	"context"
+	"io"
 	"time"
 
+	"github.com/modulix-systems/goose-talk/internal/dtos"
 	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/rbac"
 )
 
 //go:generate mockgen -source=contracts.go -destination=../../tests/mocks/mocks_gateways.go -package=mocks
@@ -15,23 +18,61 @@ type (
 		GetByLogin(ctx context.Context, login string) (*entity.User, error)
 		GetByID(ctx context.Context, id int) (*entity.User, error)
 		GetByIDWithPasskeyCredentials(ctx context.Context, id int) (*entity.User, error)
-		UpdateIsActiveById(ctx context.Context, userId int, isActive bool) (*entity.User, error)
+		Search(ctx context.Context, query string, limit int) ([]entity.User, error)
+		UpdateStateById(ctx context.Context, userId int, change *entity.AccountStateChange) (*entity.User, error)
 		CreatePasskeyCredential(ctx context.Context, userId int, cred *entity.PasskeyCredential) error
 		CreateTwoFa(ctx context.Context, ent *entity.TwoFactorAuth) (*entity.TwoFactorAuth, error)
 		UpdateTwoFaContact(ctx context.Context, userId int, contact string) error
+		DeleteTwoFaByUserId(ctx context.Context, userId int) error
+		GetByTwoFaContact(ctx context.Context, method entity.TwoFaMethod, contact string) (*entity.User, error)
+		UpdateMustResetPasswordById(ctx context.Context, userId int, mustReset bool) error
+		UpdatePasswordById(ctx context.Context, userId int, password []byte) error
+		GetAllWithTotpSecret(ctx context.Context, afterUserId int, limit int) ([]entity.User, error)
+		ReplaceTotpSecret(ctx context.Context, userId int, current []byte, replacement []byte) error
+		ScheduleDeletionById(ctx context.Context, userId int, scheduledAt time.Time) error
+		CancelDeletionById(ctx context.Context, userId int) error
+		GetAllPendingDeletionReminder(ctx context.Context, scheduledBefore time.Time, limit int) ([]entity.User, error)
+		UpdateDeletionReminderSentAtById(ctx context.Context, userId int, sentAt time.Time) error
+		GetAllDueForDeletion(ctx context.Context, limit int) ([]entity.User, error)
+		EraseById(ctx context.Context, userId int) error
+	}
+	DeletedUsersRepo interface {
+		GetAllUnpublished(ctx context.Context, limit int) ([]entity.DeletedUser, error)
+		UpdateEventPublishedAt(ctx context.Context, userId int, publishedAt time.Time) error
+	}
+	DataExportsRepo interface {
+		Create(ctx context.Context, export *entity.DataExport) (*entity.DataExport, error)
+		GetById(ctx context.Context, exportId string) (*entity.DataExport, error)
+		GetAllByUserId(ctx context.Context, userId int) ([]entity.DataExport, error)
+		SavePart(ctx context.Context, part *entity.DataExportPart) error
+		GetAllParts(ctx context.Context, exportId string) ([]entity.DataExportPart, error)
+		GetAllReadyToAssemble(ctx context.Context, expectedParts int, limit int) ([]entity.DataExport, error)
+		CompleteById(ctx context.Context, exportId string, fileName string, expiresAt time.Time) error
+		RecordFailedAttemptById(ctx context.Context, exportId string, nextAttemptAt time.Time, maxAttempts int) (*entity.DataExport, error)
+		GetAllExpired(ctx context.Context, limit int) ([]entity.DataExport, error)
+		UpdateStatusById(ctx context.Context, exportId string, status entity.DataExportStatus) error
+	}
+	// FileStorage keeps generated files e.g data export archives
+	FileStorage interface {
+		Write(name string, write func(w io.Writer) error) error
+		Open(name string) (io.ReadCloser, error)
+		Remove(name string) error
 	}
 	AuthSessionsRepo interface {
 		CreateWithTTL(ctx context.Context, session *entity.AuthSession, ttl time.Duration) (*entity.AuthSession, error)
 		DeleteById(ctx context.Context, userId int, sessionId string) error
 		DeleteAllByUserId(ctx context.Context, userId int, excludeSessionId string) error
 		GetAllByUserId(ctx context.Context, userId int) ([]entity.AuthSession, error)
-		GetByLoginData(ctx context.Context, userId int, ip string, deviceInfo string) (*entity.AuthSession, error)
+		GetByLoginData(ctx context.Context, userId int, ip string, deviceKey string) (*entity.AuthSession, error)
 		GetById(ctx context.Context, userId int, sessionId string) (*entity.AuthSession, error)
 		UpdateById(ctx context.Context, userId int, sessionId string, lastSeenAt time.Time, ttl time.Duration) error
+		UpdateAuthenticationById(ctx context.Context, userId int, sessionId string, authenticatedAt time.Time, method entity.AuthMethod) error
 	}
 	OtpRepo interface {
 		GetByEmail(ctx context.Context, email string) (*entity.OTP, error)
 		GetByUserId(ctx context.Context, userId int) (*entity.OTP, error)
+		GetByPurpose(ctx context.Context, purpose entity.OTPPurpose, userId int) (*entity.OTP, error)
+		IncrementAttempts(ctx context.Context, otp *entity.OTP) (int, error)
 		Delete(ctx context.Context, otp *entity.OTP) error
 		CreateWithTTL(ctx context.Context, otp *entity.OTP, ttl time.Duration) error
 	}
@@ -39,6 +80,21 @@ type (
 		CreateWithTTL(ctx context.Context, token *entity.QRCodeLoginToken, ttl time.Duration) error
 		FindOne(ctx context.Context, value string, clientId string) (*entity.QRCodeLoginToken, error)
 		DeleteAllByClient(ctx context.Context, clientId string) error
+		DeleteAllByWatch(ctx context.Context, clientId string, watchId string) error
+		PublishAcceptance(ctx context.Context, watchId string, acceptance *entity.QRLoginAcceptance) error
+		SubscribeAcceptances(ctx context.Context, watchId string) (<-chan *entity.QRLoginAcceptance, func() error, error)
+	}
+	// QRCodeRenderer encodes text e.g login token url as QR code image
+	QRCodeRenderer interface {
+		RenderPNG(content string) ([]byte, error)
+		RenderSVG(content string) ([]byte, error)
+	}
+	// KeyRing encrypts sensitive values with versioned master keys
+	KeyRing interface {
+		Encrypt(plaintext []byte) ([]byte, error)
+		Decrypt(ciphertext []byte) ([]byte, error)
+		IsEncrypted(data []byte) bool
+		NeedsRotation(ciphertext []byte) bool
 	}
 	SecurityProvider interface {
 		GenerateOTPCode() string
@@ -46,11 +102,89 @@ type (
 		ValidateTOTP(code string, secret string) bool
 		HashPassword(password string) ([]byte, error)
 		ComparePasswords(hashed []byte, plain string) error
+		PasswordNeedsRehash(hashed []byte) bool
 		EncryptSymmetric(plaintext string, key string) ([]byte, error)
 		DecryptSymmetric(encrypted []byte, key string) (string, error)
 		GenerateSecretTokenUrlSafe(len int) string
+		HashToken(token string) string
+		ValidatePublicKey(publicKey string) error
+		VerifySignature(publicKey string, message []byte, signature string) error
 		GenerateSessionId() string
-		GeneratePrivateKey() string
+	}
+	TelegramLinksRepo interface {
+		CreateWithTTL(ctx context.Context, link *entity.TelegramLink, ttl time.Duration) error
+		GetByCode(ctx context.Context, code string) (*entity.TelegramLink, error)
+		GetByUserId(ctx context.Context, userId int) (*entity.TelegramLink, error)
+		Update(ctx context.Context, link *entity.TelegramLink) error
+		Delete(ctx context.Context, link *entity.TelegramLink) error
+	}
+	SecurityEventsRepo interface {
+		Create(ctx context.Context, event *entity.SecurityEvent) (*entity.SecurityEvent, error)
+		GetMany(ctx context.Context, filter *dtos.SecurityEventsFilter) ([]entity.SecurityEvent, error)
+	}
+	RolesRepo interface {
+		Save(ctx context.Context, role *entity.Role) (*entity.Role, error)
+		GetAll(ctx context.Context) ([]entity.Role, error)
+		GetAllByUserId(ctx context.Context, userId int) ([]entity.Role, error)
+		DeleteByName(ctx context.Context, name string) error
+		AssignToUser(ctx context.Context, userId int, roleName string, grantedBy int) error
+		RevokeFromUser(ctx context.Context, userId int, roleName string) error
+		GetPermissionsByUserId(ctx context.Context, userId int) ([]rbac.Permission, error)
+	}
+	AdminActionsRepo interface {
+		Create(ctx context.Context, action *entity.AdminAction) (*entity.AdminAction, error)
+		GetMany(ctx context.Context, filter *dtos.AdminActionsFilter) ([]entity.AdminAction, error)
+	}
+	InvitesRepo interface {
+		Create(ctx context.Context, invite *entity.Invite) (*entity.Invite, error)
+		GetByCodeHash(ctx context.Context, codeHash string) (*entity.Invite, error)
+		GetAllByInviterId(ctx context.Context, inviterId int) ([]entity.Invite, error)
+		RevokeById(ctx context.Context, inviterId int, inviteId int) error
+		Reserve(ctx context.Context, inviteId int, email string) error
+		Release(ctx context.Context, inviteId int) error
+		CreateRedemption(ctx context.Context, inviteId int, userId int) error
+		GetAllRedemptionsByInviterId(ctx context.Context, inviterId int) ([]entity.InviteRedemption, error)
+	}
+	// MagicLinksRepo keeps single pending magic link per client
+	MagicLinksRepo interface {
+		CreateWithTTL(ctx context.Context, link *entity.MagicLink, ttl time.Duration) error
+		GetByClientId(ctx context.Context, clientId string) (*entity.MagicLink, error)
+		IncrementAttempts(ctx context.Context, link *entity.MagicLink) (int, error)
+		Delete(ctx context.Context, link *entity.MagicLink) error
+	}
+	LoginConfirmationsRepo interface {
+		CreateWithTTL(ctx context.Context, confirmation *entity.LoginConfirmation, ttl time.Duration) error
+		GetAndDelete(ctx context.Context, sessionId string) (*entity.LoginConfirmation, error)
+	}
+	TrustedDevicesRepo interface {
+		Create(ctx context.Context, device *entity.TrustedDevice) (*entity.TrustedDevice, error)
+		GetByTokenHash(ctx context.Context, userId int, tokenHash string) (*entity.TrustedDevice, error)
+		GetAllByUserId(ctx context.Context, userId int) ([]entity.TrustedDevice, error)
+		UpdateLastUsedAt(ctx context.Context, deviceId int, lastUsedAt time.Time) error
+		DeleteById(ctx context.Context, userId int, deviceId int) error
+		DeleteAllByUserId(ctx context.Context, userId int) error
+	}
+	AccessTokensRepo interface {
+		Create(ctx context.Context, token *entity.AccessToken) (*entity.AccessToken, error)
+		GetByTokenHash(ctx context.Context, tokenHash string) (*entity.AccessToken, error)
+		GetAllByUserId(ctx context.Context, userId int) ([]entity.AccessToken, error)
+		UpdateLastUsedAt(ctx context.Context, tokenId int, lastUsedAt time.Time) error
+		DeleteById(ctx context.Context, userId int, tokenId int) error
+		DeleteAllByUserId(ctx context.Context, userId int) error
+	}
+	KeyDirectoryRepo interface {
+		CreateIdentityKey(ctx context.Context, key *entity.IdentityKey) (*entity.IdentityKey, error)
+		GetIdentityKey(ctx context.Context, userId int, deviceId string) (*entity.IdentityKey, error)
+		GetAllIdentityKeys(ctx context.Context, userId int) ([]entity.IdentityKey, error)
+		DeleteIdentityKey(ctx context.Context, userId int, deviceId string) error
+		SaveSignedPrekey(ctx context.Context, prekey *entity.SignedPrekey) (*entity.SignedPrekey, error)
+		GetSignedPrekey(ctx context.Context, userId int, deviceId string) (*entity.SignedPrekey, error)
+		CreateOneTimePrekeys(ctx context.Context, prekeys []entity.OneTimePrekey) error
+		ConsumeOneTimePrekey(ctx context.Context, userId int, deviceId string) (*entity.OneTimePrekey, error)
+		CountOneTimePrekeys(ctx context.Context, userId int, deviceId string) (int, error)
+	}
+	SessionProofNoncesRepo interface {
+		Reserve(ctx context.Context, sessionId string, nonce string, ttl time.Duration) error
 	}
 	PasskeySessionsRepo interface {
 		Create(ctx context.Context, session *entity.PasskeyRegistrationSession) error
@@ -66,15 +200,44 @@ type (
 		SendSignUpEmail(ctx context.Context, user *entity.User) error
 		SendConfirmEmailTwoFaEmail(ctx context.Context, to, username, otp, lang string) error
 		SendAccountDeactivatedEmail(ctx context.Context, to, username, lang string) error
-		SendLoginNewDeviceEmail(ctx context.Context, to, username string, newSession *entity.AuthSession, lang string) error
+		SendLoginNewDeviceEmail(ctx context.Context, to, username string, newSession *entity.AuthSession, approveToken, denyToken, lang string) error
+		SendPasswordResetEmail(ctx context.Context, to, username, otp, lang string) error
+		SendSessionEvictedEmail(ctx context.Context, to, username string, session *entity.AuthSession, lang string) error
+		SendLoginChallengeEmail(ctx context.Context, to, username, otp, ip, location, lang string) error
+		SendAccountDeletionScheduledEmail(ctx context.Context, to, username string, scheduledAt time.Time, lang string) error
+		SendAccountDeletionReminderEmail(ctx context.Context, to, username string, scheduledAt time.Time, lang string) error
+		SendAccountStateChangedEmail(ctx context.Context, to, username, state, reason string, until *time.Time, lang string) error
+		SendAccountReactivationEmail(ctx context.Context, to, username, otp, lang string) error
+		SendMagicLinkEmail(ctx context.Context, to, username, token, otp, ip, location, lang string) error
+		SendDataExportReadyEmail(ctx context.Context, to, username, downloadToken string, expiresAt time.Time, lang string) error
+		SendPrekeysLowNotification(ctx context.Context, userId int, deviceId string, remaining int, signedPrekeyRotationDue bool) error
 	}
 	TelegramBotClient interface {
 		SendTextMsg(ctx context.Context, chatId string, text string) error
+		SendTextMsgWithButtons(ctx context.Context, chatId string, text string, buttons []TelegramInlineButton) error
+		AnswerCallback(ctx context.Context, callbackId string, text string) error
 		GetStartLinkWithCode(code string) string
-		GetLatestMsg(ctx context.Context) (*TelegramMsg, error)
+		GetUpdates(ctx context.Context, offset int, timeout time.Duration) ([]TelegramUpdate, error)
+	}
+	TokenProvider interface {
+		NewToken(expires time.Duration, claims map[string]any) (string, error)
+		ParseClaimsFromToken(token string) (map[string]any, error)
+	}
+	UserAgentParser interface {
+		Parse(userAgent string) entity.Device
+	}
+	RiskyNetworksList interface {
+		Contains(ip string) bool
+	}
+	SecurityAlertsPublisher interface {
+		PublishSuspiciousLogin(ctx context.Context, user *entity.User, risk *entity.LoginRisk, ip, deviceInfo string, challenged bool) error
+	}
+	UserEventsPublisher interface {
+		PublishUserDeleted(ctx context.Context, userId int, deletedAt time.Time) error
+		PublishDataExportRequested(ctx context.Context, exportId string, userId int) error
 	}
 	GeoIpApi interface {
-		GetLocationByIP(ip string) (string, error)
+		GetLocationByIP(ctx context.Context, ip string) (*entity.GeoLocation, error)
 	}
 	Transaction interface {
 		Commit(ctx context.Context) error
@@ -8,4 +8,23 @@ type (
 		Text     string
 		ChatId   string
 	}
+
+	// TelegramCallback is a press on inline keyboard button attached to bot's message
+	TelegramCallback struct {
+		Id     string
+		ChatId string
+		Data   string
+	}
+
+	// TelegramUpdate contains exactly one of Message or Callback
+	TelegramUpdate struct {
+		Id       int
+		Message  *TelegramMsg
+		Callback *TelegramCallback
+	}
+
+	TelegramInlineButton struct {
+		Text         string
+		CallbackData string
+	}
 )
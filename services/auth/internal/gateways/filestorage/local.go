@@ -0,0 +1,87 @@
+package filestorage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+)
+
+// Local keeps files in a single directory on local disk
+type Local struct {
+	dir string
+}
+
+// NewLocal creates directory if it does not exist yet. Files are readable by owner only
+func NewLocal(dir string) (*Local, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("filestorage.NewLocal - create dir: %w", err)
+	}
+	return &Local{dir: dir}, nil
+}
+
+// path prevents names from escaping storage directory
+func (s *Local) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("filestorage - invalid file name %q", name)
+	}
+	return filepath.Join(s.dir, name), nil
+}
+
+// Write replaces file with content produced by write. Partially written file is never visible under name
+func (s *Local) Write(name string, write func(w io.Writer) error) error {
+	op := "filestorage.Local.Write"
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, "."+name+".*")
+	if err != nil {
+		return fmt.Errorf("%s - create temp file: %w", op, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = write(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("%s - write: %w", op, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("%s - close temp file: %w", op, err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("%s - rename: %w", op, err)
+	}
+	return nil
+}
+
+// Open returns storage.ErrNotFound if file does not exist
+func (s *Local) Open(name string) (io.ReadCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("filestorage.Local.Open: %w", err)
+	}
+	return file, nil
+}
+
+// Remove does nothing if file does not exist
+func (s *Local) Remove(name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("filestorage.Local.Remove: %w", err)
+	}
+	return nil
+}
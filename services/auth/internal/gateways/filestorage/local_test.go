@@ -0,0 +1,73 @@
+package filestorage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalWriteAndOpen(t *testing.T) {
+	fileStorage, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, fileStorage.Write("export.zip", func(w io.Writer) error {
+		_, err := io.WriteString(w, "content")
+		return err
+	}))
+
+	file, err := fileStorage.Open("export.zip")
+	require.NoError(t, err)
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+}
+
+func TestLocalWriteFailed(t *testing.T) {
+	dir := t.TempDir()
+	fileStorage, err := NewLocal(dir)
+	require.NoError(t, err)
+
+	err = fileStorage.Write("export.zip", func(w io.Writer) error {
+		io.WriteString(w, "partial")
+		return errors.New("collect failed")
+	})
+
+	require.Error(t, err)
+	_, err = fileStorage.Open("export.zip")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLocalRemove(t *testing.T) {
+	fileStorage, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, fileStorage.Write("export.zip", func(w io.Writer) error {
+		_, err := io.Copy(w, strings.NewReader("content"))
+		return err
+	}))
+
+	require.NoError(t, fileStorage.Remove("export.zip"))
+	_, err = fileStorage.Open("export.zip")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	assert.NoError(t, fileStorage.Remove("export.zip"))
+}
+
+func TestLocalRejectsPathTraversal(t *testing.T) {
+	fileStorage, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, name := range []string{"", "..", "../export.zip", "nested/export.zip"} {
+		_, err = fileStorage.Open(name)
+		assert.Error(t, err, name)
+		assert.NotErrorIs(t, err, storage.ErrNotFound, name)
+	}
+}
@@ -0,0 +1,38 @@
+package geoip
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+)
+
+// CachedClient keeps recently resolved locations in memory.
+// Failed lookups are not cached so they can be retried
+type CachedClient struct {
+	api   gateways.GeoIpApi
+	cache *expirable.LRU[string, entity.GeoLocation]
+}
+
+func NewCachedClient(api gateways.GeoIpApi, size int, ttl time.Duration) *CachedClient {
+	return &CachedClient{
+		api:   api,
+		cache: expirable.NewLRU[string, entity.GeoLocation](size, nil, ttl),
+	}
+}
+
+func (c *CachedClient) GetLocationByIP(ctx context.Context, ip string) (*entity.GeoLocation, error) {
+	if location, ok := c.cache.Get(ip); ok {
+		return &location, nil
+	}
+
+	location, err := c.api.GetLocationByIP(ctx, ip)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(ip, *location)
+
+	return location, nil
+}
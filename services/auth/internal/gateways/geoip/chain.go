@@ -0,0 +1,49 @@
+package geoip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+)
+
+// Chain queries providers one by one until any of them resolves location.
+// Loopback and private network addresses are never sent to providers
+type Chain struct {
+	providers []gateways.GeoIpApi
+}
+
+func NewChain(providers ...gateways.GeoIpApi) *Chain {
+	return &Chain{providers: providers}
+}
+
+func (c *Chain) GetLocationByIP(ctx context.Context, ip string) (*entity.GeoLocation, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, ErrInvalidIP
+	}
+	if isPrivate(addr.Unmap()) {
+		return &entity.GeoLocation{IsPrivate: true}, nil
+	}
+	if len(c.providers) == 0 {
+		return nil, ErrNoProviders
+	}
+
+	var errs []error
+	for _, provider := range c.providers {
+		location, err := provider.GetLocationByIP(ctx, ip)
+		if err == nil {
+			return location, nil
+		}
+		errs = append(errs, err)
+	}
+
+	return nil, fmt.Errorf("geoip - Chain.GetLocationByIP - all providers failed: %w", errors.Join(errs...))
+}
+
+func isPrivate(addr netip.Addr) bool {
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsUnspecified()
+}
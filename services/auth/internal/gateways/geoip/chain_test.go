@@ -0,0 +1,92 @@
+package geoip
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubProvider struct {
+	location *entity.GeoLocation
+	err      error
+	calls    int
+}
+
+func (p *stubProvider) GetLocationByIP(_ context.Context, ip string) (*entity.GeoLocation, error) {
+	p.calls++
+	return p.location, p.err
+}
+
+func TestChainFallsBackToNextProvider(t *testing.T) {
+	expectedLocation := &entity.GeoLocation{City: "Berlin", Country: "Germany"}
+	failing := &stubProvider{err: ErrLocationNotFound}
+	working := &stubProvider{location: expectedLocation}
+	chain := NewChain(failing, working)
+
+	location, err := chain.GetLocationByIP(context.Background(), "8.8.8.8")
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedLocation, location)
+	assert.Equal(t, "Berlin, Germany", location.String())
+	assert.Equal(t, 1, failing.calls)
+}
+
+func TestChainAllProvidersFailed(t *testing.T) {
+	providerErr := errors.New("provider is down")
+	chain := NewChain(&stubProvider{err: ErrLocationNotFound}, &stubProvider{err: providerErr})
+
+	_, err := chain.GetLocationByIP(context.Background(), "8.8.8.8")
+
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+	assert.ErrorIs(t, err, providerErr)
+}
+
+func TestChainSkipsPrivateAddresses(t *testing.T) {
+	provider := &stubProvider{err: ErrLocationNotFound}
+	chain := NewChain(provider)
+
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "192.168.0.10", "::1", "fe80::1", "::ffff:172.16.0.1"} {
+		location, err := chain.GetLocationByIP(context.Background(), ip)
+		require.NoError(t, err, ip)
+		assert.True(t, location.IsPrivate, ip)
+		assert.Equal(t, entity.LOCAL_NETWORK_LOCATION, location.String())
+	}
+	assert.Zero(t, provider.calls)
+}
+
+func TestChainInvalidIP(t *testing.T) {
+	_, err := NewChain().GetLocationByIP(context.Background(), "not an ip")
+	assert.ErrorIs(t, err, ErrInvalidIP)
+}
+
+func TestCachedClient(t *testing.T) {
+	provider := &stubProvider{location: &entity.GeoLocation{Country: "Germany"}}
+	client := NewCachedClient(provider, 10, time.Minute)
+
+	for range 3 {
+		location, err := client.GetLocationByIP(context.Background(), "8.8.8.8")
+		require.NoError(t, err)
+		assert.Equal(t, "Germany", location.String())
+	}
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestCachedClientDoesNotCacheFailures(t *testing.T) {
+	provider := &stubProvider{err: ErrLocationNotFound}
+	client := NewCachedClient(provider, 10, time.Minute)
+
+	_, err := client.GetLocationByIP(context.Background(), "8.8.8.8")
+	assert.ErrorIs(t, err, ErrLocationNotFound)
+	provider.err = nil
+	provider.location = &entity.GeoLocation{Country: "Germany"}
+	location, err := client.GetLocationByIP(context.Background(), "8.8.8.8")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Germany", location.String())
+	assert.Equal(t, 2, provider.calls)
+}
@@ -1,30 +1,51 @@
 package geoip
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/modulix-systems/goose-talk/httpclient"
+	"github.com/modulix-systems/goose-talk/internal/entity"
 )
 
+// Client resolves location using ip-api.com web service.
+// Only paid plan of the service supports https, so api key is required
 type Client struct {
 	httpClient *httpclient.Client
+	apiKey     string
+	// timeout bounds lookup so slow service does not stall sign in
+	timeout time.Duration
 }
 
-func New() *Client {
-	httpClient := httpclient.New("http://ip-api.com/json/")
-	return &Client{httpClient}
+func New(apiKey string, timeout time.Duration) *Client {
+	httpClient := httpclient.New("https://pro.ip-api.com/json/")
+	return &Client{httpClient: httpClient, apiKey: apiKey, timeout: timeout}
 }
 
-func (c *Client) GetLocationByIP(ip string) (string, error) {
+func (c *Client) GetLocationByIP(ctx context.Context, ip string) (*entity.GeoLocation, error) {
 	query := url.Values{}
-	query.Set("fields", "city,country")
+	query.Set("fields", "status,message,city,country,countryCode,lat,lon")
+	query.Set("key", c.apiKey)
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
 
 	var response GetLocationResponse
-	err := c.httpClient.Get(ip, query, &response)
+	err := c.httpClient.Get(ctx, ip, query, &response)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("geoip - Client.GetLocationByIP - %s: %w", response.Message, ErrLocationNotFound)
 	}
 
-	return fmt.Sprintf("%s, %s", response.City, response.Country), nil
+	return &entity.GeoLocation{
+		City:        response.City,
+		Country:     response.Country,
+		CountryCode: response.CountryCode,
+		Latitude:    response.Lat,
+		Longitude:   response.Lon,
+	}, nil
 }
@@ -0,0 +1,57 @@
+package geoip
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/oschwald/maxminddb-golang/v2"
+)
+
+const namesLanguage = "en"
+
+// DatabaseClient resolves location using local MaxMind database file (mmdb) of City type
+type DatabaseClient struct {
+	reader *maxminddb.Reader
+}
+
+func NewDatabaseClient(path string) (*DatabaseClient, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("geoip - NewDatabaseClient - maxminddb.Open(%s): %w", path, err)
+	}
+	return &DatabaseClient{reader: reader}, nil
+}
+
+func (c *DatabaseClient) GetLocationByIP(_ context.Context, ip string) (*entity.GeoLocation, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, ErrInvalidIP
+	}
+
+	result := c.reader.Lookup(addr.Unmap())
+	if err := result.Err(); err != nil {
+		return nil, fmt.Errorf("geoip - DatabaseClient.GetLocationByIP - reader.Lookup(%s): %w", ip, err)
+	}
+	if !result.Found() {
+		return nil, ErrLocationNotFound
+	}
+
+	var record cityRecord
+	if err := result.Decode(&record); err != nil {
+		return nil, fmt.Errorf("geoip - DatabaseClient.GetLocationByIP - result.Decode: %w", err)
+	}
+
+	return &entity.GeoLocation{
+		City:        record.City.Names[namesLanguage],
+		Country:     record.Country.Names[namesLanguage],
+		CountryCode: record.Country.IsoCode,
+		Latitude:    record.Location.Latitude,
+		Longitude:   record.Location.Longitude,
+	}, nil
+}
+
+func (c *DatabaseClient) Close() error {
+	return c.reader.Close()
+}
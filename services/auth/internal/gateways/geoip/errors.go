@@ -0,0 +1,9 @@
+package geoip
+
+import "errors"
+
+var (
+	ErrLocationNotFound = errors.New("location of ip address is not found")
+	ErrInvalidIP        = errors.New("invalid ip address")
+	ErrNoProviders      = errors.New("no geoip providers configured")
+)
@@ -1,6 +1,26 @@
 package geoip
 
 type GetLocationResponse struct {
-	City    string `json:"city"`
-	Country string `json:"country"`
-}
\ No newline at end of file
+	Status      string  `json:"status"`
+	Message     string  `json:"message"`
+	City        string  `json:"city"`
+	Country     string  `json:"country"`
+	CountryCode string  `json:"countryCode"`
+	Lat         float64 `json:"lat"`
+	Lon         float64 `json:"lon"`
+}
+
+// cityRecord is a subset of MaxMind GeoIP2/GeoLite2 City database record
+type cityRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		IsoCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
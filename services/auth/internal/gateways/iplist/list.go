@@ -0,0 +1,84 @@
+package iplist
+
+import (
+	"bufio"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+// List is an in-memory set of ip addresses and networks,
+// e.g TOR exit nodes or datacenter ranges
+type List struct {
+	prefixes []netip.Prefix
+}
+
+// Load reads files containing one ip address or CIDR per line.
+// Empty lines and lines starting with '#' are ignored
+func Load(paths ...string) (*List, error) {
+	list := &List{}
+	for _, path := range paths {
+		if err := list.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (l *List) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("iplist - List.loadFile - os.Open(%s): %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		prefix, err := parsePrefix(line)
+		if err != nil {
+			return fmt.Errorf("iplist - List.loadFile - %s:%d: %w", path, lineNum, err)
+		}
+		l.prefixes = append(l.prefixes, prefix)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("iplist - List.loadFile - scanner.Scan(%s): %w", path, err)
+	}
+	return nil
+}
+
+func parsePrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func (l *List) Contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range l.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package iplist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeListFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "list.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestListContains(t *testing.T) {
+	torNodes := writeListFile(t, "# tor exit nodes\n185.220.101.1\n\n2001:db8::1\n")
+	datacenters := writeListFile(t, "34.64.0.0/10\n")
+	list, err := Load(torNodes, datacenters)
+	require.NoError(t, err)
+
+	assert.True(t, list.Contains("185.220.101.1"))
+	assert.True(t, list.Contains("::ffff:185.220.101.1"))
+	assert.True(t, list.Contains("2001:db8::1"))
+	assert.True(t, list.Contains("34.100.1.2"))
+	assert.False(t, list.Contains("185.220.101.2"))
+	assert.False(t, list.Contains("8.8.8.8"))
+	assert.False(t, list.Contains("invalid"))
+}
+
+func TestLoadInvalidEntry(t *testing.T) {
+	_, err := Load(writeListFile(t, "10.0.0.1\nnot an ip\n"))
+	assert.ErrorContains(t, err, ":2")
+}
@@ -0,0 +1,220 @@
+package keyring
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	keySize       = 32
+	nonceSize     = 12
+	tagSize       = 16
+	magicSize     = 4
+	headerSize    = magicSize + 4
+	wrappedKeyLen = nonceSize + keySize + tagSize
+)
+
+// magic marks envelope ciphertexts, its last byte is format version
+var magic = [magicSize]byte{'g', 'k', 'r', 1}
+
+var (
+	ErrNoKeys              = errors.New("at least one master key must be configured")
+	ErrUnknownKey          = errors.New("ciphertext is encrypted with unknown master key")
+	ErrMalformedCiphertext = errors.New("ciphertext is malformed or has been tampered with")
+)
+
+// KeyRing implements envelope encryption: every value is encrypted with its own random data key
+// which is wrapped by one of versioned master keys. Ciphertext layout:
+//
+//	magic(4) | master key id(4) | nonce(12) + wrapped data key(32) + tag(16) | nonce(12) + ciphertext + tag(16)
+//
+// Header is authenticated along with both wrapped key and data, so key id can not be swapped
+type KeyRing struct {
+	keys     map[uint32]cipher.AEAD
+	activeId uint32
+}
+
+// Load builds key ring from "id:hexkey" specs and files containing one spec per line.
+// Keys must be 32 bytes long. If activeId is zero the key with the largest id encrypts new values,
+// other keys are kept to decrypt values until they are re-encrypted
+func Load(specs []string, files []string, activeId uint32) (*KeyRing, error) {
+	ring := &KeyRing{keys: map[uint32]cipher.AEAD{}}
+	for i, spec := range specs {
+		if err := ring.addKey(spec); err != nil {
+			return nil, fmt.Errorf("keyring - Load - spec #%d: %w", i+1, err)
+		}
+	}
+	for _, path := range files {
+		if err := ring.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if len(ring.keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	if activeId == 0 {
+		for id := range ring.keys {
+			activeId = max(activeId, id)
+		}
+	}
+	if _, ok := ring.keys[activeId]; !ok {
+		return nil, fmt.Errorf("keyring - Load - active key %d: %w", activeId, ErrUnknownKey)
+	}
+	ring.activeId = activeId
+	return ring, nil
+}
+
+func (r *KeyRing) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("keyring - KeyRing.loadFile - os.Open(%s): %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err = r.addKey(line); err != nil {
+			return fmt.Errorf("keyring - KeyRing.loadFile - %s:%d: %w", path, lineNum, err)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("keyring - KeyRing.loadFile - scanner.Scan(%s): %w", path, err)
+	}
+	return nil
+}
+
+func (r *KeyRing) addKey(spec string) error {
+	rawId, hexKey, found := strings.Cut(strings.TrimSpace(spec), ":")
+	if !found {
+		return errors.New(`key must be in "id:hexkey" format`)
+	}
+	id, err := strconv.ParseUint(rawId, 10, 32)
+	if err != nil || id == 0 {
+		return errors.New("key id must be a positive number")
+	}
+	if _, exists := r.keys[uint32(id)]; exists {
+		return fmt.Errorf("duplicate key id %d", id)
+	}
+	key, err := hex.DecodeString(hexKey)
+	if err != nil || len(key) != keySize {
+		return fmt.Errorf("key %d must be %d hex encoded bytes", id, keySize)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	r.keys[uint32(id)] = aead
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte, additionalData []byte) ([]byte, error) {
+	if len(sealed) < nonceSize+tagSize {
+		return nil, ErrMalformedCiphertext
+	}
+	plaintext, err := aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData)
+	if err != nil {
+		return nil, ErrMalformedCiphertext
+	}
+	return plaintext, nil
+}
+
+// Encrypt seals plaintext with a new data key wrapped by active master key
+func (r *KeyRing) Encrypt(plaintext []byte) ([]byte, error) {
+	header := binary.BigEndian.AppendUint32(magic[:], r.activeId)
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("keyring - KeyRing.Encrypt - rand.Read: %w", err)
+	}
+	wrappedKey, err := seal(r.keys[r.activeId], dataKey, header)
+	if err != nil {
+		return nil, fmt.Errorf("keyring - KeyRing.Encrypt - wrap key: %w", err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("keyring - KeyRing.Encrypt - newAEAD: %w", err)
+	}
+	sealed, err := seal(dataAEAD, plaintext, header)
+	if err != nil {
+		return nil, fmt.Errorf("keyring - KeyRing.Encrypt - seal: %w", err)
+	}
+
+	ciphertext := make([]byte, 0, len(header)+len(wrappedKey)+len(sealed))
+	ciphertext = append(ciphertext, header...)
+	ciphertext = append(ciphertext, wrappedKey...)
+	return append(ciphertext, sealed...), nil
+}
+
+func (r *KeyRing) Decrypt(ciphertext []byte) ([]byte, error) {
+	keyId, err := r.keyId(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	masterKey, ok := r.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("keyring - KeyRing.Decrypt - key %d: %w", keyId, ErrUnknownKey)
+	}
+
+	header := ciphertext[:headerSize]
+	dataKey, err := open(masterKey, ciphertext[headerSize:headerSize+wrappedKeyLen], header)
+	if err != nil {
+		return nil, err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("keyring - KeyRing.Decrypt - newAEAD: %w", err)
+	}
+	return open(dataAEAD, ciphertext[headerSize+wrappedKeyLen:], header)
+}
+
+// IsEncrypted reports whether data was produced by Encrypt, values encrypted otherwise must be migrated
+func (r *KeyRing) IsEncrypted(data []byte) bool {
+	_, err := r.keyId(data)
+	return err == nil
+}
+
+// NeedsRotation reports whether ciphertext is not encrypted with active master key
+func (r *KeyRing) NeedsRotation(ciphertext []byte) bool {
+	keyId, err := r.keyId(ciphertext)
+	return err != nil || keyId != r.activeId
+}
+
+func (r *KeyRing) keyId(ciphertext []byte) (uint32, error) {
+	if len(ciphertext) < headerSize+wrappedKeyLen+nonceSize+tagSize || !bytes.HasPrefix(ciphertext, magic[:]) {
+		return 0, ErrMalformedCiphertext
+	}
+	return binary.BigEndian.Uint32(ciphertext[magicSize:headerSize]), nil
+}
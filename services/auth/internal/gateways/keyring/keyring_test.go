@@ -0,0 +1,92 @@
+package keyring
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func keySpec(t *testing.T, id int) string {
+	t.Helper()
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return fmt.Sprintf("%d:%s", id, hex.EncodeToString(key))
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ring, err := Load([]string{keySpec(t, 1)}, nil, 0)
+	require.NoError(t, err)
+	plaintext := []byte("JBSWY3DPEHPK3PXP")
+
+	ciphertext, err := ring.Encrypt(plaintext)
+	require.NoError(t, err)
+
+	assert.True(t, ring.IsEncrypted(ciphertext))
+	assert.False(t, ring.NeedsRotation(ciphertext))
+	assert.NotContains(t, string(ciphertext), string(plaintext))
+	decrypted, err := ring.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	t.Run("every value has own data key", func(t *testing.T) {
+		another, err := ring.Encrypt(plaintext)
+		require.NoError(t, err)
+		assert.NotEqual(t, ciphertext[headerSize:headerSize+wrappedKeyLen], another[headerSize:headerSize+wrappedKeyLen])
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte{}, ciphertext...)
+		tampered[len(tampered)-1] ^= 1
+		_, err := ring.Decrypt(tampered)
+		assert.ErrorIs(t, err, ErrMalformedCiphertext)
+	})
+
+	t.Run("legacy value", func(t *testing.T) {
+		legacy := make([]byte, 60)
+		assert.False(t, ring.IsEncrypted(legacy))
+		_, err := ring.Decrypt(legacy)
+		assert.ErrorIs(t, err, ErrMalformedCiphertext)
+	})
+}
+
+func TestRotation(t *testing.T) {
+	oldKey, newKey := keySpec(t, 1), keySpec(t, 2)
+	oldRing, err := Load([]string{oldKey}, nil, 0)
+	require.NoError(t, err)
+	ciphertext, err := oldRing.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	keysFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keysFile, []byte("# rotated\n"+newKey+"\n"), 0o600))
+	ring, err := Load([]string{oldKey}, []string{keysFile}, 0)
+	require.NoError(t, err)
+
+	assert.True(t, ring.NeedsRotation(ciphertext))
+	decrypted, err := ring.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), decrypted)
+
+	reencrypted, err := ring.Encrypt(decrypted)
+	require.NoError(t, err)
+	assert.False(t, ring.NeedsRotation(reencrypted))
+	_, err = oldRing.Decrypt(reencrypted)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	_, err := Load(nil, nil, 0)
+	assert.ErrorIs(t, err, ErrNoKeys)
+
+	_, err = Load([]string{"1:abcd"}, nil, 0)
+	assert.Error(t, err)
+
+	_, err = Load([]string{keySpec(t, 1)}, nil, 2)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
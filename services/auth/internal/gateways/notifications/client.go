@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	notificationsContracts "github.com/modulix-systems/goose-talk/contracts/rmqcontracts/notifications"
 	"github.com/modulix-systems/goose-talk/internal/entity"
@@ -69,6 +70,37 @@ func (c *Client) sendEmailNotice(ctx context.Context, typ notificationsContracts
 	return nil
 }
 
+func (c *Client) sendNotification(ctx context.Context, typ notificationsContracts.NotificationType, userId int, deviceId string, payload any) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "notifications.Client.sendNotification"
+	log := c.log.With("op", op, "correlationId", correlationId, "userId", userId, "typ", typ)
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		log.Error("marshal payload failed", "err", err)
+		return fmt.Errorf("%s - marshal payload %s: %w", op, typ, err)
+	}
+	message := notificationsContracts.NotificationMessage{
+		Type:     typ,
+		UserId:   userId,
+		DeviceId: deviceId,
+		Data:     payloadJson,
+	}
+	messageJson, err := json.Marshal(message)
+	if err != nil {
+		log.Error("marshal notification message failed", "err", err)
+		return fmt.Errorf("%s - marshal notification message: %w", op, err)
+	}
+
+	publishing := amqp091.Publishing{Body: messageJson, CorrelationId: correlationId}
+	if err = c.channel.PublishWithContext(ctx, "", c.contracts.Queues.Notifications.Name, false, false, publishing); err != nil {
+		log.Error("rmq publish failed", "err", err)
+		return fmt.Errorf("%s - publish to queue: %w", op, err)
+	}
+	log.Info("Notification published")
+
+	return nil
+}
+
 func (c *Client) SendEmailVerifyEmail(ctx context.Context, to, username, otp string) error {
 	payload := notificationsContracts.EmailVerifyNotice{
 		Code:     otp,
@@ -140,13 +172,21 @@ func (c *Client) SendLoginNewDeviceEmail(
 	ctx context.Context,
 	to, username string,
 	newSession *entity.AuthSession,
-	lang string,
+	approveToken, denyToken, lang string,
 ) error {
 	payload := notificationsContracts.LoginNewDeviceNotice{
 		Username:   username,
 		IpAddr:     newSession.IpAddr,
 		DeviceInfo: newSession.DeviceInfo,
 		Location:   newSession.Location,
+
+		DeviceName:     newSession.DeviceName(),
+		DeviceType:     string(newSession.Device.Type),
+		DevicePlatform: newSession.Device.Platform,
+		DeviceClient:   newSession.Device.Client,
+
+		ApproveToken: approveToken,
+		DenyToken:    denyToken,
 	}
 
 	return c.sendEmailNotice(
@@ -157,3 +197,201 @@ func (c *Client) SendLoginNewDeviceEmail(
 		lang,
 	)
 }
+
+func (c *Client) SendLoginChallengeEmail(
+	ctx context.Context,
+	to, username, otp, ip, location, lang string,
+) error {
+	payload := notificationsContracts.LoginChallengeNotice{
+		Username: username,
+		Code:     otp,
+		IpAddr:   ip,
+		Location: location,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_LOGIN_CHALLENGE,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendPasswordResetEmail(
+	ctx context.Context,
+	to, username, otp, lang string,
+) error {
+	payload := notificationsContracts.PasswordResetNotice{
+		Username: username,
+		Code:     otp,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_PASSWORD_RESET,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendSessionEvictedEmail(
+	ctx context.Context,
+	to, username string,
+	session *entity.AuthSession,
+	lang string,
+) error {
+	payload := notificationsContracts.SessionEvictedNotice{
+		Username:   username,
+		IpAddr:     session.IpAddr,
+		DeviceName: session.DeviceName(),
+		Location:   session.Location,
+		LastSeenAt: session.LastSeenAt,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_SESSION_EVICTED,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendAccountDeletionScheduledEmail(
+	ctx context.Context,
+	to, username string,
+	scheduledAt time.Time,
+	lang string,
+) error {
+	payload := notificationsContracts.AccountDeletionNotice{
+		Username:    username,
+		ScheduledAt: scheduledAt,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_DELETION_SCHEDULED,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendAccountDeletionReminderEmail(
+	ctx context.Context,
+	to, username string,
+	scheduledAt time.Time,
+	lang string,
+) error {
+	payload := notificationsContracts.AccountDeletionNotice{
+		Username:    username,
+		ScheduledAt: scheduledAt,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_DELETION_REMINDER,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendDataExportReadyEmail(
+	ctx context.Context,
+	to, username, downloadToken string,
+	expiresAt time.Time,
+	lang string,
+) error {
+	payload := notificationsContracts.DataExportReadyNotice{
+		Username:      username,
+		DownloadToken: downloadToken,
+		ExpiresAt:     expiresAt,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_DATA_EXPORT_READY,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendAccountStateChangedEmail(
+	ctx context.Context,
+	to, username, state, reason string,
+	until *time.Time,
+	lang string,
+) error {
+	payload := notificationsContracts.AccountStateNotice{
+		Username: username,
+		State:    state,
+		Reason:   reason,
+		Until:    until,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_ACCOUNT_STATE,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendAccountReactivationEmail(ctx context.Context, to, username, otp, lang string) error {
+	payload := notificationsContracts.AccountReactivationNotice{
+		Username: username,
+		Code:     otp,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_ACCOUNT_REACTIVATE,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendMagicLinkEmail(ctx context.Context, to, username, token, otp, ip, location, lang string) error {
+	payload := notificationsContracts.MagicLinkNotice{
+		Username: username,
+		Token:    token,
+		Code:     otp,
+		IpAddr:   ip,
+		Location: location,
+	}
+
+	return c.sendEmailNotice(
+		ctx,
+		notificationsContracts.EMAIL_TYPE_MAGIC_LINK,
+		to,
+		payload,
+		lang,
+	)
+}
+
+func (c *Client) SendPrekeysLowNotification(
+	ctx context.Context,
+	userId int,
+	deviceId string,
+	remaining int,
+	signedPrekeyRotationDue bool,
+) error {
+	payload := notificationsContracts.PrekeysLowNotice{
+		Remaining:               remaining,
+		SignedPrekeyRotationDue: signedPrekeyRotationDue,
+	}
+
+	return c.sendNotification(
+		ctx,
+		notificationsContracts.NOTIFICATION_TYPE_PREKEYS_LOW,
+		userId,
+		deviceId,
+		payload,
+	)
+}
@@ -0,0 +1,140 @@
+package pwned
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// falsePositiveRate of the filter, password reported as breached with this probability
+// is asked to be replaced which is acceptable
+const falsePositiveRate = 0.001
+
+// Filter is an in-memory bloom filter of SHA-1 hashes of breached passwords
+// loaded from Have I Been Pwned dataset
+type Filter struct {
+	bits      []uint64
+	hashCount uint64
+}
+
+// Load reads HIBP datasets. Path is either a file with one full SHA-1 hash per line
+// or a directory of range files named by 5 characters hash prefix with one hash suffix per line,
+// as produced by HIBP downloader. Optional ":count" postfix, empty lines and lines starting with '#' are ignored
+func Load(paths ...string) (*Filter, error) {
+	total := 0
+	for _, path := range paths {
+		if err := forEachHash(path, func([sha1.Size]byte) { total++ }); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := newFilter(total)
+	for _, path := range paths {
+		if err := forEachHash(path, filter.add); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+func newFilter(size int) *Filter {
+	if size == 0 {
+		return &Filter{}
+	}
+	bitsCount := math.Ceil(-float64(size) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	hashCount := math.Max(1, math.Round(bitsCount/float64(size)*math.Ln2))
+	return &Filter{
+		bits:      make([]uint64, (uint64(bitsCount)+63)/64),
+		hashCount: uint64(hashCount),
+	}
+}
+
+// positions derives bit positions from digest with double hashing,
+// SHA-1 output is uniform so its parts are used as independent hashes
+func (f *Filter) positions(digest [sha1.Size]byte, fn func(bit uint64)) {
+	size := uint64(len(f.bits)) * 64
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	for i := range f.hashCount {
+		fn((h1 + i*h2) % size)
+	}
+}
+
+func (f *Filter) add(digest [sha1.Size]byte) {
+	f.positions(digest, func(bit uint64) { f.bits[bit/64] |= 1 << (bit % 64) })
+}
+
+func (f *Filter) Contains(password string) bool {
+	if len(f.bits) == 0 {
+		return false
+	}
+	found := true
+	f.positions(sha1.Sum([]byte(password)), func(bit uint64) {
+		found = found && f.bits[bit/64]&(1<<(bit%64)) != 0
+	})
+	return found
+}
+
+func forEachHash(path string, fn func([sha1.Size]byte)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("pwned - forEachHash - os.Stat(%s): %w", path, err)
+	}
+	if !info.IsDir() {
+		return readHashFile(path, "", fn)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("pwned - forEachHash - os.ReadDir(%s): %w", path, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		prefix := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if len(prefix) != 5 {
+			continue
+		}
+		if err = readHashFile(filepath.Join(path, entry.Name()), prefix, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readHashFile(path string, prefix string, fn func([sha1.Size]byte)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("pwned - readHashFile - os.Open(%s): %w", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, _, _ := strings.Cut(line, ":")
+		var digest [sha1.Size]byte
+		decoded, err := hex.DecodeString(prefix + hash)
+		if err != nil || len(decoded) != sha1.Size {
+			return fmt.Errorf("pwned - readHashFile - %s:%d: invalid SHA-1 hash", path, lineNum)
+		}
+		copy(digest[:], decoded)
+		fn(digest)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("pwned - readHashFile - scanner.Scan(%s): %w", path, err)
+	}
+	return nil
+}
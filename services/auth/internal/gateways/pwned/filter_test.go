@@ -0,0 +1,69 @@
+package pwned
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha1Hex(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func TestFilterContains(t *testing.T) {
+	dir := t.TempDir()
+	fullHashes := filepath.Join(dir, "hashes.txt")
+	require.NoError(t, os.WriteFile(fullHashes, []byte("# top passwords\n"+sha1Hex("password")+":9545824\n\n"), 0o600))
+
+	rangesDir := filepath.Join(dir, "ranges")
+	require.NoError(t, os.Mkdir(rangesDir, 0o700))
+	hash := sha1Hex("qwerty123")
+	require.NoError(t, os.WriteFile(filepath.Join(rangesDir, hash[:5]+".txt"), []byte(hash[5:]+":42\n"), 0o600))
+
+	filter, err := Load(fullHashes, rangesDir)
+	require.NoError(t, err)
+
+	assert.True(t, filter.Contains("password"))
+	assert.True(t, filter.Contains("qwerty123"))
+	assert.False(t, filter.Contains("correct horse battery staple"))
+}
+
+func TestFilterFalsePositives(t *testing.T) {
+	lines := make([]string, 0, 1000)
+	for i := range 1000 {
+		lines = append(lines, sha1Hex(fmt.Sprintf("breached-%d", i)))
+	}
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+	filter, err := Load(path)
+	require.NoError(t, err)
+
+	falsePositives := 0
+	for i := range 10000 {
+		if filter.Contains(fmt.Sprintf("safe-%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 100)
+}
+
+func TestLoadEmpty(t *testing.T) {
+	filter, err := Load()
+	require.NoError(t, err)
+	assert.False(t, filter.Contains("password"))
+}
+
+func TestLoadInvalidEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+	require.NoError(t, os.WriteFile(path, []byte(sha1Hex("password")+"\nnot a hash\n"), 0o600))
+	_, err := Load(path)
+	assert.ErrorContains(t, err, ":2")
+}
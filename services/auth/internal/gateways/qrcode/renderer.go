@@ -0,0 +1,55 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// Renderer encodes text as QR code image
+type Renderer struct {
+	// Size is width and height of PNG image in pixels
+	Size  int
+	Level goqrcode.RecoveryLevel
+}
+
+func New(size int) *Renderer {
+	return &Renderer{Size: size, Level: goqrcode.Medium}
+}
+
+func (r *Renderer) RenderPNG(content string) ([]byte, error) {
+	png, err := goqrcode.Encode(content, r.Level, r.Size)
+	if err != nil {
+		return nil, fmt.Errorf("qrcode - Renderer.RenderPNG - goqrcode.Encode: %w", err)
+	}
+	return png, nil
+}
+
+// RenderSVG draws every dark module as a unit square, so image scales to any size without blur
+func (r *Renderer) RenderSVG(content string) ([]byte, error) {
+	code, err := goqrcode.New(content, r.Level)
+	if err != nil {
+		return nil, fmt.Errorf("qrcode - Renderer.RenderSVG - goqrcode.New: %w", err)
+	}
+	bitmap := code.Bitmap()
+	size := len(bitmap)
+
+	var svg bytes.Buffer
+	fmt.Fprintf(
+		&svg,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="100%%" height="100%%" fill="#fff"/><path fill="#000" d="`,
+		size, size,
+	)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&svg, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	svg.WriteString(`"/></svg>`)
+
+	return svg.Bytes(), nil
+}
@@ -0,0 +1,45 @@
+package qrcode_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/qrcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const content = "https://example.com/qr-login?client_id=client&token=token"
+
+func TestRenderPNG(t *testing.T) {
+	renderer := qrcode.New(256)
+
+	data, err := renderer.RenderPNG(content)
+	require.NoError(t, err)
+
+	image, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 256, image.Bounds().Dx())
+	assert.Equal(t, 256, image.Bounds().Dy())
+}
+
+func TestRenderSVG(t *testing.T) {
+	renderer := qrcode.New(256)
+
+	data, err := renderer.RenderSVG(content)
+	require.NoError(t, err)
+
+	svg := string(data)
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.True(t, strings.HasSuffix(svg, "</svg>"))
+	assert.Contains(t, svg, "h1v1h-1z")
+}
+
+func TestRenderTooLongContent(t *testing.T) {
+	renderer := qrcode.New(256)
+
+	_, err := renderer.RenderSVG(strings.Repeat("a", 5000))
+	assert.Error(t, err)
+}
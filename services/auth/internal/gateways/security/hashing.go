@@ -1,23 +1,110 @@
 package security
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	argon2idPrefix          = "$argon2id$"
+	argon2SaltLength uint32 = 16
+	argon2KeyLength  uint32 = 32
+)
+
+var (
+	ErrPasswordMismatch      = errors.New("password does not match hash")
+	ErrUnsupportedHashFormat = errors.New("password hash format is not supported")
+)
+
+// Argon2Params are tunable argon2id parameters, Memory is in KiB
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// HashPassword returns argon2id hash encoded in PHC string format:
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash>
 func (s *SecurityProvider) HashPassword(plainPassword string) ([]byte, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(plainPassword), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, err
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("SecurityProvider - HashPassword - rand.Read: %w", err)
 	}
-	return hashedPassword, nil
+	params := s.passwordParams
+	key := argon2.IDKey([]byte(plainPassword), salt, params.Iterations, params.Memory, params.Parallelism, argon2KeyLength)
+	encoded := fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key),
+	)
+	return []byte(encoded), nil
 }
 
-func (s *SecurityProvider) ComparePasswords(hashed []byte, plain string) (error) {
-	err := bcrypt.CompareHashAndPassword(hashed, []byte(plain))
+// ComparePasswords verifies plain password against argon2id PHC string or legacy bcrypt hash
+func (s *SecurityProvider) ComparePasswords(hashed []byte, plain string) error {
+	if !strings.HasPrefix(string(hashed), argon2idPrefix) {
+		err := bcrypt.CompareHashAndPassword(hashed, []byte(plain))
+		if err != nil {
+			return fmt.Errorf("SecurityProvider - ComparePasswords - bcrypt.CompareHashAndPassword: %w", err)
+		}
+		return nil
+	}
+
+	params, salt, key, err := decodeArgon2idHash(string(hashed))
 	if err != nil {
-		return fmt.Errorf("SecurityProvider - ComparePasswords - bcrypt.CompareHashAndPassword: %w", err)
+		return fmt.Errorf("SecurityProvider - ComparePasswords - decodeArgon2idHash: %w", err)
+	}
+	actualKey := argon2.IDKey([]byte(plain), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, actualKey) != 1 {
+		return ErrPasswordMismatch
 	}
 	return nil
 }
+
+// PasswordNeedsRehash reports whether hash was produced with another algorithm or parameters than current ones.
+// Malformed hashes are reported as well
+func (s *SecurityProvider) PasswordNeedsRehash(hashed []byte) bool {
+	params, _, key, err := decodeArgon2idHash(string(hashed))
+	if err != nil {
+		return true
+	}
+	return params != s.passwordParams || uint32(len(key)) != argon2KeyLength
+}
+
+func decodeArgon2idHash(encoded string) (params Argon2Params, salt []byte, key []byte, err error) {
+	// leading "$" produces empty first part
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || "$"+parts[1]+"$" != argon2idPrefix {
+		return params, nil, nil, ErrUnsupportedHashFormat
+	}
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnsupportedHashFormat
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrUnsupportedHashFormat
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, ErrUnsupportedHashFormat
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnsupportedHashFormat
+	}
+	return params, salt, key, nil
+}
+
+// HashToken returns deterministic hash of high entropy token which can be used for lookups.
+// It must not be used for passwords
+func (s *SecurityProvider) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,49 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashToken(t *testing.T) {
+	securityProvider := SecurityProvider{}
+	hash := securityProvider.HashToken("token")
+	assert.Equal(t, hash, securityProvider.HashToken("token"))
+	assert.NotEqual(t, hash, securityProvider.HashToken("another token"))
+	assert.NotContains(t, hash, "token")
+}
+
+func TestHashPassword(t *testing.T) {
+	params := Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+	securityProvider := SecurityProvider{passwordParams: params}
+	// longer than 72 bytes which bcrypt truncates
+	password := strings.Repeat("p", 80)
+
+	hashed, err := securityProvider.HashPassword(password)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(hashed), "$argon2id$v=19$m=1024,t=1,p=1$"))
+	assert.NoError(t, securityProvider.ComparePasswords(hashed, password))
+	assert.ErrorIs(t, securityProvider.ComparePasswords(hashed, strings.Repeat("p", 79)+"q"), ErrPasswordMismatch)
+	assert.False(t, securityProvider.PasswordNeedsRehash(hashed))
+
+	t.Run("params changed", func(t *testing.T) {
+		upgradedProvider := SecurityProvider{passwordParams: Argon2Params{Memory: 2048, Iterations: 1, Parallelism: 1}}
+		assert.True(t, upgradedProvider.PasswordNeedsRehash(hashed))
+		assert.NoError(t, upgradedProvider.ComparePasswords(hashed, password))
+	})
+}
+
+func TestComparePasswordsLegacyBcrypt(t *testing.T) {
+	securityProvider := SecurityProvider{passwordParams: Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}}
+	hashed, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.NoError(t, securityProvider.ComparePasswords(hashed, "password"))
+	assert.Error(t, securityProvider.ComparePasswords(hashed, "another password"))
+	assert.True(t, securityProvider.PasswordNeedsRehash(hashed))
+}
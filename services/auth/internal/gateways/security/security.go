@@ -5,15 +5,17 @@ import (
 )
 
 type SecurityProvider struct {
-	totpTTL time.Duration
-	otpLen  int
-	appName string
+	totpTTL        time.Duration
+	otpLen         int
+	appName        string
+	passwordParams Argon2Params
 }
 
-func New(totpTTL time.Duration, otpLen int, appName string) *SecurityProvider {
+func New(totpTTL time.Duration, otpLen int, appName string, passwordParams Argon2Params) *SecurityProvider {
 	return &SecurityProvider{
-		totpTTL: totpTTL,
-		otpLen:  otpLen,
-		appName: appName,
+		totpTTL:        totpTTL,
+		otpLen:         otpLen,
+		appName:        appName,
+		passwordParams: passwordParams,
 	}
 }
@@ -0,0 +1,79 @@
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	ErrUnsupportedPublicKey = errors.New("public key must be ECDSA P-256 or Ed25519")
+	ErrInvalidSignature     = errors.New("signature is invalid")
+)
+
+// parsePublicKey decodes base64url encoded PKIX (SPKI) public key
+func parsePublicKey(publicKey string) (any, error) {
+	der, err := base64.RawURLEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("SecurityProvider - parsePublicKey - base64.DecodeString: %w", err)
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("SecurityProvider - parsePublicKey - x509.ParsePKIXPublicKey: %w", err)
+	}
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return key, nil
+	case *ecdsa.PublicKey:
+		if key.Curve.Params().Name != "P-256" {
+			return nil, ErrUnsupportedPublicKey
+		}
+		return key, nil
+	default:
+		return nil, ErrUnsupportedPublicKey
+	}
+}
+
+func (s *SecurityProvider) ValidatePublicKey(publicKey string) error {
+	_, err := parsePublicKey(publicKey)
+	return err
+}
+
+// VerifySignature checks base64url encoded signature of message made with private key of publicKey.
+// ECDSA signatures are accepted both in ASN.1 and raw r||s (WebCrypto) form, message is hashed with SHA-256
+func (s *SecurityProvider) VerifySignature(publicKey string, message []byte, signature string) error {
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, sig) {
+			return ErrInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) == 2*size {
+			r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+			if !ecdsa.Verify(key, digest[:], r, s) {
+				return ErrInvalidSignature
+			}
+			return nil
+		}
+		if !ecdsa.VerifyASN1(key, digest[:], sig) {
+			return ErrInvalidSignature
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package security_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/gateways/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(der)
+}
+
+func TestVerifySignature(t *testing.T) {
+	securityProvider := security.New(time.Hour, config.OTP_LENGTH, "Test App", security.Argon2Params{})
+	message := []byte("session\nPOST\n1700000000\nnonce")
+
+	t.Run("ed25519", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		signature := base64.RawURLEncoding.EncodeToString(ed25519.Sign(privateKey, message))
+
+		assert.NoError(t, securityProvider.VerifySignature(encodePublicKey(t, publicKey), message, signature))
+		assert.ErrorIs(t, securityProvider.VerifySignature(encodePublicKey(t, publicKey), []byte("tampered"), signature), security.ErrInvalidSignature)
+	})
+
+	t.Run("ecdsa asn1", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		digest := sha256.Sum256(message)
+		sig, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		require.NoError(t, err)
+
+		err = securityProvider.VerifySignature(encodePublicKey(t, &privateKey.PublicKey), message, base64.RawURLEncoding.EncodeToString(sig))
+		assert.NoError(t, err)
+	})
+
+	t.Run("ecdsa raw", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		digest := sha256.Sum256(message)
+		r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+		require.NoError(t, err)
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+
+		err = securityProvider.VerifySignature(encodePublicKey(t, &privateKey.PublicKey), message, base64.RawURLEncoding.EncodeToString(sig))
+		assert.NoError(t, err)
+	})
+
+	t.Run("another key", func(t *testing.T) {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		anotherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		signature := base64.RawURLEncoding.EncodeToString(ed25519.Sign(privateKey, message))
+
+		err = securityProvider.VerifySignature(encodePublicKey(t, anotherPublicKey), message, signature)
+		assert.ErrorIs(t, err, security.ErrInvalidSignature)
+	})
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	securityProvider := security.New(time.Hour, config.OTP_LENGTH, "Test App", security.Argon2Params{})
+
+	t.Run("supported", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		assert.NoError(t, securityProvider.ValidatePublicKey(encodePublicKey(t, &privateKey.PublicKey)))
+	})
+
+	t.Run("unsupported curve", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		err = securityProvider.ValidatePublicKey(encodePublicKey(t, &privateKey.PublicKey))
+		assert.ErrorIs(t, err, security.ErrUnsupportedPublicKey)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		assert.Error(t, securityProvider.ValidatePublicKey("not a key"))
+	})
+}
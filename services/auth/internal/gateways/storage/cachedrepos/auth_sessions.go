@@ -0,0 +1,122 @@
+// Package cachedrepos combines durable repositories with fast cache ones.
+package cachedrepos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// AuthSessionsRepo is a write-through sessions repository.
+// Primary storage is the source of truth and every write goes there first,
+// cache is used to serve lookups of single session by id (hot path of session pinging).
+// Failed cache writes are logged and never fail the operation,
+// except revocation which must not leave revoked session in cache
+type AuthSessionsRepo struct {
+	primary gateways.AuthSessionsRepo
+	cache   gateways.AuthSessionsRepo
+	log     logger.Interface
+}
+
+func NewAuthSessionsRepo(primary gateways.AuthSessionsRepo, cache gateways.AuthSessionsRepo, log logger.Interface) *AuthSessionsRepo {
+	return &AuthSessionsRepo{primary: primary, cache: cache, log: log}
+}
+
+func (repo *AuthSessionsRepo) CreateWithTTL(ctx context.Context, session *entity.AuthSession, ttl time.Duration) (*entity.AuthSession, error) {
+	newSession, err := repo.primary.CreateWithTTL(ctx, session, ttl)
+	if err != nil {
+		return nil, err
+	}
+	repo.fillCache(ctx, newSession)
+	return newSession, nil
+}
+
+func (repo *AuthSessionsRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.AuthSession, error) {
+	return repo.primary.GetAllByUserId(ctx, userId)
+}
+
+func (repo *AuthSessionsRepo) GetByLoginData(ctx context.Context, userId int, ip string, deviceKey string) (*entity.AuthSession, error) {
+	return repo.primary.GetByLoginData(ctx, userId, ip, deviceKey)
+}
+
+func (repo *AuthSessionsRepo) GetById(ctx context.Context, userId int, sessionId string) (*entity.AuthSession, error) {
+	session, err := repo.cache.GetById(ctx, userId, sessionId)
+	if err == nil {
+		return session, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		repo.log.Error(fmt.Errorf("cachedrepos - AuthSessionsRepo.GetById - cache.GetById: %w", err), "sessionId", sessionId)
+	}
+
+	session, err = repo.primary.GetById(ctx, userId, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	repo.fillCache(ctx, session)
+	return session, nil
+}
+
+func (repo *AuthSessionsRepo) UpdateById(ctx context.Context, userId int, sessionId string, lastSeenAt time.Time, ttl time.Duration) error {
+	if err := repo.primary.UpdateById(ctx, userId, sessionId, lastSeenAt, ttl); err != nil {
+		return err
+	}
+
+	err := repo.cache.UpdateById(ctx, userId, sessionId, lastSeenAt, ttl)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		repo.log.Error(fmt.Errorf("cachedrepos - AuthSessionsRepo.UpdateById - cache.UpdateById: %w", err), "sessionId", sessionId)
+		// drop possibly stale cache entry, it will be filled on next lookup
+		return repo.cache.DeleteById(ctx, userId, sessionId)
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) UpdateAuthenticationById(ctx context.Context, userId int, sessionId string, authenticatedAt time.Time, method entity.AuthMethod) error {
+	if err := repo.primary.UpdateAuthenticationById(ctx, userId, sessionId, authenticatedAt, method); err != nil {
+		return err
+	}
+
+	err := repo.cache.UpdateAuthenticationById(ctx, userId, sessionId, authenticatedAt, method)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		repo.log.Error(fmt.Errorf("cachedrepos - AuthSessionsRepo.UpdateAuthenticationById - cache.UpdateAuthenticationById: %w", err), "sessionId", sessionId)
+		// drop possibly stale cache entry, it will be filled on next lookup
+		return repo.cache.DeleteById(ctx, userId, sessionId)
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) DeleteById(ctx context.Context, userId int, sessionId string) error {
+	if err := repo.primary.DeleteById(ctx, userId, sessionId); err != nil {
+		return err
+	}
+	if err := repo.cache.DeleteById(ctx, userId, sessionId); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("cachedrepos - AuthSessionsRepo.DeleteById - cache.DeleteById: %w", err)
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) DeleteAllByUserId(ctx context.Context, userId int, excludeSessionId string) error {
+	if err := repo.primary.DeleteAllByUserId(ctx, userId, excludeSessionId); err != nil {
+		return err
+	}
+	if err := repo.cache.DeleteAllByUserId(ctx, userId, excludeSessionId); err != nil {
+		return fmt.Errorf("cachedrepos - AuthSessionsRepo.DeleteAllByUserId - cache.DeleteAllByUserId: %w", err)
+	}
+	return nil
+}
+
+// fillCache puts session into cache for the rest of its lifetime
+func (repo *AuthSessionsRepo) fillCache(ctx context.Context, session *entity.AuthSession) {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return
+	}
+	if _, err := repo.cache.CreateWithTTL(ctx, session, ttl); err != nil {
+		repo.log.Error(fmt.Errorf("cachedrepos - AuthSessionsRepo.fillCache - cache.CreateWithTTL: %w", err), "sessionId", session.Id)
+	}
+}
@@ -0,0 +1,87 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+type AccessTokensRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *AccessTokensRepo) selectActive() squirrel.SelectBuilder {
+	return repo.Builder.Select("*").From("access_token").Where("expires_at > now()")
+}
+
+func (repo *AccessTokensRepo) Create(ctx context.Context, token *entity.AccessToken) (*entity.AccessToken, error) {
+	qb := repo.Builder.Insert("access_token").
+		Columns("user_id", "name", "prefix", "token_hash", "scopes", "expires_at").
+		Values(token.UserId, token.Name, token.Prefix, token.TokenHash, token.Scopes, token.ExpiresAt).
+		Suffix("RETURNING *")
+	newToken, err := postgres.ExecAndGetOne[entity.AccessToken](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return newToken, nil
+}
+
+func (repo *AccessTokensRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.AccessToken, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"token_hash": tokenHash})
+	token, err := postgres.ExecAndGetOne[entity.AccessToken](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func (repo *AccessTokensRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.AccessToken, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"user_id": userId}).OrderBy("created_at DESC")
+	return postgres.ExecAndGetMany[entity.AccessToken](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *AccessTokensRepo) UpdateLastUsedAt(ctx context.Context, tokenId int, lastUsedAt time.Time) error {
+	qb := repo.Builder.Update("access_token").Set("last_used_at", lastUsedAt).Where(squirrel.Eq{"id": tokenId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *AccessTokensRepo) DeleteById(ctx context.Context, userId int, tokenId int) error {
+	qb := repo.Builder.Delete("access_token").Where(squirrel.Eq{"user_id": userId, "id": tokenId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *AccessTokensRepo) DeleteAllByUserId(ctx context.Context, userId int) error {
+	qb := repo.Builder.Delete("access_token").Where(squirrel.Eq{"user_id": userId})
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		return err
+	}
+	return nil
+}
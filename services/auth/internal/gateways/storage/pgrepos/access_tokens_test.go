@@ -0,0 +1,143 @@
+package pgrepos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccessToken(t *testing.T, testSuite *pgrepos.TestSuite, userId int) *entity.AccessToken {
+	t.Helper()
+	token := helpers.MockAccessToken()
+	token.UserId = userId
+	token, err := testSuite.AccessTokens.Create(testSuite.TxCtx, token)
+	require.NoError(t, err)
+	return token
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		token := helpers.MockAccessToken()
+		token.UserId = user.Id
+
+		newToken, err := testSuite.AccessTokens.Create(testSuite.TxCtx, token)
+
+		require.NoError(t, err)
+		assert.NotZero(t, newToken.Id)
+		assert.Equal(t, token.Name, newToken.Name)
+		assert.Equal(t, token.Prefix, newToken.Prefix)
+		assert.Equal(t, token.TokenHash, newToken.TokenHash)
+		assert.Equal(t, token.Scopes, newToken.Scopes)
+		assert.Nil(t, newToken.LastUsedAt)
+		assert.WithinDuration(t, token.ExpiresAt, newToken.ExpiresAt, time.Second)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		token := helpers.MockAccessToken()
+		token.UserId = -1
+		_, err := testSuite.AccessTokens.Create(testSuite.TxCtx, token)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAccessTokenByTokenHash(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	token := createAccessToken(t, testSuite, user.Id)
+
+	t.Run("success", func(t *testing.T) {
+		foundToken, err := testSuite.AccessTokens.GetByTokenHash(testSuite.TxCtx, token.TokenHash)
+		require.NoError(t, err)
+		assert.Equal(t, token.Id, foundToken.Id)
+		assert.Equal(t, user.Id, foundToken.UserId)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expiredToken := helpers.MockAccessToken()
+		expiredToken.UserId = user.Id
+		expiredToken.ExpiresAt = time.Now().Add(-time.Minute)
+		expiredToken, err := testSuite.AccessTokens.Create(testSuite.TxCtx, expiredToken)
+		require.NoError(t, err)
+
+		_, err = testSuite.AccessTokens.GetByTokenHash(testSuite.TxCtx, expiredToken.TokenHash)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAllAccessTokensByUserId(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	first := createAccessToken(t, testSuite, user.Id)
+	second := createAccessToken(t, testSuite, user.Id)
+
+	tokens, err := testSuite.AccessTokens.GetAllByUserId(testSuite.TxCtx, user.Id)
+
+	require.NoError(t, err)
+	ids := []int{}
+	for _, token := range tokens {
+		ids = append(ids, token.Id)
+	}
+	assert.ElementsMatch(t, []int{first.Id, second.Id}, ids)
+}
+
+func TestUpdateAccessTokenLastUsedAt(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	token := createAccessToken(t, testSuite, user.Id)
+	expectedLastUsedAt := time.Now()
+
+	err = testSuite.AccessTokens.UpdateLastUsedAt(testSuite.TxCtx, token.Id, expectedLastUsedAt)
+
+	require.NoError(t, err)
+	foundToken, err := testSuite.AccessTokens.GetByTokenHash(testSuite.TxCtx, token.TokenHash)
+	require.NoError(t, err)
+	require.NotNil(t, foundToken.LastUsedAt)
+	assert.WithinDuration(t, expectedLastUsedAt, *foundToken.LastUsedAt, time.Millisecond)
+}
+
+func TestDeleteAccessToken(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	token := createAccessToken(t, testSuite, user.Id)
+
+	t.Run("another user", func(t *testing.T) {
+		err := testSuite.AccessTokens.DeleteById(testSuite.TxCtx, user.Id+1, token.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.AccessTokens.DeleteById(testSuite.TxCtx, user.Id, token.Id)
+		require.NoError(t, err)
+		_, err = testSuite.AccessTokens.GetByTokenHash(testSuite.TxCtx, token.TokenHash)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestDeleteAllAccessTokensByUserId(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	createAccessToken(t, testSuite, user.Id)
+	createAccessToken(t, testSuite, user.Id)
+
+	err = testSuite.AccessTokens.DeleteAllByUserId(testSuite.TxCtx, user.Id)
+
+	require.NoError(t, err)
+	tokens, err := testSuite.AccessTokens.GetAllByUserId(testSuite.TxCtx, user.Id)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -0,0 +1,70 @@
+package pgrepos
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+// AdminActionsRepo is an append-only audit log of admin actions, records are never updated or removed
+type AdminActionsRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *AdminActionsRepo) Create(ctx context.Context, action *entity.AdminAction) (*entity.AdminAction, error) {
+	details := action.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+	var targetUserId any
+	if action.TargetUserId != 0 {
+		targetUserId = action.TargetUserId
+	}
+
+	qb := repo.Builder.Insert("admin_action").
+		Columns("admin_id", "target_user_id", "action", "details").
+		Values(action.AdminId, targetUserId, action.Action, details).
+		Suffix("RETURNING id, created_at")
+	created, err := postgres.ExecAndGetOne[entity.AdminAction](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		return nil, err
+	}
+
+	newAction := *action
+	newAction.Details = details
+	newAction.Id = created.Id
+	newAction.CreatedAt = created.CreatedAt
+	return &newAction, nil
+}
+
+func (repo *AdminActionsRepo) GetMany(ctx context.Context, filter *dtos.AdminActionsFilter) ([]entity.AdminAction, error) {
+	query := repo.Builder.Select(
+		"id",
+		"admin_id",
+		"COALESCE(target_user_id, 0) AS target_user_id",
+		"action",
+		"details",
+		"created_at",
+	).From("admin_action").OrderBy("id DESC")
+
+	if filter.AdminId != 0 {
+		query = query.Where(squirrel.Eq{"admin_id": filter.AdminId})
+	}
+	if filter.TargetUserId != 0 {
+		query = query.Where(squirrel.Eq{"target_user_id": filter.TargetUserId})
+	}
+	if len(filter.Actions) > 0 {
+		query = query.Where(squirrel.Eq{"action": filter.Actions})
+	}
+	if filter.BeforeId != 0 {
+		query = query.Where(squirrel.Lt{"id": filter.BeforeId})
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	return postgres.ExecAndGetMany[entity.AdminAction](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
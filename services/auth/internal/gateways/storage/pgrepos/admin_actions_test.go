@@ -0,0 +1,100 @@
+package pgrepos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAdminAction(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	admin, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		action := &entity.AdminAction{
+			AdminId:      admin.Id,
+			TargetUserId: admin.Id,
+			Action:       entity.ADMIN_ACTION_FORCE_LOGOUT,
+			Details:      map[string]string{"reason": "compromised"},
+		}
+
+		newAction, err := testSuite.AdminActions.Create(testSuite.TxCtx, action)
+
+		require.NoError(t, err)
+		assert.NotZero(t, newAction.Id)
+		assert.WithinDuration(t, time.Now(), newAction.CreatedAt, time.Second)
+		foundActions, err := testSuite.AdminActions.GetMany(testSuite.TxCtx, &dtos.AdminActionsFilter{TargetUserId: admin.Id})
+		require.NoError(t, err)
+		require.Len(t, foundActions, 1)
+		assert.Equal(t, newAction.Id, foundActions[0].Id)
+		assert.Equal(t, action.Details, foundActions[0].Details)
+	})
+
+	t.Run("without target", func(t *testing.T) {
+		newAction, err := testSuite.AdminActions.Create(testSuite.TxCtx, &entity.AdminAction{
+			AdminId: admin.Id,
+			Action:  entity.ADMIN_ACTION_SEARCH_USERS,
+		})
+
+		require.NoError(t, err)
+		assert.Zero(t, newAction.TargetUserId)
+		assert.NotNil(t, newAction.Details)
+	})
+}
+
+func TestGetAdminActions(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	admin, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	createAction := func(action *entity.AdminAction) *entity.AdminAction {
+		t.Helper()
+		action, err := testSuite.AdminActions.Create(testSuite.TxCtx, action)
+		require.NoError(t, err)
+		return action
+	}
+	searchAction := createAction(&entity.AdminAction{AdminId: admin.Id, Action: entity.ADMIN_ACTION_SEARCH_USERS})
+	viewAction := createAction(&entity.AdminAction{
+		AdminId: admin.Id, TargetUserId: user.Id, Action: entity.ADMIN_ACTION_VIEW_USER,
+	})
+	resetAction := createAction(&entity.AdminAction{
+		AdminId: admin.Id, TargetUserId: user.Id, Action: entity.ADMIN_ACTION_RESET_TWO_FA,
+	})
+
+	t.Run("by admin newest first", func(t *testing.T) {
+		actions, err := testSuite.AdminActions.GetMany(testSuite.TxCtx, &dtos.AdminActionsFilter{AdminId: admin.Id})
+		require.NoError(t, err)
+		require.Len(t, actions, 3)
+		assert.Equal(t, resetAction.Id, actions[0].Id)
+		assert.Equal(t, searchAction.Id, actions[2].Id)
+	})
+
+	t.Run("by target and action", func(t *testing.T) {
+		actions, err := testSuite.AdminActions.GetMany(testSuite.TxCtx, &dtos.AdminActionsFilter{
+			TargetUserId: user.Id,
+			Actions:      []entity.AdminActionType{entity.ADMIN_ACTION_VIEW_USER},
+		})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, viewAction.Id, actions[0].Id)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		actions, err := testSuite.AdminActions.GetMany(testSuite.TxCtx, &dtos.AdminActionsFilter{
+			AdminId:  admin.Id,
+			BeforeId: resetAction.Id,
+			Limit:    1,
+		})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, viewAction.Id, actions[0].Id)
+	})
+}
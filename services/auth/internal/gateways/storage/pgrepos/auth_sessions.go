@@ -0,0 +1,208 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+// AuthSessionsRepo keeps full history of user's sessions.
+// Deleted sessions are not removed but marked as deactivated
+type AuthSessionsRepo struct {
+	*postgres.Postgres
+}
+
+var sessionSelectCols = []string{
+	"user_session.id",
+	"user_session.user_id",
+	"user_session.last_seen_at",
+	"user_session.created_at",
+	"user_session.expires_at",
+	"user_session.is_long_lived",
+	"user_session.authenticated_at",
+	"user_session.auth_method",
+	"user_session.public_key",
+	"client_identity.location",
+	"host(client_identity.ip_addr) AS ip_addr",
+	"client_identity.device_info",
+	`json_build_object(
+		'type', client_identity.device_type,
+		'platform', client_identity.device_platform,
+		'os_version', client_identity.device_os_version,
+		'client', client_identity.device_client,
+		'client_version', client_identity.device_client_version
+	) AS device`,
+}
+
+func (repo *AuthSessionsRepo) selectActive() squirrel.SelectBuilder {
+	return repo.Builder.Select(sessionSelectCols...).From("user_session").
+		Join("client_identity ON client_identity.id=user_session.client_identity_id").
+		Where(squirrel.Eq{"user_session.deactived_at": nil}).
+		Where("user_session.expires_at > now()")
+}
+
+func (repo *AuthSessionsRepo) CreateWithTTL(ctx context.Context, session *entity.AuthSession, ttl time.Duration) (*entity.AuthSession, error) {
+	newSession := *session
+
+	now := time.Now().UTC()
+	if newSession.CreatedAt.IsZero() {
+		newSession.CreatedAt = now
+	}
+	if newSession.LastSeenAt.IsZero() {
+		newSession.LastSeenAt = now
+	}
+	newSession.ExpiresAt = now.Add(ttl)
+
+	// client identity is inserted within the same statement to avoid orphaned rows
+	qb := repo.Builder.Insert("user_session").
+		Prefix(
+			`WITH identity AS (
+				INSERT INTO client_identity(
+					location, ip_addr, device_info, device_key,
+					device_type, device_platform, device_os_version, device_client, device_client_version
+				) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING id
+			)`,
+			newSession.Location, newSession.IpAddr, newSession.DeviceInfo, newSession.DeviceKey(),
+			deviceType(newSession.Device), newSession.Device.Platform, newSession.Device.OsVersion,
+			newSession.Device.Client, newSession.Device.ClientVersion,
+		).
+		Columns(
+			"id", "user_id", "expires_at", "created_at", "last_seen_at", "is_long_lived",
+			"authenticated_at", "auth_method", "public_key", "client_identity_id",
+		).
+		Select(
+			squirrel.Select().
+				Column("?::text", newSession.Id).
+				Column("?::int", newSession.UserId).
+				Column("?::timestamptz", newSession.ExpiresAt).
+				Column("?::timestamptz", newSession.CreatedAt).
+				Column("?::timestamptz", newSession.LastSeenAt).
+				Column("?::bool", newSession.IsLongLived).
+				Column("?::timestamptz", newSession.AuthenticatedAt).
+				Column("?::text", newSession.AuthMethod).
+				Column("?::text", newSession.PublicKey).
+				Column("identity.id").
+				From("identity"),
+		)
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	return &newSession, nil
+}
+
+func (repo *AuthSessionsRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.AuthSession, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"user_session.user_id": userId}).
+		OrderBy("user_session.last_seen_at DESC")
+	return postgres.ExecAndGetMany[entity.AuthSession](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *AuthSessionsRepo) GetByLoginData(ctx context.Context, userId int, ip string, deviceKey string) (*entity.AuthSession, error) {
+	query := repo.selectActive().
+		Where(squirrel.Eq{"user_session.user_id": userId, "client_identity.device_key": deviceKey}).
+		Where("client_identity.ip_addr = ?::inet", ip).
+		OrderBy("user_session.last_seen_at DESC").
+		Limit(1)
+	session, err := postgres.ExecAndGetOne[entity.AuthSession](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+func (repo *AuthSessionsRepo) GetById(ctx context.Context, userId int, sessionId string) (*entity.AuthSession, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"user_session.user_id": userId, "user_session.id": sessionId})
+	session, err := postgres.ExecAndGetOne[entity.AuthSession](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+func (repo *AuthSessionsRepo) UpdateById(ctx context.Context, userId int, sessionId string, lastSeenAt time.Time, ttl time.Duration) error {
+	if lastSeenAt.IsZero() && ttl == 0 {
+		return nil
+	}
+	qb := repo.Builder.Update("user_session").
+		Where(squirrel.Eq{"user_id": userId, "id": sessionId, "deactived_at": nil}).
+		Where("expires_at > now()")
+	if !lastSeenAt.IsZero() {
+		qb = qb.Set("last_seen_at", lastSeenAt)
+	}
+	if ttl != 0 {
+		qb = qb.Set("expires_at", time.Now().UTC().Add(ttl))
+	}
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) UpdateAuthenticationById(ctx context.Context, userId int, sessionId string, authenticatedAt time.Time, method entity.AuthMethod) error {
+	qb := repo.Builder.Update("user_session").
+		Set("authenticated_at", authenticatedAt).
+		Set("auth_method", method).
+		Where(squirrel.Eq{"user_id": userId, "id": sessionId, "deactived_at": nil}).
+		Where("expires_at > now()")
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) DeleteById(ctx context.Context, userId int, sessionId string) error {
+	qb := repo.Builder.Update("user_session").Set("deactived_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"user_id": userId, "id": sessionId, "deactived_at": nil})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *AuthSessionsRepo) DeleteAllByUserId(ctx context.Context, userId int, excludeSessionId string) error {
+	qb := repo.Builder.Update("user_session").Set("deactived_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"user_id": userId, "deactived_at": nil})
+	if excludeSessionId != "" {
+		qb = qb.Where(squirrel.NotEq{"id": excludeSessionId})
+	}
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deviceType(device entity.Device) entity.DeviceType {
+	if device.Type == "" {
+		return entity.DEVICE_TYPE_UNKNOWN
+	}
+	return device.Type
+}
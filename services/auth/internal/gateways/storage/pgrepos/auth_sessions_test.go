@@ -0,0 +1,149 @@
+package pgrepos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUserSession(t *testing.T, testSuite *pgrepos.TestSuite, userId int) *entity.AuthSession {
+	t.Helper()
+	session := helpers.MockAuthSession()
+	session.UserId = userId
+	session, err := testSuite.AuthSessions.CreateWithTTL(testSuite.TxCtx, session, time.Minute)
+	require.NoError(t, err)
+	return session
+}
+
+func TestCreateAuthSession(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		expectedSession := helpers.MockAuthSession()
+		expectedSession.UserId = user.Id
+		expectedTTL := time.Hour
+
+		newSession, err := testSuite.AuthSessions.CreateWithTTL(testSuite.TxCtx, expectedSession, expectedTTL)
+
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), newSession.CreatedAt, time.Second)
+		assert.WithinDuration(t, time.Now().Add(expectedTTL), newSession.ExpiresAt, time.Second)
+		foundSession, err := testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, expectedSession.Id)
+		require.NoError(t, err)
+		assert.Equal(t, expectedSession.IpAddr, foundSession.IpAddr)
+		assert.Equal(t, expectedSession.DeviceInfo, foundSession.DeviceInfo)
+		assert.Equal(t, expectedSession.Location, foundSession.Location)
+		assert.Equal(t, expectedSession.Device, foundSession.Device)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		session := helpers.MockAuthSession()
+		session.UserId = -1
+		_, err := testSuite.AuthSessions.CreateWithTTL(testSuite.TxCtx, session, time.Hour)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAuthSession(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	session1 := createUserSession(t, testSuite, user.Id)
+	session2 := createUserSession(t, testSuite, user.Id)
+
+	t.Run("one by login data", func(t *testing.T) {
+		foundSession, err := testSuite.AuthSessions.GetByLoginData(testSuite.TxCtx, user.Id, session2.IpAddr, session2.DeviceKey())
+		assert.NoError(t, err)
+		require.NotNil(t, foundSession)
+		assert.Equal(t, session2.Id, foundSession.Id)
+	})
+
+	t.Run("all by user id", func(t *testing.T) {
+		foundSessions, err := testSuite.AuthSessions.GetAllByUserId(testSuite.TxCtx, user.Id)
+		assert.NoError(t, err)
+		assert.Len(t, foundSessions, 2)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id+1, session1.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestUpdateAuthSession(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	session := createUserSession(t, testSuite, user.Id)
+	expectedLastSeenAt := time.Now().Add(-24 * time.Hour)
+	expectedTTL := 5 * time.Hour
+
+	err = testSuite.AuthSessions.UpdateById(testSuite.TxCtx, user.Id, session.Id, expectedLastSeenAt, expectedTTL)
+
+	require.NoError(t, err)
+	foundSession, err := testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, session.Id)
+	require.NoError(t, err)
+	assert.WithinDuration(t, expectedLastSeenAt, foundSession.LastSeenAt, time.Second)
+	assert.WithinDuration(t, time.Now().Add(expectedTTL), foundSession.ExpiresAt, time.Second)
+}
+
+func TestUpdateAuthSessionAuthentication(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	session := createUserSession(t, testSuite, user.Id)
+	expectedAuthenticatedAt := time.Now()
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.AuthSessions.UpdateAuthenticationById(testSuite.TxCtx, user.Id, session.Id, expectedAuthenticatedAt, entity.AUTH_METHOD_TWO_FA)
+		require.NoError(t, err)
+		foundSession, err := testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, session.Id)
+		require.NoError(t, err)
+		assert.WithinDuration(t, expectedAuthenticatedAt, foundSession.AuthenticatedAt, time.Millisecond)
+		assert.Equal(t, entity.AUTH_METHOD_TWO_FA, foundSession.AuthMethod)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.AuthSessions.UpdateAuthenticationById(testSuite.TxCtx, user.Id, "unknown", expectedAuthenticatedAt, entity.AUTH_METHOD_TWO_FA)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestDeleteAuthSession(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("one by session id", func(t *testing.T) {
+		session := createUserSession(t, testSuite, user.Id)
+
+		err := testSuite.AuthSessions.DeleteById(testSuite.TxCtx, user.Id, session.Id)
+
+		require.NoError(t, err)
+		_, err = testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, session.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		err = testSuite.AuthSessions.DeleteById(testSuite.TxCtx, user.Id, session.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("all by user id", func(t *testing.T) {
+		includedSession := createUserSession(t, testSuite, user.Id)
+		excludedSession := createUserSession(t, testSuite, user.Id)
+
+		err := testSuite.AuthSessions.DeleteAllByUserId(testSuite.TxCtx, user.Id, excludedSession.Id)
+
+		require.NoError(t, err)
+		_, err = testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, includedSession.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = testSuite.AuthSessions.GetById(testSuite.TxCtx, user.Id, excludedSession.Id)
+		assert.NoError(t, err)
+	})
+}
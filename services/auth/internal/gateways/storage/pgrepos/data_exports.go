@@ -0,0 +1,167 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+type DataExportsRepo struct {
+	*postgres.Postgres
+}
+
+// Create returns storage.ErrAlreadyExists if user has another export being collected
+func (repo *DataExportsRepo) Create(ctx context.Context, export *entity.DataExport) (*entity.DataExport, error) {
+	qb := repo.Builder.Insert("data_export").
+		Columns("id", "user_id", "collect_until").
+		Values(export.Id, export.UserId, export.CollectUntil).
+		Suffix("RETURNING *")
+	newExport, err := postgres.ExecAndGetOne[entity.DataExport](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return newExport, nil
+}
+
+func (repo *DataExportsRepo) GetById(ctx context.Context, exportId string) (*entity.DataExport, error) {
+	query := repo.Builder.Select("*").From("data_export").Where(squirrel.Eq{"id": exportId})
+	export, err := postgres.ExecAndGetOne[entity.DataExport](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return export, nil
+}
+
+func (repo *DataExportsRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.DataExport, error) {
+	query := repo.Builder.Select("*").From("data_export").
+		Where(squirrel.Eq{"user_id": userId}).
+		OrderBy("requested_at DESC")
+	return postgres.ExecAndGetMany[entity.DataExport](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+// SavePart stores or replaces service's part of export.
+// Returns storage.ErrNotFound if export does not exist or is not collected anymore
+func (repo *DataExportsRepo) SavePart(ctx context.Context, part *entity.DataExportPart) error {
+	source := repo.Builder.Select("id").
+		Column("?::varchar", part.Service).
+		Column("?::jsonb", part.Data).
+		From("data_export").
+		Where(squirrel.Eq{"id": part.ExportId, "status": entity.DATA_EXPORT_STATUS_PENDING})
+	qb := repo.Builder.Insert("data_export_part").
+		Columns("export_id", "service", "data").
+		Select(source).
+		Suffix("ON CONFLICT (export_id, service) DO UPDATE SET data = EXCLUDED.data, received_at = now()")
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *DataExportsRepo) GetAllParts(ctx context.Context, exportId string) ([]entity.DataExportPart, error) {
+	query := repo.Builder.Select("*").From("data_export_part").
+		Where(squirrel.Eq{"export_id": exportId}).
+		OrderBy("service")
+	return postgres.ExecAndGetMany[entity.DataExportPart](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+// GetAllReadyToAssemble returns up to limit oldest pending exports whose collection time is over
+// or which already received at least expectedParts parts. Exports waiting for retry are skipped
+func (repo *DataExportsRepo) GetAllReadyToAssemble(ctx context.Context, expectedParts int, limit int) ([]entity.DataExport, error) {
+	query := repo.Builder.Select("*").From("data_export").
+		Where(squirrel.Eq{"status": entity.DATA_EXPORT_STATUS_PENDING}).
+		Where("(next_attempt_at IS NULL OR next_attempt_at <= now())").
+		Where(squirrel.Or{
+			squirrel.Expr("collect_until <= now()"),
+			squirrel.Expr(
+				"(SELECT COUNT(*) FROM data_export_part WHERE export_id = data_export.id) >= ?", expectedParts,
+			),
+		}).
+		OrderBy("requested_at").
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany[entity.DataExport](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+// CompleteById marks pending export as ready and drops its collected parts which are now kept in archive
+func (repo *DataExportsRepo) CompleteById(ctx context.Context, exportId string, fileName string, expiresAt time.Time) error {
+	qb := repo.Builder.Update("data_export").
+		Prefix("WITH parts AS (DELETE FROM data_export_part WHERE export_id = ?)", exportId).
+		Set("status", entity.DATA_EXPORT_STATUS_READY).
+		Set("file_name", fileName).
+		Set("completed_at", squirrel.Expr("now()")).
+		Set("expires_at", expiresAt).
+		Where(squirrel.Eq{"id": exportId, "status": entity.DATA_EXPORT_STATUS_PENDING})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// RecordFailedAttemptById postpones next assembly of pending export until nextAttemptAt.
+// Export is marked as failed once it has failed maxAttempts times
+func (repo *DataExportsRepo) RecordFailedAttemptById(
+	ctx context.Context,
+	exportId string,
+	nextAttemptAt time.Time,
+	maxAttempts int,
+) (*entity.DataExport, error) {
+	qb := repo.Builder.Update("data_export").
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("status", squirrel.Expr(
+			"CASE WHEN attempts + 1 >= ? THEN ? ELSE status END", maxAttempts, entity.DATA_EXPORT_STATUS_FAILED,
+		)).
+		Where(squirrel.Eq{"id": exportId, "status": entity.DATA_EXPORT_STATUS_PENDING}).
+		Suffix("RETURNING *")
+	export, err := postgres.ExecAndGetOne[entity.DataExport](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return export, nil
+}
+
+// GetAllExpired returns up to limit ready exports which may no longer be downloaded
+func (repo *DataExportsRepo) GetAllExpired(ctx context.Context, limit int) ([]entity.DataExport, error) {
+	query := repo.Builder.Select("*").From("data_export").
+		Where(squirrel.Eq{"status": entity.DATA_EXPORT_STATUS_READY}).
+		Where("expires_at <= now()").
+		OrderBy("expires_at").
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany[entity.DataExport](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *DataExportsRepo) UpdateStatusById(ctx context.Context, exportId string, status entity.DataExportStatus) error {
+	qb := repo.Builder.Update("data_export").Set("status", status).Where(squirrel.Eq{"id": exportId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
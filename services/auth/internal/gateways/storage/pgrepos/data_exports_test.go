@@ -0,0 +1,209 @@
+package pgrepos_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDataExport(t *testing.T, testSuite *pgrepos.TestSuite, userId int, collectUntil time.Time) *entity.DataExport {
+	t.Helper()
+	export, err := testSuite.DataExports.Create(testSuite.TxCtx, &entity.DataExport{
+		Id:           gofakeit.UUID(),
+		UserId:       userId,
+		CollectUntil: collectUntil,
+	})
+	require.NoError(t, err)
+	return export
+}
+
+func saveDataExportPart(t *testing.T, testSuite *pgrepos.TestSuite, exportId string, service string) {
+	t.Helper()
+	require.NoError(t, testSuite.DataExports.SavePart(testSuite.TxCtx, &entity.DataExportPart{
+		ExportId: exportId,
+		Service:  service,
+		Data:     json.RawMessage(`{"messages":[]}`),
+	}))
+}
+
+func TestCreateDataExport(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		export := createDataExport(t, testSuite, user.Id, time.Now().Add(time.Minute))
+
+		assert.Equal(t, entity.DATA_EXPORT_STATUS_PENDING, export.Status)
+		assert.NotZero(t, export.RequestedAt)
+		assert.Nil(t, export.CompletedAt)
+	})
+
+	t.Run("export already pending", func(t *testing.T) {
+		_, err := testSuite.DataExports.Create(testSuite.TxCtx, &entity.DataExport{
+			Id:           gofakeit.UUID(),
+			UserId:       user.Id,
+			CollectUntil: time.Now().Add(time.Minute),
+		})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		_, err := testSuite.DataExports.Create(testSuite.TxCtx, &entity.DataExport{
+			Id:           gofakeit.UUID(),
+			UserId:       -1,
+			CollectUntil: time.Now().Add(time.Minute),
+		})
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestSaveDataExportPart(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	export := createDataExport(t, testSuite, user.Id, time.Now().Add(time.Minute))
+
+	t.Run("replaces previous part", func(t *testing.T) {
+		saveDataExportPart(t, testSuite, export.Id, "chats")
+		err := testSuite.DataExports.SavePart(testSuite.TxCtx, &entity.DataExportPart{
+			ExportId: export.Id,
+			Service:  "chats",
+			Data:     json.RawMessage(`{"chats":[1]}`),
+		})
+		require.NoError(t, err)
+
+		parts, err := testSuite.DataExports.GetAllParts(testSuite.TxCtx, export.Id)
+		require.NoError(t, err)
+		require.Len(t, parts, 1)
+		assert.JSONEq(t, `{"chats":[1]}`, string(parts[0].Data))
+	})
+
+	t.Run("export completed", func(t *testing.T) {
+		otherUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+		require.NoError(t, err)
+		completed := createDataExport(t, testSuite, otherUser.Id, time.Now().Add(time.Minute))
+		require.NoError(t, testSuite.DataExports.CompleteById(testSuite.TxCtx, completed.Id, "export.zip", time.Now().Add(time.Hour)))
+
+		err = testSuite.DataExports.SavePart(testSuite.TxCtx, &entity.DataExportPart{
+			ExportId: completed.Id,
+			Service:  "chats",
+			Data:     json.RawMessage(`{}`),
+		})
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAllDataExportsReadyToAssemble(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	newUserId := func() int {
+		user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+		require.NoError(t, err)
+		return user.Id
+	}
+	collectionOver := createDataExport(t, testSuite, newUserId(), time.Now().Add(-time.Minute))
+	allPartsReceived := createDataExport(t, testSuite, newUserId(), time.Now().Add(time.Hour))
+	saveDataExportPart(t, testSuite, allPartsReceived.Id, "chats")
+	saveDataExportPart(t, testSuite, allPartsReceived.Id, "messages")
+	partsMissing := createDataExport(t, testSuite, newUserId(), time.Now().Add(time.Hour))
+	saveDataExportPart(t, testSuite, partsMissing.Id, "chats")
+
+	exports, err := testSuite.DataExports.GetAllReadyToAssemble(testSuite.TxCtx, 2, 10)
+
+	require.NoError(t, err)
+	exportIds := make([]string, len(exports))
+	for i, export := range exports {
+		exportIds[i] = export.Id
+	}
+	assert.ElementsMatch(t, []string{collectionOver.Id, allPartsReceived.Id}, exportIds)
+}
+
+func TestRecordFailedDataExportAttempt(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	export := createDataExport(t, testSuite, user.Id, time.Now().Add(-time.Minute))
+
+	t.Run("postponed", func(t *testing.T) {
+		failed, err := testSuite.DataExports.RecordFailedAttemptById(testSuite.TxCtx, export.Id, time.Now().Add(time.Hour), 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, entity.DATA_EXPORT_STATUS_PENDING, failed.Status)
+		exports, err := testSuite.DataExports.GetAllReadyToAssemble(testSuite.TxCtx, 1, 10)
+		require.NoError(t, err)
+		for _, ready := range exports {
+			assert.NotEqual(t, export.Id, ready.Id)
+		}
+	})
+
+	t.Run("given up", func(t *testing.T) {
+		failed, err := testSuite.DataExports.RecordFailedAttemptById(testSuite.TxCtx, export.Id, time.Now(), 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, failed.Attempts)
+		assert.Equal(t, entity.DATA_EXPORT_STATUS_FAILED, failed.Status)
+	})
+
+	t.Run("not pending", func(t *testing.T) {
+		_, err := testSuite.DataExports.RecordFailedAttemptById(testSuite.TxCtx, export.Id, time.Now(), 2)
+
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestCompleteDataExport(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	export := createDataExport(t, testSuite, user.Id, time.Now().Add(time.Minute))
+	saveDataExportPart(t, testSuite, export.Id, "chats")
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.DataExports.CompleteById(testSuite.TxCtx, export.Id, "export.zip", time.Now().Add(time.Hour))
+
+		require.NoError(t, err)
+		completed, err := testSuite.DataExports.GetById(testSuite.TxCtx, export.Id)
+		require.NoError(t, err)
+		assert.Equal(t, entity.DATA_EXPORT_STATUS_READY, completed.Status)
+		assert.Equal(t, "export.zip", completed.FileName)
+		assert.NotNil(t, completed.CompletedAt)
+		parts, err := testSuite.DataExports.GetAllParts(testSuite.TxCtx, export.Id)
+		require.NoError(t, err)
+		assert.Empty(t, parts)
+	})
+
+	t.Run("already completed", func(t *testing.T) {
+		err := testSuite.DataExports.CompleteById(testSuite.TxCtx, export.Id, "export.zip", time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAllExpiredDataExports(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	expired := createDataExport(t, testSuite, user.Id, time.Now())
+	require.NoError(t, testSuite.DataExports.CompleteById(testSuite.TxCtx, expired.Id, "expired.zip", time.Now().Add(-time.Minute)))
+	active := createDataExport(t, testSuite, user.Id, time.Now())
+	require.NoError(t, testSuite.DataExports.CompleteById(testSuite.TxCtx, active.Id, "active.zip", time.Now().Add(time.Hour)))
+
+	exports, err := testSuite.DataExports.GetAllExpired(testSuite.TxCtx, 10)
+
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.Equal(t, expired.Id, exports[0].Id)
+
+	require.NoError(t, testSuite.DataExports.UpdateStatusById(testSuite.TxCtx, expired.Id, entity.DATA_EXPORT_STATUS_EXPIRED))
+	exports, err = testSuite.DataExports.GetAllExpired(testSuite.TxCtx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, exports)
+}
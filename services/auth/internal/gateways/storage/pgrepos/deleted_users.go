@@ -0,0 +1,38 @@
+package pgrepos
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+// DeletedUsersRepo reads tombstones written by UsersRepo.EraseById
+type DeletedUsersRepo struct {
+	*postgres.Postgres
+}
+
+// GetAllUnpublished returns up to limit oldest tombstones other services were not notified about
+func (repo *DeletedUsersRepo) GetAllUnpublished(ctx context.Context, limit int) ([]entity.DeletedUser, error) {
+	query := repo.Builder.Select("*").From("deleted_user").
+		Where(squirrel.Eq{"event_published_at": nil}).
+		OrderBy("deleted_at").
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany[entity.DeletedUser](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *DeletedUsersRepo) UpdateEventPublishedAt(ctx context.Context, userId int, publishedAt time.Time) error {
+	qb := repo.Builder.Update("deleted_user").Set("event_published_at", publishedAt).
+		Where(squirrel.Eq{"user_id": userId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+type InvitesRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *InvitesRepo) Create(ctx context.Context, invite *entity.Invite) (*entity.Invite, error) {
+	qb := repo.Builder.Insert("invite").
+		Columns("inviter_id", "prefix", "code_hash", "email", "max_uses", "expires_at").
+		Values(invite.InviterId, invite.Prefix, invite.CodeHash, invite.Email, invite.MaxUses, invite.ExpiresAt).
+		Suffix("RETURNING *")
+	newInvite, err := postgres.ExecAndGetOne[entity.Invite](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return newInvite, nil
+}
+
+func (repo *InvitesRepo) GetByCodeHash(ctx context.Context, codeHash string) (*entity.Invite, error) {
+	query := repo.Builder.Select("*").From("invite").Where(squirrel.Eq{"code_hash": codeHash})
+	invite, err := postgres.ExecAndGetOne[entity.Invite](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (repo *InvitesRepo) GetAllByInviterId(ctx context.Context, inviterId int) ([]entity.Invite, error) {
+	query := repo.Builder.Select("*").From("invite").Where(squirrel.Eq{"inviter_id": inviterId}).OrderBy("created_at DESC")
+	return postgres.ExecAndGetMany[entity.Invite](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *InvitesRepo) RevokeById(ctx context.Context, inviterId int, inviteId int) error {
+	qb := repo.Builder.Update("invite").
+		Set("revoked_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": inviteId, "inviter_id": inviterId, "revoked_at": nil})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// Reserve takes one use of invite if it may still be redeemed by email.
+// Check and update are atomic, so concurrent sign ups can not exceed usage limit
+func (repo *InvitesRepo) Reserve(ctx context.Context, inviteId int, email string) error {
+	qb := repo.Builder.Update("invite").
+		Set("uses", squirrel.Expr("uses + 1")).
+		Where(squirrel.Eq{"id": inviteId, "revoked_at": nil}).
+		Where("expires_at > now()").
+		Where("uses < max_uses").
+		Where(squirrel.Or{squirrel.Eq{"email": ""}, squirrel.Expr("lower(email) = lower(?)", email)})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// Release returns use taken by Reserve if sign up did not complete
+func (repo *InvitesRepo) Release(ctx context.Context, inviteId int) error {
+	qb := repo.Builder.Update("invite").
+		Set("uses", squirrel.Expr("uses - 1")).
+		Where(squirrel.Eq{"id": inviteId}).
+		Where("uses > 0")
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *InvitesRepo) CreateRedemption(ctx context.Context, inviteId int, userId int) error {
+	qb := repo.Builder.Insert("invite_redemption").Columns("invite_id", "user_id").Values(inviteId, userId)
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// GetAllRedemptionsByInviterId returns users who signed up with any of inviter's invites, latest first
+func (repo *InvitesRepo) GetAllRedemptionsByInviterId(ctx context.Context, inviterId int) ([]entity.InviteRedemption, error) {
+	query := repo.Builder.Select("r.invite_id", "r.user_id", "r.redeemed_at").
+		From("invite_redemption r").
+		Join("invite i ON i.id = r.invite_id").
+		Where(squirrel.Eq{"i.inviter_id": inviterId}).
+		OrderBy("r.redeemed_at DESC")
+	return postgres.ExecAndGetMany[entity.InviteRedemption](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
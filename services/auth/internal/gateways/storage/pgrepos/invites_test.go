@@ -0,0 +1,174 @@
+package pgrepos_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createInvite(t *testing.T, testSuite *pgrepos.TestSuite, inviterId int, maxUses int) *entity.Invite {
+	t.Helper()
+	invite := helpers.MockInvite()
+	invite.InviterId = inviterId
+	invite.MaxUses = maxUses
+	invite, err := testSuite.Invites.Create(testSuite.TxCtx, invite)
+	require.NoError(t, err)
+	return invite
+}
+
+func TestCreateInvite(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		invite := helpers.MockInvite()
+		invite.InviterId = user.Id
+		invite.Email = "friend@example.com"
+
+		newInvite, err := testSuite.Invites.Create(testSuite.TxCtx, invite)
+
+		require.NoError(t, err)
+		assert.NotZero(t, newInvite.Id)
+		assert.Equal(t, invite.Prefix, newInvite.Prefix)
+		assert.Equal(t, invite.CodeHash, newInvite.CodeHash)
+		assert.Equal(t, invite.Email, newInvite.Email)
+		assert.Equal(t, invite.MaxUses, newInvite.MaxUses)
+		assert.Zero(t, newInvite.Uses)
+		assert.Nil(t, newInvite.RevokedAt)
+		assert.WithinDuration(t, invite.ExpiresAt, newInvite.ExpiresAt, time.Second)
+	})
+
+	t.Run("inviter not found", func(t *testing.T) {
+		invite := helpers.MockInvite()
+		invite.InviterId = -1
+		_, err := testSuite.Invites.Create(testSuite.TxCtx, invite)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetInviteByCodeHash(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	invite := createInvite(t, testSuite, user.Id, 1)
+
+	t.Run("success", func(t *testing.T) {
+		foundInvite, err := testSuite.Invites.GetByCodeHash(testSuite.TxCtx, invite.CodeHash)
+		require.NoError(t, err)
+		assert.Equal(t, invite.Id, foundInvite.Id)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := testSuite.Invites.GetByCodeHash(testSuite.TxCtx, "unknown")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestRevokeInviteById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	invite := createInvite(t, testSuite, user.Id, 1)
+
+	t.Run("another inviter", func(t *testing.T) {
+		err := testSuite.Invites.RevokeById(testSuite.TxCtx, -1, invite.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Invites.RevokeById(testSuite.TxCtx, user.Id, invite.Id)
+		require.NoError(t, err)
+
+		invites, err := testSuite.Invites.GetAllByInviterId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+		assert.NotNil(t, invites[0].RevokedAt)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		err := testSuite.Invites.RevokeById(testSuite.TxCtx, user.Id, invite.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestReserveInvite(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("usage limit", func(t *testing.T) {
+		invite := createInvite(t, testSuite, user.Id, 1)
+
+		err := testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "new@example.com")
+		require.NoError(t, err)
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "new@example.com")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		err = testSuite.Invites.Release(testSuite.TxCtx, invite.Id)
+		require.NoError(t, err)
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "new@example.com")
+		assert.NoError(t, err)
+	})
+
+	t.Run("bound email", func(t *testing.T) {
+		invite := helpers.MockInvite()
+		invite.InviterId = user.Id
+		invite.Email = "friend@example.com"
+		invite, err := testSuite.Invites.Create(testSuite.TxCtx, invite)
+		require.NoError(t, err)
+
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "stranger@example.com")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, strings.ToUpper(invite.Email))
+		assert.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		invite := helpers.MockInvite()
+		invite.InviterId = user.Id
+		invite.ExpiresAt = time.Now().Add(-time.Minute)
+		invite, err := testSuite.Invites.Create(testSuite.TxCtx, invite)
+		require.NoError(t, err)
+
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "new@example.com")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		invite := createInvite(t, testSuite, user.Id, 1)
+		err := testSuite.Invites.RevokeById(testSuite.TxCtx, user.Id, invite.Id)
+		require.NoError(t, err)
+
+		err = testSuite.Invites.Reserve(testSuite.TxCtx, invite.Id, "new@example.com")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestInviteRedemptions(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	inviter, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	invitee, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	invite := createInvite(t, testSuite, inviter.Id, 2)
+
+	err = testSuite.Invites.CreateRedemption(testSuite.TxCtx, invite.Id, invitee.Id)
+	require.NoError(t, err)
+	err = testSuite.Invites.CreateRedemption(testSuite.TxCtx, invite.Id, invitee.Id)
+	assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+	redemptions, err := testSuite.Invites.GetAllRedemptionsByInviterId(testSuite.TxCtx, inviter.Id)
+	require.NoError(t, err)
+	require.Len(t, redemptions, 1)
+	assert.Equal(t, invite.Id, redemptions[0].InviteId)
+	assert.Equal(t, invitee.Id, redemptions[0].UserId)
+	assert.False(t, redemptions[0].RedeemedAt.IsZero())
+}
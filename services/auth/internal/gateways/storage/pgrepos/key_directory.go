@@ -0,0 +1,157 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+// KeyDirectoryRepo stores public keys used by clients for end-to-end encryption
+type KeyDirectoryRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *KeyDirectoryRepo) CreateIdentityKey(ctx context.Context, key *entity.IdentityKey) (*entity.IdentityKey, error) {
+	qb := repo.Builder.Insert("identity_key").
+		Columns("user_id", "device_id", "public_key").
+		Values(key.UserId, key.DeviceId, key.PublicKey).
+		Suffix("RETURNING *")
+	newKey, err := postgres.ExecAndGetOne[entity.IdentityKey](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return newKey, nil
+}
+
+func (repo *KeyDirectoryRepo) GetIdentityKey(ctx context.Context, userId int, deviceId string) (*entity.IdentityKey, error) {
+	query := repo.Builder.Select("*").From("identity_key").Where(squirrel.Eq{"user_id": userId, "device_id": deviceId})
+	key, err := postgres.ExecAndGetOne[entity.IdentityKey](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+func (repo *KeyDirectoryRepo) GetAllIdentityKeys(ctx context.Context, userId int) ([]entity.IdentityKey, error) {
+	query := repo.Builder.Select("*").From("identity_key").Where(squirrel.Eq{"user_id": userId}).OrderBy("created_at")
+	return postgres.ExecAndGetMany[entity.IdentityKey](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+// DeleteIdentityKey removes device from directory along with all its prekeys
+func (repo *KeyDirectoryRepo) DeleteIdentityKey(ctx context.Context, userId int, deviceId string) error {
+	qb := repo.Builder.Delete("identity_key").Where(squirrel.Eq{"user_id": userId, "device_id": deviceId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// SaveSignedPrekey sets device's signed prekey replacing the previous one
+func (repo *KeyDirectoryRepo) SaveSignedPrekey(ctx context.Context, prekey *entity.SignedPrekey) (*entity.SignedPrekey, error) {
+	qb := repo.Builder.Insert("signed_prekey").
+		Columns("user_id", "device_id", "key_id", "public_key", "signature").
+		Values(prekey.UserId, prekey.DeviceId, prekey.KeyId, prekey.PublicKey, prekey.Signature).
+		Suffix(`ON CONFLICT (user_id, device_id) DO UPDATE SET
+			key_id = EXCLUDED.key_id,
+			public_key = EXCLUDED.public_key,
+			signature = EXCLUDED.signature,
+			created_at = now()
+		RETURNING *`)
+	newPrekey, err := postgres.ExecAndGetOne[entity.SignedPrekey](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return newPrekey, nil
+}
+
+func (repo *KeyDirectoryRepo) GetSignedPrekey(ctx context.Context, userId int, deviceId string) (*entity.SignedPrekey, error) {
+	query := repo.Builder.Select("*").From("signed_prekey").Where(squirrel.Eq{"user_id": userId, "device_id": deviceId})
+	prekey, err := postgres.ExecAndGetOne[entity.SignedPrekey](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return prekey, nil
+}
+
+// CreateOneTimePrekeys inserts batch of prekeys, none are saved if any of key ids is already taken
+func (repo *KeyDirectoryRepo) CreateOneTimePrekeys(ctx context.Context, prekeys []entity.OneTimePrekey) error {
+	if len(prekeys) == 0 {
+		return nil
+	}
+	qb := repo.Builder.Insert("one_time_prekey").Columns("user_id", "device_id", "key_id", "public_key")
+	for _, prekey := range prekeys {
+		qb = qb.Values(prekey.UserId, prekey.DeviceId, prekey.KeyId, prekey.PublicKey)
+	}
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// ConsumeOneTimePrekey deletes and returns device's prekey with the lowest id.
+// Concurrent callers never receive the same prekey
+func (repo *KeyDirectoryRepo) ConsumeOneTimePrekey(ctx context.Context, userId int, deviceId string) (*entity.OneTimePrekey, error) {
+	qb := repo.Builder.Delete("one_time_prekey").
+		Prefix(
+			`WITH next AS (
+				SELECT user_id, device_id, key_id FROM one_time_prekey
+				WHERE user_id = ? AND device_id = ?
+				ORDER BY key_id LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)`,
+			userId, deviceId,
+		).
+		Suffix(
+			`USING next WHERE one_time_prekey.user_id = next.user_id
+				AND one_time_prekey.device_id = next.device_id
+				AND one_time_prekey.key_id = next.key_id
+			RETURNING one_time_prekey.*`,
+		)
+	prekey, err := postgres.ExecAndGetOne[entity.OneTimePrekey](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return prekey, nil
+}
+
+func (repo *KeyDirectoryRepo) CountOneTimePrekeys(ctx context.Context, userId int, deviceId string) (int, error) {
+	query := repo.Builder.Select("count(*)").From("one_time_prekey").Where(squirrel.Eq{"user_id": userId, "device_id": deviceId})
+	count, err := postgres.ExecAndGetOne(ctx, query, repo.Pool, pgx.RowTo[int], repo.TransactionCtxKey)
+	if err != nil {
+		return 0, err
+	}
+	return *count, nil
+}
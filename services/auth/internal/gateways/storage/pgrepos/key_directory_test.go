@@ -0,0 +1,192 @@
+package pgrepos_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createIdentityKey(t *testing.T, testSuite *pgrepos.TestSuite, userId int) *entity.IdentityKey {
+	t.Helper()
+	key := helpers.MockIdentityKey()
+	key.UserId = userId
+	key, err := testSuite.KeyDirectory.CreateIdentityKey(testSuite.TxCtx, key)
+	require.NoError(t, err)
+	return key
+}
+
+func createOneTimePrekeys(t *testing.T, testSuite *pgrepos.TestSuite, key *entity.IdentityKey, keyIds ...int) {
+	t.Helper()
+	prekeys := make([]entity.OneTimePrekey, len(keyIds))
+	for i, keyId := range keyIds {
+		prekeys[i] = entity.OneTimePrekey{
+			UserId:    key.UserId,
+			DeviceId:  key.DeviceId,
+			KeyId:     keyId,
+			PublicKey: gofakeit.LetterN(43),
+		}
+	}
+	require.NoError(t, testSuite.KeyDirectory.CreateOneTimePrekeys(testSuite.TxCtx, prekeys))
+}
+
+func TestCreateIdentityKey(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		key := helpers.MockIdentityKey()
+		key.UserId = user.Id
+
+		newKey, err := testSuite.KeyDirectory.CreateIdentityKey(testSuite.TxCtx, key)
+
+		require.NoError(t, err)
+		assert.Equal(t, key.DeviceId, newKey.DeviceId)
+		assert.Equal(t, key.PublicKey, newKey.PublicKey)
+		assert.NotZero(t, newKey.CreatedAt)
+	})
+
+	t.Run("device already registered", func(t *testing.T) {
+		key := createIdentityKey(t, testSuite, user.Id)
+		duplicate := helpers.MockIdentityKey()
+		duplicate.UserId = user.Id
+		duplicate.DeviceId = key.DeviceId
+
+		_, err := testSuite.KeyDirectory.CreateIdentityKey(testSuite.TxCtx, duplicate)
+
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		key := helpers.MockIdentityKey()
+		key.UserId = -1
+		_, err := testSuite.KeyDirectory.CreateIdentityKey(testSuite.TxCtx, key)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAllIdentityKeys(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	otherUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	createIdentityKey(t, testSuite, user.Id)
+	createIdentityKey(t, testSuite, user.Id)
+	createIdentityKey(t, testSuite, otherUser.Id)
+
+	keys, err := testSuite.KeyDirectory.GetAllIdentityKeys(testSuite.TxCtx, user.Id)
+
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+	for _, key := range keys {
+		assert.Equal(t, user.Id, key.UserId)
+	}
+}
+
+func TestDeleteIdentityKey(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("removes prekeys", func(t *testing.T) {
+		key := createIdentityKey(t, testSuite, user.Id)
+		createOneTimePrekeys(t, testSuite, key, 1, 2)
+
+		err := testSuite.KeyDirectory.DeleteIdentityKey(testSuite.TxCtx, user.Id, key.DeviceId)
+
+		require.NoError(t, err)
+		_, err = testSuite.KeyDirectory.GetIdentityKey(testSuite.TxCtx, user.Id, key.DeviceId)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		count, err := testSuite.KeyDirectory.CountOneTimePrekeys(testSuite.TxCtx, user.Id, key.DeviceId)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.KeyDirectory.DeleteIdentityKey(testSuite.TxCtx, user.Id, gofakeit.UUID())
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestSaveSignedPrekey(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	key := createIdentityKey(t, testSuite, user.Id)
+
+	t.Run("replaces previous prekey", func(t *testing.T) {
+		prekey := helpers.MockSignedPrekey()
+		prekey.UserId = user.Id
+		prekey.DeviceId = key.DeviceId
+		_, err := testSuite.KeyDirectory.SaveSignedPrekey(testSuite.TxCtx, prekey)
+		require.NoError(t, err)
+
+		rotated := helpers.MockSignedPrekey()
+		rotated.UserId = user.Id
+		rotated.DeviceId = key.DeviceId
+		rotated.KeyId = prekey.KeyId + 1
+		_, err = testSuite.KeyDirectory.SaveSignedPrekey(testSuite.TxCtx, rotated)
+		require.NoError(t, err)
+
+		current, err := testSuite.KeyDirectory.GetSignedPrekey(testSuite.TxCtx, user.Id, key.DeviceId)
+		require.NoError(t, err)
+		assert.Equal(t, rotated.KeyId, current.KeyId)
+		assert.Equal(t, rotated.PublicKey, current.PublicKey)
+		assert.Equal(t, rotated.Signature, current.Signature)
+	})
+
+	t.Run("unknown device", func(t *testing.T) {
+		prekey := helpers.MockSignedPrekey()
+		prekey.UserId = user.Id
+		prekey.DeviceId = gofakeit.UUID()
+		_, err := testSuite.KeyDirectory.SaveSignedPrekey(testSuite.TxCtx, prekey)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestCreateOneTimePrekeys(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	key := createIdentityKey(t, testSuite, user.Id)
+	createOneTimePrekeys(t, testSuite, key, 1, 2, 3)
+
+	t.Run("success", func(t *testing.T) {
+		count, err := testSuite.KeyDirectory.CountOneTimePrekeys(testSuite.TxCtx, user.Id, key.DeviceId)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("key id already taken", func(t *testing.T) {
+		err := testSuite.KeyDirectory.CreateOneTimePrekeys(testSuite.TxCtx, []entity.OneTimePrekey{
+			{UserId: user.Id, DeviceId: key.DeviceId, KeyId: 3, PublicKey: gofakeit.LetterN(43)},
+		})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+}
+
+func TestConsumeOneTimePrekey(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	key := createIdentityKey(t, testSuite, user.Id)
+	createOneTimePrekeys(t, testSuite, key, 7, 5)
+
+	first, err := testSuite.KeyDirectory.ConsumeOneTimePrekey(testSuite.TxCtx, user.Id, key.DeviceId)
+	require.NoError(t, err)
+	assert.Equal(t, 5, first.KeyId)
+
+	second, err := testSuite.KeyDirectory.ConsumeOneTimePrekey(testSuite.TxCtx, user.Id, key.DeviceId)
+	require.NoError(t, err)
+	assert.Equal(t, 7, second.KeyId)
+
+	_, err = testSuite.KeyDirectory.ConsumeOneTimePrekey(testSuite.TxCtx, user.Id, key.DeviceId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
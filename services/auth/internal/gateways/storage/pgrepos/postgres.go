@@ -10,11 +10,33 @@ import (
 )
 
 type Repositories struct {
-	Users *UsersRepo
+	Users          *UsersRepo
+	AuthSessions   *AuthSessionsRepo
+	SecurityEvents *SecurityEventsRepo
+	TrustedDevices *TrustedDevicesRepo
+	AccessTokens   *AccessTokensRepo
+	KeyDirectory   *KeyDirectoryRepo
+	DeletedUsers   *DeletedUsersRepo
+	DataExports    *DataExportsRepo
+	AdminActions   *AdminActionsRepo
+	Roles          *RolesRepo
+	Invites        *InvitesRepo
 }
 
 func New(pg *postgres.Postgres) *Repositories {
-	return &Repositories{Users: &UsersRepo{pg}}
+	return &Repositories{
+		Users:          &UsersRepo{pg},
+		AuthSessions:   &AuthSessionsRepo{pg},
+		SecurityEvents: &SecurityEventsRepo{pg},
+		TrustedDevices: &TrustedDevicesRepo{pg},
+		AccessTokens:   &AccessTokensRepo{pg},
+		KeyDirectory:   &KeyDirectoryRepo{pg},
+		DeletedUsers:   &DeletedUsersRepo{pg},
+		DataExports:    &DataExportsRepo{pg},
+		AdminActions:   &AdminActionsRepo{pg},
+		Roles:          &RolesRepo{pg},
+		Invites:        &InvitesRepo{pg},
+	}
 }
 
 type TestSuite struct {
@@ -0,0 +1,131 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+	"github.com/modulix-systems/goose-talk/rbac"
+)
+
+type RolesRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *RolesRepo) selectRoles() squirrel.SelectBuilder {
+	return repo.Builder.Select(
+		"role.name",
+		"role.description",
+		"role.created_at",
+		`COALESCE(
+			array_agg(role_permission.permission ORDER BY role_permission.permission)
+				FILTER (WHERE role_permission.permission IS NOT NULL),
+			'{}'
+		) AS permissions`,
+	).From("role").
+		LeftJoin("role_permission ON role_permission.role_name = role.name").
+		GroupBy("role.name").
+		OrderBy("role.name")
+}
+
+// Save creates role or updates description of existing one, permissions of role are replaced with given ones
+func (repo *RolesRepo) Save(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	permissions := make([]string, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = string(permission)
+	}
+	qb := repo.Builder.Select("saved.name", "saved.description", "saved.created_at").
+		Prefix(
+			`WITH saved AS (
+				INSERT INTO role(name, description) VALUES (?, ?)
+				ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description
+				RETURNING name, description, created_at
+			),
+			removed AS (
+				DELETE FROM role_permission WHERE role_name = ? AND NOT permission = ANY(?::varchar[])
+			),
+			added AS (
+				INSERT INTO role_permission(role_name, permission)
+				SELECT ?, unnest(?::varchar[])
+				ON CONFLICT DO NOTHING
+			)`,
+			role.Name, role.Description, role.Name, permissions, role.Name, permissions,
+		).
+		From("saved")
+	saved, err := postgres.ExecAndGetOne[entity.Role](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		return nil, err
+	}
+	saved.Permissions = role.Permissions
+	return saved, nil
+}
+
+func (repo *RolesRepo) GetAll(ctx context.Context) ([]entity.Role, error) {
+	return postgres.ExecAndGetMany[entity.Role](ctx, repo.selectRoles(), repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *RolesRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.Role, error) {
+	query := repo.selectRoles().
+		Join("user_role ON user_role.role_name = role.name").
+		Where(squirrel.Eq{"user_role.user_id": userId})
+	return postgres.ExecAndGetMany[entity.Role](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *RolesRepo) DeleteByName(ctx context.Context, name string) error {
+	qb := repo.Builder.Delete("role").Where(squirrel.Eq{"name": name})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// AssignToUser returns storage.ErrNotFound if user or role does not exist
+// and storage.ErrAlreadyExists if user already has the role
+func (repo *RolesRepo) AssignToUser(ctx context.Context, userId int, roleName string, grantedBy int) error {
+	var grantedById any
+	if grantedBy != 0 {
+		grantedById = grantedBy
+	}
+	qb := repo.Builder.Insert("user_role").
+		Columns("user_id", "role_name", "granted_by").
+		Values(userId, roleName, grantedById)
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (repo *RolesRepo) RevokeFromUser(ctx context.Context, userId int, roleName string) error {
+	qb := repo.Builder.Delete("user_role").Where(squirrel.Eq{"user_id": userId, "role_name": roleName})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// GetPermissionsByUserId returns distinct permissions granted to user by all of their roles
+func (repo *RolesRepo) GetPermissionsByUserId(ctx context.Context, userId int) ([]rbac.Permission, error) {
+	query := repo.Builder.Select("DISTINCT role_permission.permission").From("role_permission").
+		Join("user_role ON user_role.role_name = role_permission.role_name").
+		Where(squirrel.Eq{"user_role.user_id": userId}).
+		OrderBy("role_permission.permission")
+	return postgres.ExecAndGetMany(ctx, query, repo.Pool, pgx.RowTo[rbac.Permission], repo.TransactionCtxKey)
+}
@@ -0,0 +1,162 @@
+package pgrepos_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/rbac"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRole(t *testing.T, testSuite *pgrepos.TestSuite, permissions ...rbac.Permission) *entity.Role {
+	t.Helper()
+	role, err := testSuite.Roles.Save(testSuite.TxCtx, &entity.Role{
+		Name:        "role_" + gofakeit.LetterN(10),
+		Description: gofakeit.Sentence(5),
+		Permissions: permissions,
+	})
+	require.NoError(t, err)
+	return role
+}
+
+func findRole(roles []entity.Role, name string) *entity.Role {
+	for _, role := range roles {
+		if role.Name == name {
+			return &role
+		}
+	}
+	return nil
+}
+
+func TestSaveRole(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+
+	t.Run("create", func(t *testing.T) {
+		role := createRole(t, testSuite, "chats:read", "chats:write")
+
+		roles, err := testSuite.Roles.GetAll(testSuite.TxCtx)
+		require.NoError(t, err)
+		saved := findRole(roles, role.Name)
+		require.NotNil(t, saved)
+		assert.Equal(t, role.Description, saved.Description)
+		assert.Equal(t, []rbac.Permission{"chats:read", "chats:write"}, saved.Permissions)
+	})
+
+	t.Run("replace permissions", func(t *testing.T) {
+		role := createRole(t, testSuite, "chats:read", "chats:write")
+		role.Description = gofakeit.Sentence(3)
+		role.Permissions = []rbac.Permission{"chats:write", "chats:moderate"}
+
+		_, err := testSuite.Roles.Save(testSuite.TxCtx, role)
+		require.NoError(t, err)
+
+		roles, err := testSuite.Roles.GetAll(testSuite.TxCtx)
+		require.NoError(t, err)
+		saved := findRole(roles, role.Name)
+		require.NotNil(t, saved)
+		assert.Equal(t, role.Description, saved.Description)
+		assert.Equal(t, []rbac.Permission{"chats:moderate", "chats:write"}, saved.Permissions)
+	})
+
+	t.Run("without permissions", func(t *testing.T) {
+		role := createRole(t, testSuite)
+
+		roles, err := testSuite.Roles.GetAll(testSuite.TxCtx)
+		require.NoError(t, err)
+		saved := findRole(roles, role.Name)
+		require.NotNil(t, saved)
+		assert.Empty(t, saved.Permissions)
+	})
+}
+
+func TestDeleteRoleByName(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	role := createRole(t, testSuite, "chats:read")
+	require.NoError(t, testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, role.Name, 0))
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Roles.DeleteByName(testSuite.TxCtx, role.Name)
+		require.NoError(t, err)
+		permissions, err := testSuite.Roles.GetPermissionsByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Empty(t, permissions)
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.Roles.DeleteByName(testSuite.TxCtx, role.Name)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestAssignRoleToUser(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	admin, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	role := createRole(t, testSuite, "chats:read")
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, role.Name, admin.Id)
+		require.NoError(t, err)
+		roles, err := testSuite.Roles.GetAllByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, role.Name, roles[0].Name)
+		assert.Equal(t, role.Permissions, roles[0].Permissions)
+	})
+	t.Run("already assigned", func(t *testing.T) {
+		err := testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, role.Name, admin.Id)
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+	t.Run("role not found", func(t *testing.T) {
+		err := testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, gofakeit.UUID(), admin.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+	t.Run("user not found", func(t *testing.T) {
+		err := testSuite.Roles.AssignToUser(testSuite.TxCtx, -1, role.Name, admin.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestRevokeRoleFromUser(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	role := createRole(t, testSuite, "chats:read")
+	require.NoError(t, testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, role.Name, 0))
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Roles.RevokeFromUser(testSuite.TxCtx, user.Id, role.Name)
+		require.NoError(t, err)
+		roles, err := testSuite.Roles.GetAllByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Empty(t, roles)
+	})
+	t.Run("not assigned", func(t *testing.T) {
+		err := testSuite.Roles.RevokeFromUser(testSuite.TxCtx, user.Id, role.Name)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetPermissionsByUserId(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	moderator := createRole(t, testSuite, "chats:read", "chats:moderate")
+	support := createRole(t, testSuite, "chats:read", entity.PERMISSION_USERS_READ)
+	createRole(t, testSuite, "chats:delete")
+	require.NoError(t, testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, moderator.Name, 0))
+	require.NoError(t, testSuite.Roles.AssignToUser(testSuite.TxCtx, user.Id, support.Name, 0))
+
+	permissions, err := testSuite.Roles.GetPermissionsByUserId(testSuite.TxCtx, user.Id)
+
+	require.NoError(t, err)
+	assert.Equal(t, []rbac.Permission{"chats:moderate", "chats:read", entity.PERMISSION_USERS_READ}, permissions)
+}
@@ -0,0 +1,81 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+// SecurityEventsRepo is an append-only audit log, events are never updated or removed
+type SecurityEventsRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *SecurityEventsRepo) Create(ctx context.Context, event *entity.SecurityEvent) (*entity.SecurityEvent, error) {
+	details := event.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+	var userId any
+	if event.UserId != 0 {
+		userId = event.UserId
+	}
+	var ipAddr any
+	if event.IpAddr != "" {
+		ipAddr = event.IpAddr
+	}
+
+	qb := repo.Builder.Insert("security_event").
+		Columns("user_id", "type", "ip_addr", "device_info", "location", "details").
+		Values(userId, event.Type, ipAddr, event.DeviceInfo, event.Location, details).
+		Suffix("RETURNING id, created_at")
+	created, err := postgres.ExecAndGetOne[entity.SecurityEvent](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	newEvent := *event
+	newEvent.Details = details
+	newEvent.Id = created.Id
+	newEvent.CreatedAt = created.CreatedAt
+	return &newEvent, nil
+}
+
+func (repo *SecurityEventsRepo) GetMany(ctx context.Context, filter *dtos.SecurityEventsFilter) ([]entity.SecurityEvent, error) {
+	query := repo.Builder.Select(
+		"id",
+		"COALESCE(user_id, 0) AS user_id",
+		"type",
+		"COALESCE(host(ip_addr), '') AS ip_addr",
+		"device_info",
+		"location",
+		"details",
+		"created_at",
+	).From("security_event").OrderBy("id DESC")
+
+	if filter.UserId != 0 {
+		query = query.Where(squirrel.Eq{"user_id": filter.UserId})
+	}
+	if filter.IpAddr != "" {
+		query = query.Where("ip_addr = ?::inet", filter.IpAddr)
+	}
+	if len(filter.Types) > 0 {
+		query = query.Where(squirrel.Eq{"type": filter.Types})
+	}
+	if filter.BeforeId != 0 {
+		query = query.Where(squirrel.Lt{"id": filter.BeforeId})
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	return postgres.ExecAndGetMany[entity.SecurityEvent](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
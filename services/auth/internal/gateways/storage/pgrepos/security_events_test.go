@@ -0,0 +1,118 @@
+package pgrepos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSecurityEvent(t *testing.T, testSuite *pgrepos.TestSuite, event *entity.SecurityEvent) *entity.SecurityEvent {
+	t.Helper()
+	event, err := testSuite.SecurityEvents.Create(testSuite.TxCtx, event)
+	require.NoError(t, err)
+	return event
+}
+
+func TestCreateSecurityEvent(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		event := helpers.MockSecurityEvent()
+		event.UserId = user.Id
+
+		newEvent, err := testSuite.SecurityEvents.Create(testSuite.TxCtx, event)
+
+		require.NoError(t, err)
+		assert.NotZero(t, newEvent.Id)
+		assert.WithinDuration(t, time.Now(), newEvent.CreatedAt, time.Second)
+		foundEvents, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{UserId: user.Id})
+		require.NoError(t, err)
+		require.Len(t, foundEvents, 1)
+		assert.Equal(t, newEvent.Id, foundEvents[0].Id)
+		assert.Equal(t, event.IpAddr, foundEvents[0].IpAddr)
+		assert.Equal(t, event.Details, foundEvents[0].Details)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		event := helpers.MockSecurityEvent()
+		event.Type = entity.SECURITY_EVENT_SIGN_IN_FAILED
+		event.IpAddr = ""
+
+		newEvent, err := testSuite.SecurityEvents.Create(testSuite.TxCtx, event)
+
+		require.NoError(t, err)
+		assert.Zero(t, newEvent.UserId)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		event := helpers.MockSecurityEvent()
+		event.UserId = -1
+		_, err := testSuite.SecurityEvents.Create(testSuite.TxCtx, event)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetSecurityEvents(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	ip := "10.10.10.10"
+	signInEvent := helpers.MockSecurityEvent()
+	signInEvent.UserId = user.Id
+	signInEvent.IpAddr = ip
+	signInEvent = createSecurityEvent(t, testSuite, signInEvent)
+	failedEvent := helpers.MockSecurityEvent()
+	failedEvent.Type = entity.SECURITY_EVENT_SIGN_IN_FAILED
+	failedEvent.IpAddr = ip
+	failedEvent = createSecurityEvent(t, testSuite, failedEvent)
+	revokedEvent := helpers.MockSecurityEvent()
+	revokedEvent.UserId = user.Id
+	revokedEvent.Type = entity.SECURITY_EVENT_SESSION_REVOKED
+	revokedEvent = createSecurityEvent(t, testSuite, revokedEvent)
+
+	t.Run("by user newest first", func(t *testing.T) {
+		events, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{UserId: user.Id})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, revokedEvent.Id, events[0].Id)
+		assert.Equal(t, signInEvent.Id, events[1].Id)
+	})
+
+	t.Run("by ip", func(t *testing.T) {
+		events, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{IpAddr: ip})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, failedEvent.Id, events[0].Id)
+		assert.Equal(t, signInEvent.Id, events[1].Id)
+	})
+
+	t.Run("by type", func(t *testing.T) {
+		events, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{
+			IpAddr: ip,
+			Types:  []entity.SecurityEventType{entity.SECURITY_EVENT_SIGN_IN_FAILED},
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, failedEvent.Id, events[0].Id)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		events, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{
+			UserId:   user.Id,
+			BeforeId: revokedEvent.Id,
+			Limit:    1,
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, signInEvent.Id, events[0].Id)
+	})
+}
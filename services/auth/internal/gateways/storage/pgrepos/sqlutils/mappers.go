@@ -38,5 +38,12 @@ func RowToUser(row pgx.CollectableRow) (entity.User, error) {
 	if err := json.Unmarshal(userJson, &user); err != nil {
 		return user, err
 	}
+	// fields hidden from json are assigned directly
+	if password, ok := userData[userColPrefix+".password"].([]byte); ok {
+		user.Password = password
+	}
+	if privateKey, ok := userData[userColPrefix+".private_key"].(string); ok {
+		user.PrivateKey = privateKey
+	}
 	return user, nil
 }
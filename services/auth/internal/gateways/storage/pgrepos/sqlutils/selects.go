@@ -32,7 +32,8 @@ func getUserSelectCols() []string {
 	colsList := make([]string, 0, userType.NumField()+1)
 	for i := 0; i < userType.NumField(); i++ {
 		field := userType.Field(i)
-		if !utils.IsScalarType(field.Type) {
+		// byte slices are stored as bytea, so they are selected as is
+		if !utils.IsScalarType(field.Type) && field.Type != reflect.TypeFor[[]byte]() {
 			fieldType := field.Type
 			if fieldType.Kind() == reflect.Pointer {
 				fieldType = fieldType.Elem()
@@ -0,0 +1,87 @@
+package pgrepos
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/postgres"
+)
+
+type TrustedDevicesRepo struct {
+	*postgres.Postgres
+}
+
+func (repo *TrustedDevicesRepo) selectActive() squirrel.SelectBuilder {
+	return repo.Builder.Select("*").From("trusted_device").Where("expires_at > now()")
+}
+
+func (repo *TrustedDevicesRepo) Create(ctx context.Context, device *entity.TrustedDevice) (*entity.TrustedDevice, error) {
+	qb := repo.Builder.Insert("trusted_device").
+		Columns("user_id", "token_hash", "label", "expires_at").
+		Values(device.UserId, device.TokenHash, device.Label, device.ExpiresAt).
+		Suffix("RETURNING *")
+	newDevice, err := postgres.ExecAndGetOne[entity.TrustedDevice](ctx, qb, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrForeignKeyViolation) {
+			return nil, storage.ErrNotFound
+		}
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, storage.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return newDevice, nil
+}
+
+func (repo *TrustedDevicesRepo) GetByTokenHash(ctx context.Context, userId int, tokenHash string) (*entity.TrustedDevice, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"user_id": userId, "token_hash": tokenHash})
+	device, err := postgres.ExecAndGetOne[entity.TrustedDevice](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return device, nil
+}
+
+func (repo *TrustedDevicesRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.TrustedDevice, error) {
+	query := repo.selectActive().Where(squirrel.Eq{"user_id": userId}).OrderBy("last_used_at DESC")
+	return postgres.ExecAndGetMany[entity.TrustedDevice](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
+}
+
+func (repo *TrustedDevicesRepo) UpdateLastUsedAt(ctx context.Context, deviceId int, lastUsedAt time.Time) error {
+	qb := repo.Builder.Update("trusted_device").Set("last_used_at", lastUsedAt).Where(squirrel.Eq{"id": deviceId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *TrustedDevicesRepo) DeleteById(ctx context.Context, userId int, deviceId int) error {
+	qb := repo.Builder.Delete("trusted_device").Where(squirrel.Eq{"user_id": userId, "id": deviceId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *TrustedDevicesRepo) DeleteAllByUserId(ctx context.Context, userId int) error {
+	qb := repo.Builder.Delete("trusted_device").Where(squirrel.Eq{"user_id": userId})
+	if _, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey); err != nil {
+		return err
+	}
+	return nil
+}
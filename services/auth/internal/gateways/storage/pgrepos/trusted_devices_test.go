@@ -0,0 +1,141 @@
+package pgrepos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTrustedDevice(t *testing.T, testSuite *pgrepos.TestSuite, userId int) *entity.TrustedDevice {
+	t.Helper()
+	device := helpers.MockTrustedDevice()
+	device.UserId = userId
+	device, err := testSuite.TrustedDevices.Create(testSuite.TxCtx, device)
+	require.NoError(t, err)
+	return device
+}
+
+func TestCreateTrustedDevice(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		device := helpers.MockTrustedDevice()
+		device.UserId = user.Id
+
+		newDevice, err := testSuite.TrustedDevices.Create(testSuite.TxCtx, device)
+
+		require.NoError(t, err)
+		assert.NotZero(t, newDevice.Id)
+		assert.Equal(t, device.TokenHash, newDevice.TokenHash)
+		assert.Equal(t, device.Label, newDevice.Label)
+		assert.WithinDuration(t, device.ExpiresAt, newDevice.ExpiresAt, time.Second)
+		assert.WithinDuration(t, time.Now(), newDevice.CreatedAt, time.Second)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		device := helpers.MockTrustedDevice()
+		device.UserId = -1
+		_, err := testSuite.TrustedDevices.Create(testSuite.TxCtx, device)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetTrustedDeviceByTokenHash(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	device := createTrustedDevice(t, testSuite, user.Id)
+
+	t.Run("success", func(t *testing.T) {
+		foundDevice, err := testSuite.TrustedDevices.GetByTokenHash(testSuite.TxCtx, user.Id, device.TokenHash)
+		require.NoError(t, err)
+		assert.Equal(t, device.Id, foundDevice.Id)
+	})
+
+	t.Run("belongs to another user", func(t *testing.T) {
+		_, err := testSuite.TrustedDevices.GetByTokenHash(testSuite.TxCtx, user.Id+1, device.TokenHash)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expiredDevice := helpers.MockTrustedDevice()
+		expiredDevice.UserId = user.Id
+		expiredDevice.ExpiresAt = time.Now().Add(-time.Minute)
+		expiredDevice, err := testSuite.TrustedDevices.Create(testSuite.TxCtx, expiredDevice)
+		require.NoError(t, err)
+
+		_, err = testSuite.TrustedDevices.GetByTokenHash(testSuite.TxCtx, user.Id, expiredDevice.TokenHash)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetAllTrustedDevicesByUserId(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	createTrustedDevice(t, testSuite, user.Id)
+	createTrustedDevice(t, testSuite, user.Id)
+
+	devices, err := testSuite.TrustedDevices.GetAllByUserId(testSuite.TxCtx, user.Id)
+
+	require.NoError(t, err)
+	assert.Len(t, devices, 2)
+}
+
+func TestUpdateTrustedDeviceLastUsedAt(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	device := createTrustedDevice(t, testSuite, user.Id)
+	lastUsedAt := time.Now().Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.TrustedDevices.UpdateLastUsedAt(testSuite.TxCtx, device.Id, lastUsedAt)
+		require.NoError(t, err)
+		foundDevice, err := testSuite.TrustedDevices.GetByTokenHash(testSuite.TxCtx, user.Id, device.TokenHash)
+		require.NoError(t, err)
+		assert.WithinDuration(t, lastUsedAt, foundDevice.LastUsedAt, time.Millisecond)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.TrustedDevices.UpdateLastUsedAt(testSuite.TxCtx, -1, lastUsedAt)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestDeleteTrustedDevice(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("by id", func(t *testing.T) {
+		device := createTrustedDevice(t, testSuite, user.Id)
+		err := testSuite.TrustedDevices.DeleteById(testSuite.TxCtx, user.Id, device.Id)
+		require.NoError(t, err)
+		_, err = testSuite.TrustedDevices.GetByTokenHash(testSuite.TxCtx, user.Id, device.TokenHash)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("by id not found", func(t *testing.T) {
+		err := testSuite.TrustedDevices.DeleteById(testSuite.TxCtx, user.Id, -1)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("all by user id", func(t *testing.T) {
+		createTrustedDevice(t, testSuite, user.Id)
+		createTrustedDevice(t, testSuite, user.Id)
+		err := testSuite.TrustedDevices.DeleteAllByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		devices, err := testSuite.TrustedDevices.GetAllByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Empty(t, devices)
+	})
+}
@@ -3,6 +3,9 @@ package pgrepos
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -16,10 +19,15 @@ type UsersRepo struct {
 	*postgres.Postgres
 }
 
+// Save creates account in active state unless other state is given
 func (repo *UsersRepo) Save(ctx context.Context, user *entity.User) (*entity.User, error) {
+	state := user.State
+	if state == "" {
+		state = entity.ACCOUNT_STATE_ACTIVE
+	}
 	query := repo.Builder.Insert(`"user"`).
-		Columns("username", "password", "email", "first_name", "last_name", "photo_url", "birth_date", "about_me", "is_active", "private_key").
-		Values(user.Username, user.Password, user.Email, user.FirstName, user.LastName, user.PhotoUrl, user.BirthDate, user.AboutMe, user.IsActive, user.PrivateKey).
+		Columns("username", "password", "email", "first_name", "last_name", "photo_url", "birth_date", "about_me", "state", "private_key").
+		Values(user.Username, user.Password, user.Email, user.FirstName, user.LastName, user.PhotoUrl, user.BirthDate, user.AboutMe, state, user.PrivateKey).
 		Suffix("RETURNING *")
 	savedUser, err := postgres.ExecAndGetOne[entity.User](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
 	if err != nil {
@@ -98,9 +106,46 @@ func (repo *UsersRepo) GetByID(ctx context.Context, id int) (*entity.User, error
 	return user, nil
 }
 
-func (repo *UsersRepo) UpdateIsActiveById(ctx context.Context, userId int, isActive bool) (*entity.User, error) {
-	query := repo.Builder.Update(`"user"`).Set("is_active", isActive).
-		Where(squirrel.Eq{"id": userId}).Suffix("RETURNING *")
+// Search finds users by exact id or by email or username prefix, ordered by id
+func (repo *UsersRepo) Search(ctx context.Context, query string, limit int) ([]entity.User, error) {
+	prefix := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(query) + "%"
+	matches := squirrel.Or{squirrel.ILike{"email": prefix}, squirrel.ILike{"username": prefix}}
+	if id, err := strconv.Atoi(query); err == nil {
+		matches = append(matches, squirrel.Eq{`"user".id`: id})
+	}
+	qb := repo.Builder.Select(sqlutils.UserSelect).From(`"user"`).
+		LeftJoin(`two_factor_auth ON two_factor_auth.user_id="user".id`).
+		Where(matches).
+		OrderBy(`"user".id`).
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany(ctx, qb, repo.Pool, sqlutils.RowToUser, repo.TransactionCtxKey)
+}
+
+// stateTransitionAllowed matches users whose account may be moved to target state
+func stateTransitionAllowed(target entity.AccountState) squirrel.Sqlizer {
+	allowedFrom := squirrel.Or{squirrel.Eq{"state": entity.AccountStatesFrom(target)}}
+	if entity.ACCOUNT_STATE_ACTIVE.CanTransitionTo(target) {
+		allowedFrom = append(allowedFrom, squirrel.And{
+			squirrel.Eq{"state": []entity.AccountState{entity.ACCOUNT_STATE_LOCKED, entity.ACCOUNT_STATE_SUSPENDED}},
+			squirrel.Expr("state_until <= now()"),
+		})
+	}
+	return allowedFrom
+}
+
+// UpdateStateById moves account to new state if transition from its current state is allowed.
+// Expired temporary state is treated as active one. Returns storage.ErrNotFound if user does not exist
+// or transition is not allowed, so concurrent transitions never override each other
+func (repo *UsersRepo) UpdateStateById(ctx context.Context, userId int, change *entity.AccountStateChange) (*entity.User, error) {
+	query := repo.Builder.Update(`"user"`).
+		Set("state", change.State).
+		Set("state_reason", change.Reason).
+		Set("state_until", change.Until).
+		Set("state_changed_at", squirrel.Expr("now()")).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": userId}).
+		Where(stateTransitionAllowed(change.State)).
+		Suffix("RETURNING *")
 	user, err := postgres.ExecAndGetOne[entity.User](ctx, query, repo.Pool, nil, repo.TransactionCtxKey)
 	if err != nil {
 		if errors.Is(err, postgres.ErrNoRows) {
@@ -111,6 +156,36 @@ func (repo *UsersRepo) UpdateIsActiveById(ctx context.Context, userId int, isAct
 	return user, nil
 }
 
+func (repo *UsersRepo) UpdateMustResetPasswordById(ctx context.Context, userId int, mustReset bool) error {
+	qb := repo.Builder.Update(`"user"`).Set("must_reset_password", mustReset).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": userId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// UpdatePasswordById replaces password hash and lifts pending reset requirement
+func (repo *UsersRepo) UpdatePasswordById(ctx context.Context, userId int, password []byte) error {
+	qb := repo.Builder.Update(`"user"`).Set("password", password).
+		Set("must_reset_password", false).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": userId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
 func (repo *UsersRepo) CreatePasskeyCredential(ctx context.Context, userId int, cred *entity.PasskeyCredential) error {
 	qb := repo.Builder.Insert(`"passkey_credential"`).
 		Columns("id", "public_key", "user_id", "transports", "backed_up").
@@ -170,3 +245,181 @@ func (repo *UsersRepo) UpdateTwoFaContact(ctx context.Context, userId int, conta
 
 	return nil
 }
+
+func (repo *UsersRepo) DeleteTwoFaByUserId(ctx context.Context, userId int) error {
+	qb := repo.Builder.Delete("two_factor_auth").Where(squirrel.Eq{"user_id": userId})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *UsersRepo) GetByTwoFaContact(ctx context.Context, method entity.TwoFaMethod, contact string) (*entity.User, error) {
+	query := repo.Builder.Select(sqlutils.UserSelect).From(`"user"`).
+		Join(`two_factor_auth ON two_factor_auth.user_id="user".id`).
+		Where(squirrel.Eq{"two_factor_auth.transport": method, "two_factor_auth.contact": contact, "two_factor_auth.enabled": true})
+	user, err := postgres.ExecAndGetOne(ctx, query, repo.Pool, sqlutils.RowToUser, repo.TransactionCtxKey)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetAllWithTotpSecret returns up to limit users having TOTP secret with id greater than afterUserId ordered by id
+func (repo *UsersRepo) GetAllWithTotpSecret(ctx context.Context, afterUserId int, limit int) ([]entity.User, error) {
+	query := repo.Builder.Select(sqlutils.UserSelect).From(`"user"`).
+		Join(`two_factor_auth ON two_factor_auth.user_id="user".id`).
+		Where(squirrel.Gt{`"user".id`: afterUserId}).
+		Where("length(two_factor_auth.totp_secret) > 0").
+		OrderBy(`"user".id`).
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany(ctx, query, repo.Pool, sqlutils.RowToUser, repo.TransactionCtxKey)
+}
+
+// ReplaceTotpSecret updates TOTP secret only if it was not changed since current was read.
+// Legacy private key is dropped together with the secret it was used to decrypt
+func (repo *UsersRepo) ReplaceTotpSecret(ctx context.Context, userId int, current []byte, replacement []byte) error {
+	qb := repo.Builder.Update(`"user"`).
+		Prefix(
+			`WITH replaced AS (
+				UPDATE two_factor_auth SET totp_secret = ? WHERE user_id = ? AND totp_secret = ? RETURNING user_id
+			)`,
+			replacement, userId, current,
+		).
+		Set("private_key", "").
+		Where("id IN (SELECT user_id FROM replaced)")
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// ScheduleDeletionById sets the moment account will be erased at unless deletion is already pending
+func (repo *UsersRepo) ScheduleDeletionById(ctx context.Context, userId int, scheduledAt time.Time) error {
+	qb := repo.Builder.Update(`"user"`).Set("deletion_scheduled_at", scheduledAt).
+		Set("deletion_reminder_sent_at", nil).
+		Set("state", entity.ACCOUNT_STATE_PENDING_DELETION).
+		Set("state_reason", "").
+		Set("state_changed_at", squirrel.Expr("now()")).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": userId, "deletion_scheduled_at": nil}).
+		Where(stateTransitionAllowed(entity.ACCOUNT_STATE_PENDING_DELETION))
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *UsersRepo) CancelDeletionById(ctx context.Context, userId int) error {
+	qb := repo.Builder.Update(`"user"`).Set("deletion_scheduled_at", nil).
+		Set("deletion_reminder_sent_at", nil).
+		// account could be suspended by admin while deletion was pending, such state is kept
+		Set("state", squirrel.Expr("CASE WHEN state = ? THEN ? ELSE state END", entity.ACCOUNT_STATE_PENDING_DELETION, entity.ACCOUNT_STATE_ACTIVE)).
+		Set("state_changed_at", squirrel.Expr("CASE WHEN state = ? THEN now() ELSE state_changed_at END", entity.ACCOUNT_STATE_PENDING_DELETION)).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.NotEq{"deletion_scheduled_at": nil})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// GetAllPendingDeletionReminder returns up to limit users whose accounts are going to be erased
+// not later than scheduledBefore and who were not reminded about it yet
+func (repo *UsersRepo) GetAllPendingDeletionReminder(ctx context.Context, scheduledBefore time.Time, limit int) ([]entity.User, error) {
+	query := repo.Builder.Select(sqlutils.UserSelect).From(`"user"`).
+		LeftJoin(`two_factor_auth ON two_factor_auth.user_id="user".id`).
+		Where(squirrel.LtOrEq{`"user".deletion_scheduled_at`: scheduledBefore}).
+		Where(`"user".deletion_scheduled_at > now()`).
+		Where(squirrel.Eq{`"user".deletion_reminder_sent_at`: nil}).
+		OrderBy(`"user".deletion_scheduled_at`).
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany(ctx, query, repo.Pool, sqlutils.RowToUser, repo.TransactionCtxKey)
+}
+
+func (repo *UsersRepo) UpdateDeletionReminderSentAtById(ctx context.Context, userId int, sentAt time.Time) error {
+	qb := repo.Builder.Update(`"user"`).Set("deletion_reminder_sent_at", sentAt).
+		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.NotEq{"deletion_scheduled_at": nil})
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// GetAllDueForDeletion returns up to limit users whose grace period is over
+func (repo *UsersRepo) GetAllDueForDeletion(ctx context.Context, limit int) ([]entity.User, error) {
+	query := repo.Builder.Select(sqlutils.UserSelect).From(`"user"`).
+		LeftJoin(`two_factor_auth ON two_factor_auth.user_id="user".id`).
+		Where(`"user".deletion_scheduled_at <= now()`).
+		OrderBy(`"user".deletion_scheduled_at`).
+		Limit(uint64(limit))
+	return postgres.ExecAndGetMany(ctx, query, repo.Pool, sqlutils.RowToUser, repo.TransactionCtxKey)
+}
+
+// EraseById removes account and everything tied to it in a single statement, leaving only a tombstone.
+// Security events are kept for audit, but detached from account and scrubbed of client metadata and details,
+// which hold login, session ids and other personal data. Failed sign ins with account's login are scrubbed as well.
+// Account is erased only if its deletion is due, so deletion cancelled concurrently wins
+func (repo *UsersRepo) EraseById(ctx context.Context, userId int) error {
+	qb := repo.Builder.Delete(`"user"`).
+		Prefix(
+			`WITH target AS (
+				SELECT id, email, username FROM "user" WHERE id = ? AND deletion_scheduled_at <= now() FOR UPDATE
+			),
+			sessions AS (
+				DELETE FROM user_session WHERE user_id IN (SELECT id FROM target) RETURNING client_identity_id
+			),
+			identities AS (
+				DELETE FROM client_identity WHERE id IN (SELECT client_identity_id FROM sessions)
+			),
+			two_fa AS (
+				DELETE FROM two_factor_auth WHERE user_id IN (SELECT id FROM target)
+			),
+			passkeys AS (
+				DELETE FROM passkey_credential WHERE user_id IN (SELECT id FROM target)
+			),
+			events AS (
+				UPDATE security_event SET user_id = NULL, ip_addr = NULL, device_info = '', location = '', details = '{}'
+				WHERE user_id IN (SELECT id FROM target)
+					OR lower(details->>'login') IN (SELECT lower(email::text) FROM target UNION ALL SELECT lower(username) FROM target)
+			),
+			tombstone AS (
+				INSERT INTO deleted_user(user_id) SELECT id FROM target
+			)`,
+			userId,
+		).
+		Where("id IN (SELECT id FROM target)")
+	tag, err := postgres.Exec(ctx, qb, repo.Pool, repo.TransactionCtxKey)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package pgrepos_test
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
 	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/pgrepos"
@@ -22,7 +24,7 @@ func TestInsertUser(t *testing.T) {
 		assert.WithinDuration(t, time.Now(), insertedUser.CreatedAt, time.Second)
 		assert.WithinDuration(t, time.Now(), insertedUser.UpdatedAt, time.Second)
 		assert.Equal(t, expectedUser.Email, insertedUser.Email)
-		assert.Equal(t, expectedUser.IsActive, insertedUser.IsActive)
+		assert.Equal(t, expectedUser.State, insertedUser.State)
 	}
 
 	checkUserInDB := func(expectedUser *entity.User, insertedID int) *entity.User {
@@ -127,24 +129,143 @@ func TestGetByID(t *testing.T) {
 	})
 }
 
-func TestUpdateIsActiveById(t *testing.T) {
+func TestSearch(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	mockUser := helpers.MockUser()
+	mockUser.Username = "search_target_" + gofakeit.LetterN(8)
+	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, mockUser)
+	require.NoError(t, err)
+	t.Run("by username prefix", func(t *testing.T) {
+		users, err := testSuite.Users.Search(testSuite.TxCtx, "SEARCH_TARGET_", 10)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, expectedUser.Id, users[0].Id)
+		assert.NotNil(t, users[0].TwoFactorAuth)
+	})
+	t.Run("by email", func(t *testing.T) {
+		users, err := testSuite.Users.Search(testSuite.TxCtx, expectedUser.Email, 10)
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, expectedUser.Id, users[0].Id)
+	})
+	t.Run("by id", func(t *testing.T) {
+		users, err := testSuite.Users.Search(testSuite.TxCtx, strconv.Itoa(expectedUser.Id), 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, users)
+		assert.Equal(t, expectedUser.Id, users[0].Id)
+	})
+	t.Run("wildcards are escaped", func(t *testing.T) {
+		users, err := testSuite.Users.Search(testSuite.TxCtx, "search%target", 10)
+		require.NoError(t, err)
+		assert.Empty(t, users)
+	})
+}
+
+func TestUpdateStateById(t *testing.T) {
 	testSuite := pgrepos.NewTestSuite(t)
 	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
 	require.NoError(t, err)
-	expectedIsActive := gofakeit.Bool()
 	t.Run("success", func(t *testing.T) {
-		user, err := testSuite.Users.UpdateIsActiveById(testSuite.TxCtx, expectedUser.Id, expectedIsActive)
-		assert.NoError(t, err)
+		until := time.Now().Add(time.Hour)
+		user, err := testSuite.Users.UpdateStateById(testSuite.TxCtx, expectedUser.Id, &entity.AccountStateChange{
+			State:  entity.ACCOUNT_STATE_SUSPENDED,
+			Reason: gofakeit.Sentence(3),
+			Until:  &until,
+		})
+		require.NoError(t, err)
 		assert.Equal(t, expectedUser.Id, user.Id)
-		assert.Equal(t, expectedIsActive, user.IsActive)
+		assert.Equal(t, entity.ACCOUNT_STATE_SUSPENDED, user.State)
+		assert.NotEmpty(t, user.StateReason)
+		assert.NotNil(t, user.StateChangedAt)
+		assert.WithinDuration(t, until, *user.StateUntil, time.Second)
+
+		user, err = testSuite.Users.UpdateStateById(
+			testSuite.TxCtx, expectedUser.Id, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_ACTIVE},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ACCOUNT_STATE_ACTIVE, user.State)
+		assert.Empty(t, user.StateReason)
+		assert.Nil(t, user.StateUntil)
+	})
+	t.Run("transition not allowed", func(t *testing.T) {
+		_, err := testSuite.Users.UpdateStateById(
+			testSuite.TxCtx, expectedUser.Id, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_BANNED},
+		)
+		require.NoError(t, err)
+		user, err := testSuite.Users.UpdateStateById(
+			testSuite.TxCtx, expectedUser.Id, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_DEACTIVATED},
+		)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		assert.Nil(t, user)
+	})
+	t.Run("expired lock", func(t *testing.T) {
+		lockedUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+		require.NoError(t, err)
+		until := time.Now().Add(-time.Minute)
+		_, err = testSuite.Users.UpdateStateById(testSuite.TxCtx, lockedUser.Id, &entity.AccountStateChange{
+			State: entity.ACCOUNT_STATE_LOCKED,
+			Until: &until,
+		})
+		require.NoError(t, err)
+		user, err := testSuite.Users.UpdateStateById(
+			testSuite.TxCtx, lockedUser.Id, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_DEACTIVATED},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ACCOUNT_STATE_DEACTIVATED, user.State)
 	})
 	t.Run("not found", func(t *testing.T) {
-		user, err := testSuite.Users.UpdateIsActiveById(testSuite.TxCtx, -1, expectedIsActive)
+		user, err := testSuite.Users.UpdateStateById(
+			testSuite.TxCtx, -1, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_DEACTIVATED},
+		)
 		assert.ErrorIs(t, err, storage.ErrNotFound)
 		assert.Nil(t, user)
 	})
 }
 
+func TestUpdateMustResetPasswordById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Users.UpdateMustResetPasswordById(testSuite.TxCtx, expectedUser.Id, true)
+		require.NoError(t, err)
+		user, err := testSuite.Users.GetByID(testSuite.TxCtx, expectedUser.Id)
+		require.NoError(t, err)
+		assert.True(t, user.MustResetPassword)
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.Users.UpdateMustResetPasswordById(testSuite.TxCtx, -1, true)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestUpdatePasswordById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	err = testSuite.Users.UpdateMustResetPasswordById(testSuite.TxCtx, expectedUser.Id, true)
+	require.NoError(t, err)
+	t.Run("success", func(t *testing.T) {
+		expectedPassword := []byte(gofakeit.Password(true, true, true, false, false, 12))
+		err := testSuite.Users.UpdatePasswordById(testSuite.TxCtx, expectedUser.Id, expectedPassword)
+		require.NoError(t, err)
+		user, err := postgres.ExecAndGetOne[entity.User](
+			testSuite.TxCtx,
+			testSuite.Users.Builder.Select("password", "must_reset_password").From(`"user"`).Where("id = ?", expectedUser.Id),
+			testSuite.Pg.Pool,
+			nil,
+			testSuite.Pg.TransactionCtxKey,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, expectedPassword, user.Password)
+		assert.False(t, user.MustResetPassword)
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.Users.UpdatePasswordById(testSuite.TxCtx, -1, []byte(gofakeit.Password(true, true, true, false, false, 12)))
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
 func assertUserHasCred(t *testing.T, credsList []entity.PasskeyCredential, expectedCredential *entity.PasskeyCredential) {
 	t.Helper()
 	var userHasCred bool
@@ -261,3 +382,234 @@ func TestUpdateTwoFaContact(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedContact, actualTwoFa.Contact)
 }
+
+func TestDeleteTwoFaByUserId(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Users.DeleteTwoFaByUserId(testSuite.TxCtx, expectedUser.Id)
+		require.NoError(t, err)
+		user, err := testSuite.Users.GetByID(testSuite.TxCtx, expectedUser.Id)
+		require.NoError(t, err)
+		assert.False(t, user.Is2FAEnabled())
+	})
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.Users.DeleteTwoFaByUserId(testSuite.TxCtx, expectedUser.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestGetByTwoFaContact(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	mockUser := helpers.MockUser()
+	mockUser.TwoFactorAuth.Method = entity.TWO_FA_TELEGRAM
+	mockUser.TwoFactorAuth.Contact = gofakeit.Numerify("#########")
+	expectedUser, err := testSuite.Users.Save(testSuite.TxCtx, mockUser)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		user, err := testSuite.Users.GetByTwoFaContact(testSuite.TxCtx, entity.TWO_FA_TELEGRAM, mockUser.TwoFactorAuth.Contact)
+		assert.NoError(t, err)
+		require.NotNil(t, user)
+		assert.Equal(t, expectedUser.Id, user.Id)
+	})
+	t.Run("another method", func(t *testing.T) {
+		user, err := testSuite.Users.GetByTwoFaContact(testSuite.TxCtx, entity.TWO_FA_EMAIL, mockUser.TwoFactorAuth.Contact)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		assert.Nil(t, user)
+	})
+}
+
+func TestGetAllWithTotpSecret(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	withSecret, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	withoutSecretMock := helpers.MockUser()
+	withoutSecretMock.TwoFactorAuth.TotpSecret = nil
+	withoutSecret, err := testSuite.Users.Save(testSuite.TxCtx, withoutSecretMock)
+	require.NoError(t, err)
+
+	users, err := testSuite.Users.GetAllWithTotpSecret(testSuite.TxCtx, withSecret.Id-1, 100)
+
+	require.NoError(t, err)
+	ids := []int{}
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	assert.Contains(t, ids, withSecret.Id)
+	assert.NotContains(t, ids, withoutSecret.Id)
+	assert.Equal(t, withSecret.TwoFactorAuth.TotpSecret, users[0].TwoFactorAuth.TotpSecret)
+	assert.Equal(t, withSecret.PrivateKey, users[0].PrivateKey)
+
+	t.Run("after last user", func(t *testing.T) {
+		users, err := testSuite.Users.GetAllWithTotpSecret(testSuite.TxCtx, withSecret.Id, 100)
+		require.NoError(t, err)
+		for _, user := range users {
+			assert.Greater(t, user.Id, withSecret.Id)
+		}
+	})
+}
+
+func TestReplaceTotpSecret(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	replacement := []byte(gofakeit.Sentence(3))
+
+	t.Run("changed concurrently", func(t *testing.T) {
+		err := testSuite.Users.ReplaceTotpSecret(testSuite.TxCtx, user.Id, []byte("stale"), replacement)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Users.ReplaceTotpSecret(testSuite.TxCtx, user.Id, user.TwoFactorAuth.TotpSecret, replacement)
+		require.NoError(t, err)
+		foundUser, err := testSuite.Users.GetByID(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Equal(t, replacement, foundUser.TwoFactorAuth.TotpSecret)
+		assert.Empty(t, foundUser.PrivateKey)
+	})
+}
+
+func TestScheduleDeletionById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	scheduledAt := time.Now().Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, user.Id, scheduledAt)
+		require.NoError(t, err)
+		foundUser, err := testSuite.Users.GetByID(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		require.NotNil(t, foundUser.DeletionScheduledAt)
+		assert.WithinDuration(t, scheduledAt, *foundUser.DeletionScheduledAt, time.Second)
+	})
+
+	t.Run("already scheduled", func(t *testing.T) {
+		err := testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, user.Id, scheduledAt.Add(time.Hour))
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestCancelDeletionById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+
+	t.Run("not scheduled", func(t *testing.T) {
+		err := testSuite.Users.CancelDeletionById(testSuite.TxCtx, user.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, user.Id, time.Now().Add(time.Hour)))
+
+		err := testSuite.Users.CancelDeletionById(testSuite.TxCtx, user.Id)
+
+		require.NoError(t, err)
+		foundUser, err := testSuite.Users.GetByID(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Nil(t, foundUser.DeletionScheduledAt)
+	})
+}
+
+func TestGetAllPendingDeletionReminder(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	soon, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	require.NoError(t, testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, soon.Id, time.Now().Add(time.Hour)))
+	later, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	require.NoError(t, testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, later.Id, time.Now().Add(72*time.Hour)))
+
+	users, err := testSuite.Users.GetAllPendingDeletionReminder(testSuite.TxCtx, time.Now().Add(24*time.Hour), 100)
+
+	require.NoError(t, err)
+	ids := []int{}
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	assert.Contains(t, ids, soon.Id)
+	assert.NotContains(t, ids, later.Id)
+
+	t.Run("already reminded", func(t *testing.T) {
+		require.NoError(t, testSuite.Users.UpdateDeletionReminderSentAtById(testSuite.TxCtx, soon.Id, time.Now()))
+
+		users, err := testSuite.Users.GetAllPendingDeletionReminder(testSuite.TxCtx, time.Now().Add(24*time.Hour), 100)
+
+		require.NoError(t, err)
+		for _, user := range users {
+			assert.NotEqual(t, soon.Id, user.Id)
+		}
+	})
+}
+
+func TestEraseById(t *testing.T) {
+	testSuite := pgrepos.NewTestSuite(t)
+	user, err := testSuite.Users.Save(testSuite.TxCtx, helpers.MockUser())
+	require.NoError(t, err)
+	session := helpers.MockAuthSession()
+	session.UserId = user.Id
+	_, err = testSuite.AuthSessions.CreateWithTTL(testSuite.TxCtx, session, time.Hour)
+	require.NoError(t, err)
+	event := helpers.MockSecurityEvent()
+	event.UserId = user.Id
+	event, err = testSuite.SecurityEvents.Create(testSuite.TxCtx, event)
+	require.NoError(t, err)
+	failedSignIn := helpers.MockSecurityEvent()
+	failedSignIn.Type = entity.SECURITY_EVENT_SIGN_IN_FAILED
+	failedSignIn.Details = map[string]string{"login": user.Email, "reason": "invalid_credentials"}
+	failedSignIn, err = testSuite.SecurityEvents.Create(testSuite.TxCtx, failedSignIn)
+	require.NoError(t, err)
+
+	t.Run("not due", func(t *testing.T) {
+		err := testSuite.Users.EraseById(testSuite.TxCtx, user.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		require.NoError(t, testSuite.Users.ScheduleDeletionById(testSuite.TxCtx, user.Id, time.Now().Add(-time.Minute)))
+		dueUsers, err := testSuite.Users.GetAllDueForDeletion(testSuite.TxCtx, 100)
+		require.NoError(t, err)
+		require.NotEmpty(t, dueUsers)
+
+		err = testSuite.Users.EraseById(testSuite.TxCtx, user.Id)
+
+		require.NoError(t, err)
+		_, err = testSuite.Users.GetByID(testSuite.TxCtx, user.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		sessions, err := testSuite.AuthSessions.GetAllByUserId(testSuite.TxCtx, user.Id)
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+		for _, kept := range []*entity.SecurityEvent{event, failedSignIn} {
+			events, err := testSuite.SecurityEvents.GetMany(testSuite.TxCtx, &dtos.SecurityEventsFilter{
+				Types: []entity.SecurityEventType{kept.Type}, BeforeId: kept.Id + 1, Limit: 1,
+			})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, kept.Id, events[0].Id)
+			assert.Zero(t, events[0].UserId)
+			assert.Empty(t, events[0].IpAddr)
+			assert.Empty(t, events[0].DeviceInfo)
+			assert.Empty(t, events[0].Location)
+			assert.Empty(t, events[0].Details)
+		}
+
+		tombstones, err := testSuite.DeletedUsers.GetAllUnpublished(testSuite.TxCtx, 100)
+		require.NoError(t, err)
+		ids := []int{}
+		for _, tombstone := range tombstones {
+			ids = append(ids, tombstone.UserId)
+		}
+		assert.Contains(t, ids, user.Id)
+
+		require.NoError(t, testSuite.DeletedUsers.UpdateEventPublishedAt(testSuite.TxCtx, user.Id, time.Now()))
+		tombstones, err = testSuite.DeletedUsers.GetAllUnpublished(testSuite.TxCtx, 100)
+		require.NoError(t, err)
+		for _, tombstone := range tombstones {
+			assert.NotEqual(t, user.Id, tombstone.UserId)
+		}
+	})
+}
@@ -3,8 +3,9 @@ package redisrepos
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,8 +13,12 @@ import (
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/internal/utils"
 	"github.com/modulix-systems/goose-talk/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
 )
 
+// AuthSessionsRepo stores every session in a separate hash.
+// Sessions of a user are indexed by sorted set scored by expiration time
+// and by login data key, so lookups never require scanning the keyspace
 type AuthSessionsRepo struct {
 	*redis.Redis
 }
@@ -21,10 +26,22 @@ type AuthSessionsRepo struct {
 type SessionData struct {
 	LastSeenAt  time.Time        `redis:"LastSeenAt"`
 	CreatedAt   time.Time        `redis:"CreatedAt"`
+	ExpiresAt   time.Time        `redis:"ExpiresAt"`
 	IsLongLived utils.BoolString `redis:"IsLongLived"`
 	Location    string           `redis:"Location"`
 	IpAddr      string           `redis:"IpAddr"`
 	DeviceInfo  string           `redis:"DeviceInfo"`
+	DeviceKey   string           `redis:"DeviceKey"`
+
+	AuthenticatedAt time.Time `redis:"AuthenticatedAt"`
+	AuthMethod      string    `redis:"AuthMethod"`
+	PublicKey       string    `redis:"PublicKey"`
+
+	DeviceType          string `redis:"DeviceType"`
+	DevicePlatform      string `redis:"DevicePlatform"`
+	DeviceOsVersion     string `redis:"DeviceOsVersion"`
+	DeviceClient        string `redis:"DeviceClient"`
+	DeviceClientVersion string `redis:"DeviceClientVersion"`
 }
 
 func (repo *AuthSessionsRepo) CreateWithTTL(ctx context.Context, session *entity.AuthSession, ttl time.Duration) (*entity.AuthSession, error) {
@@ -37,79 +54,138 @@ func (repo *AuthSessionsRepo) CreateWithTTL(ctx context.Context, session *entity
 	if newSession.LastSeenAt.IsZero() {
 		newSession.LastSeenAt = now
 	}
+	newSession.ExpiresAt = now.Add(ttl)
 	data := SessionData{
 		LastSeenAt:  newSession.LastSeenAt,
 		CreatedAt:   newSession.CreatedAt,
+		ExpiresAt:   newSession.ExpiresAt,
 		IsLongLived: utils.BoolString(newSession.IsLongLived),
 		Location:    newSession.Location,
 		IpAddr:      newSession.IpAddr,
 		DeviceInfo:  newSession.DeviceInfo,
+		DeviceKey:   newSession.DeviceKey(),
+
+		AuthenticatedAt: newSession.AuthenticatedAt,
+		AuthMethod:      string(newSession.AuthMethod),
+		PublicKey:       newSession.PublicKey,
+
+		DeviceType:          string(newSession.Device.Type),
+		DevicePlatform:      newSession.Device.Platform,
+		DeviceOsVersion:     newSession.Device.OsVersion,
+		DeviceClient:        newSession.Device.Client,
+		DeviceClientVersion: newSession.Device.ClientVersion,
 	}
 
 	key := prefixAuthSession(session.UserId, session.Id)
+	indexKey := prefixAuthSessionsIndex(session.UserId)
+	loginKey := prefixAuthSessionByLoginData(session.UserId, session.IpAddr, data.DeviceKey)
 
-	if err := repo.HSet(ctx, key, data).Err(); err != nil {
+	_, err := repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.HSet(ctx, key, data)
+		pipe.Expire(ctx, key, ttl)
+		pipe.Set(ctx, loginKey, session.Id, ttl)
+		pipe.ZAdd(ctx, indexKey, goredis.Z{Score: expirationScore(newSession.ExpiresAt), Member: session.Id})
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(now.Unix(), 10))
+		extendTTLScript.Eval(ctx, pipe, []string{indexKey}, ttl.Milliseconds())
+		return nil
+	})
+	if err != nil {
 		return nil, mapError(err)
 	}
 
-	if err := repo.Expire(ctx, key, ttl).Err(); err != nil {
-		return nil, mapError(err)
+	return &newSession, nil
+}
+
+// activeSessionIds returns ids of user's sessions which are not expired yet, removing expired ones from index
+func (repo *AuthSessionsRepo) activeSessionIds(ctx context.Context, userId int) ([]string, error) {
+	indexKey := prefixAuthSessionsIndex(userId)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	var idsCmd *goredis.StringSliceCmd
+	_, err := repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", now)
+		idsCmd = pipe.ZRangeByScore(ctx, indexKey, &goredis.ZRangeBy{Min: "(" + now, Max: "+inf"})
+		return nil
+	})
+	if err != nil {
+		return nil, mapError(fmt.Errorf("redisrepos - AuthSessionsRepo.activeSessionIds - ZRangeByScore(%s): %w", indexKey, err))
 	}
 
-	return &newSession, nil
+	return idsCmd.Val(), nil
 }
 
 func (repo *AuthSessionsRepo) GetAllByUserId(ctx context.Context, userId int) ([]entity.AuthSession, error) {
-	keys, err := repo.Keys(ctx, prefixAuthSessionSearchByUser(userId)).Result()
+	sessionIds, err := repo.activeSessionIds(ctx, userId)
 	if err != nil {
-		return nil, mapError(fmt.Errorf("redisrepos - AuthSessionsRepo.GetAllByUserId - repo.Keys(%s): %w", prefixAuthSessionSearchByUser(userId), err))
+		return nil, err
+	}
+	if len(sessionIds) == 0 {
+		return []entity.AuthSession{}, nil
 	}
 
-	sessions := make([]entity.AuthSession, 0, len(keys))
+	cmds := make([]*goredis.MapStringStringCmd, len(sessionIds))
+	_, err = repo.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, sessionId := range sessionIds {
+			cmds[i] = pipe.HGetAll(ctx, prefixAuthSession(userId, sessionId))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, mapError(fmt.Errorf("redisrepos - AuthSessionsRepo.GetAllByUserId - HGetAll: %w", err))
+	}
 
-	for _, key := range keys {
-		session, err := repo.getSession(ctx, key)
+	sessions := make([]entity.AuthSession, 0, len(sessionIds))
+	staleIds := make([]any, 0)
+	for i, cmd := range cmds {
+		session, err := parseSession(prefixAuthSession(userId, sessionIds[i]), cmd.Val())
 		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				staleIds = append(staleIds, sessionIds[i])
+				continue
+			}
 			return nil, err
 		}
 		sessions = append(sessions, *session)
 	}
 
+	if len(staleIds) > 0 {
+		if err := repo.ZRem(ctx, prefixAuthSessionsIndex(userId), staleIds...).Err(); err != nil {
+			return nil, mapError(err)
+		}
+	}
+
 	return sessions, nil
 }
 
-func (repo *AuthSessionsRepo) GetByLoginData(ctx context.Context, userId int, ip string, deviceInfo string) (*entity.AuthSession, error) {
-	sessions, err := repo.GetAllByUserId(ctx, userId)
+func (repo *AuthSessionsRepo) GetByLoginData(ctx context.Context, userId int, ip string, deviceKey string) (*entity.AuthSession, error) {
+	sessionId, err := repo.Get(ctx, prefixAuthSessionByLoginData(userId, ip, deviceKey)).Result()
 	if err != nil {
-		return nil, err
-	}
-
-	deviceInfo = strings.ToLower(deviceInfo)
-	for _, session := range sessions {
-		if session.IpAddr == ip && strings.ToLower(session.DeviceInfo) == deviceInfo {
-			return &session, nil
-		}
+		return nil, mapError(err)
 	}
 
-	return nil, storage.ErrNotFound
+	return repo.GetById(ctx, userId, sessionId)
 }
 
 func (repo *AuthSessionsRepo) getSession(ctx context.Context, key string) (*entity.AuthSession, error) {
 	rawData, err := repo.HGetAll(ctx, key).Result()
 	if err != nil {
-		return nil, fmt.Errorf("redisrepos - AuthSessionsRepo.GetAllByUserId - repo.HGetAll(%s): %w", key, err)
+		return nil, fmt.Errorf("redisrepos - AuthSessionsRepo.getSession - repo.HGetAll(%s): %w", key, err)
 	}
+	return parseSession(key, rawData)
+}
+
+func parseSession(key string, rawData map[string]string) (*entity.AuthSession, error) {
 	if len(rawData) == 0 {
 		return nil, storage.ErrNotFound
 	}
 	jsonRawData, err := json.Marshal(rawData)
 	if err != nil {
-		return nil, fmt.Errorf("redisrepos - AuthSessionsRepo.GetAllByUserId - json.Marshal(%s): %w", rawData, err)
+		return nil, fmt.Errorf("redisrepos - parseSession - json.Marshal(%s): %w", rawData, err)
 	}
 
 	var sessionData SessionData
 	if err := json.Unmarshal(jsonRawData, &sessionData); err != nil {
-		return nil, fmt.Errorf("redisrepos - AuthSessionsRepo.GetAllByUserId - json.Unmarshal(%s): %w", jsonRawData, err)
+		return nil, fmt.Errorf("redisrepos - parseSession - json.Unmarshal(%s): %w", jsonRawData, err)
 	}
 
 	return &entity.AuthSession{
@@ -117,10 +193,22 @@ func (repo *AuthSessionsRepo) getSession(ctx context.Context, key string) (*enti
 		UserId:      extractAuthSessionUserId(key),
 		LastSeenAt:  sessionData.LastSeenAt,
 		CreatedAt:   sessionData.CreatedAt,
+		ExpiresAt:   sessionData.ExpiresAt,
 		IsLongLived: bool(sessionData.IsLongLived),
 		Location:    sessionData.Location,
 		IpAddr:      sessionData.IpAddr,
 		DeviceInfo:  sessionData.DeviceInfo,
+
+		AuthenticatedAt: sessionData.AuthenticatedAt,
+		AuthMethod:      entity.AuthMethod(sessionData.AuthMethod),
+		PublicKey:       sessionData.PublicKey,
+		Device: entity.Device{
+			Type:          entity.DeviceType(sessionData.DeviceType),
+			Platform:      sessionData.DevicePlatform,
+			OsVersion:     sessionData.DeviceOsVersion,
+			Client:        sessionData.DeviceClient,
+			ClientVersion: sessionData.DeviceClientVersion,
+		},
 	}, nil
 }
 
@@ -129,43 +217,121 @@ func (repo *AuthSessionsRepo) GetById(ctx context.Context, userId int, sessionId
 }
 
 func (repo *AuthSessionsRepo) UpdateById(ctx context.Context, userId int, sessionId string, lastSeenAt time.Time, ttl time.Duration) error {
-	if !lastSeenAt.IsZero() {
-		if err := repo.HSet(ctx, prefixAuthSession(userId, sessionId), "LastSeenAt", lastSeenAt).Err(); err != nil {
-			return mapError(err)
-		}
+	key := prefixAuthSession(userId, sessionId)
+	// fetch login data first, it also checks existence to not create partial session hash without expiration
+	loginData, err := repo.HMGet(ctx, key, loginDataFields...).Result()
+	if err != nil {
+		return mapError(err)
+	}
+	ip, deviceKey, ok := parseLoginData(loginData)
+	if !ok {
+		return storage.ErrNotFound
 	}
 
-	if ttl != 0 {
-		if err := repo.Expire(ctx, prefixAuthSession(userId, sessionId), ttl).Err(); err != nil {
-			return mapError(err)
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		if !lastSeenAt.IsZero() {
+			pipe.HSet(ctx, key, "LastSeenAt", lastSeenAt)
 		}
+		if ttl != 0 {
+			expiresAt := time.Now().UTC().Add(ttl)
+			indexKey := prefixAuthSessionsIndex(userId)
+			pipe.HSet(ctx, key, "ExpiresAt", expiresAt)
+			pipe.Expire(ctx, key, ttl)
+			pipe.Expire(ctx, prefixAuthSessionByLoginData(userId, ip, deviceKey), ttl)
+			pipe.ZAddXX(ctx, indexKey, goredis.Z{Score: expirationScore(expiresAt), Member: sessionId})
+			extendTTLScript.Eval(ctx, pipe, []string{indexKey}, ttl.Milliseconds())
+		}
+		return nil
+	})
+	if err != nil {
+		return mapError(err)
 	}
 
 	return nil
 }
 
-func (repo *AuthSessionsRepo) DeleteById(ctx context.Context, userId int, sessionId string) error {
-	if err := repo.Del(ctx, prefixAuthSession(userId, sessionId)).Err(); err != nil {
+func (repo *AuthSessionsRepo) UpdateAuthenticationById(ctx context.Context, userId int, sessionId string, authenticatedAt time.Time, method entity.AuthMethod) error {
+	key := prefixAuthSession(userId, sessionId)
+	// fields are set only if session exists to not create partial session hash without expiration
+	updated, err := hsetIfExistsScript.Run(ctx, repo, []string{key}, "AuthenticatedAt", authenticatedAt, "AuthMethod", string(method)).Int()
+	if err != nil {
 		return mapError(err)
 	}
+	if updated == 0 {
+		return storage.ErrNotFound
+	}
 	return nil
 }
 
+func (repo *AuthSessionsRepo) DeleteById(ctx context.Context, userId int, sessionId string) error {
+	return repo.deleteSessions(ctx, userId, []string{sessionId})
+}
+
 func (repo *AuthSessionsRepo) DeleteAllByUserId(ctx context.Context, userId int, excludeSessionId string) error {
-	keys, err := repo.Keys(ctx, prefixAuthSessionSearchByUser(userId)).Result()
+	sessionIds, err := repo.activeSessionIds(ctx, userId)
 	if err != nil {
-		return mapError(fmt.Errorf("redisrepos - AuthSessionsRepo.DeleteAllByUserId - repo.Keys(%s): %w", prefixAuthSessionSearchByUser(userId), err))
-	}
-	if excludeSessionId != "" {
-		excludeIndex := slices.IndexFunc(keys, func(key string) bool {
-			return extractAuthSessionId(key) == excludeSessionId
-		})
-		if excludeIndex != -1 {
-			keys = slices.Delete(keys, excludeIndex, excludeIndex+1)
+		return err
+	}
+	idsToDelete := make([]string, 0, len(sessionIds))
+	for _, sessionId := range sessionIds {
+		if sessionId != excludeSessionId {
+			idsToDelete = append(idsToDelete, sessionId)
 		}
 	}
-	if err := repo.Del(ctx, keys...).Err(); err != nil {
+	return repo.deleteSessions(ctx, userId, idsToDelete)
+}
+
+// deleteSessions removes sessions along with their index entries in a single transaction
+func (repo *AuthSessionsRepo) deleteSessions(ctx context.Context, userId int, sessionIds []string) error {
+	if len(sessionIds) == 0 {
+		return nil
+	}
+
+	loginDataCmds := make([]*goredis.SliceCmd, len(sessionIds))
+	_, err := repo.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, sessionId := range sessionIds {
+			loginDataCmds[i] = pipe.HMGet(ctx, prefixAuthSession(userId, sessionId), loginDataFields...)
+		}
+		return nil
+	})
+	if err != nil {
+		return mapError(fmt.Errorf("redisrepos - AuthSessionsRepo.deleteSessions - HMGet: %w", err))
+	}
+
+	indexKey := prefixAuthSessionsIndex(userId)
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, sessionId := range sessionIds {
+			pipe.Del(ctx, prefixAuthSession(userId, sessionId))
+			pipe.ZRem(ctx, indexKey, sessionId)
+			if ip, deviceKey, ok := parseLoginData(loginDataCmds[i].Val()); ok {
+				// login data key may already point to a newer session with the same login data
+				deleteIfEqualScript.Eval(ctx, pipe, []string{prefixAuthSessionByLoginData(userId, ip, deviceKey)}, sessionId)
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return mapError(err)
 	}
 	return nil
 }
+
+var loginDataFields = []string{"IpAddr", "DeviceKey", "DeviceInfo"}
+
+// parseLoginData returns ip and device key of session from loginDataFields values
+func parseLoginData(values []any) (string, string, bool) {
+	if len(values) != len(loginDataFields) {
+		return "", "", false
+	}
+	ip, ipOk := values[0].(string)
+	if deviceKey, ok := values[1].(string); ok {
+		return ip, deviceKey, ipOk
+	}
+	// sessions created before device parsing was introduced are keyed by raw device info
+	deviceInfo, deviceInfoOk := values[2].(string)
+	return ip, strings.ToLower(deviceInfo), ipOk && deviceInfoOk
+}
+
+func expirationScore(expiresAt time.Time) float64 {
+	return float64(expiresAt.Unix())
+}
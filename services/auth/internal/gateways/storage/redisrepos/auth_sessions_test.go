@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
 	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
@@ -51,7 +52,15 @@ func TestGetAuthSession(t *testing.T) {
 	})
 
 	t.Run("one by login data", func(t *testing.T) {
-		foundSession, err := testSuite.AuthSessions.GetByLoginData(ctx, session2.UserId, session2.IpAddr, session2.DeviceInfo)
+		foundSession, err := testSuite.AuthSessions.GetByLoginData(ctx, session2.UserId, session2.IpAddr, session2.DeviceKey())
+		assert.NoError(t, err)
+		assert.Equal(t, session2, foundSession)
+	})
+
+	t.Run("one by login data from updated client", func(t *testing.T) {
+		updatedClient := *session2
+		updatedClient.Device.ClientVersion = gofakeit.AppVersion()
+		foundSession, err := testSuite.AuthSessions.GetByLoginData(ctx, session2.UserId, session2.IpAddr, updatedClient.DeviceKey())
 		assert.NoError(t, err)
 		assert.Equal(t, session2, foundSession)
 	})
@@ -65,6 +74,29 @@ func TestGetAuthSession(t *testing.T) {
 	})
 }
 
+func TestAuthSessionsIndexCleanup(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	userId := gofakeit.Number(1, 1000)
+	expiringSession := helpers.MockAuthSession()
+	expiringSession.UserId = userId
+	_, err := testSuite.AuthSessions.CreateWithTTL(ctx, expiringSession, time.Second)
+	require.NoError(t, err)
+	activeSession := helpers.MockAuthSession()
+	activeSession.UserId = userId
+	activeSession, err = testSuite.AuthSessions.CreateWithTTL(ctx, activeSession, time.Minute)
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+	foundSessions, err := testSuite.AuthSessions.GetAllByUserId(ctx, userId)
+
+	require.NoError(t, err)
+	assert.Equal(t, []entity.AuthSession{*activeSession}, foundSessions)
+	indexedIds, err := testSuite.RedisClient.ZRange(ctx, fmt.Sprintf("auth-sessions-index:%d", userId), 0, -1).Result()
+	require.NoError(t, err)
+	assert.Equal(t, []string{activeSession.Id}, indexedIds)
+}
+
 func TestUpdateAuthSession(t *testing.T) {
 	testSuite := redisrepos.NewTestSuite(t)
 	ctx := context.Background()
@@ -83,6 +115,31 @@ func TestUpdateAuthSession(t *testing.T) {
 	assert.Equal(t, expectedTTL, actualTTL)
 }
 
+func TestUpdateAuthSessionAuthentication(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	expectedSession, err := testSuite.AuthSessions.CreateWithTTL(ctx, helpers.MockAuthSession(), time.Minute)
+	require.NoError(t, err)
+	expectedAuthenticatedAt := time.Now()
+
+	t.Run("success", func(t *testing.T) {
+		err := testSuite.AuthSessions.UpdateAuthenticationById(ctx, expectedSession.UserId, expectedSession.Id, expectedAuthenticatedAt, entity.AUTH_METHOD_PASSWORD)
+		require.NoError(t, err)
+		foundSession, err := testSuite.AuthSessions.GetById(ctx, expectedSession.UserId, expectedSession.Id)
+		require.NoError(t, err)
+		assert.Equal(t, expectedAuthenticatedAt.Round(time.Second), foundSession.AuthenticatedAt.Round(time.Second))
+		assert.Equal(t, entity.AUTH_METHOD_PASSWORD, foundSession.AuthMethod)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.AuthSessions.UpdateAuthenticationById(ctx, expectedSession.UserId, "unknown", expectedAuthenticatedAt, entity.AUTH_METHOD_PASSWORD)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		exists, err := testSuite.RedisClient.Exists(ctx, fmt.Sprintf("auth-sessions:%d:unknown", expectedSession.UserId)).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
 func TestDeleteAuthSession(t *testing.T) {
 	testSuite := redisrepos.NewTestSuite(t)
 	ctx := context.Background()
@@ -96,6 +153,8 @@ func TestDeleteAuthSession(t *testing.T) {
 		require.NoError(t, err)
 		_, err = testSuite.AuthSessions.GetById(ctx, session.UserId, session.Id)
 		assert.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = testSuite.AuthSessions.GetByLoginData(ctx, session.UserId, session.IpAddr, session.DeviceKey())
+		assert.ErrorIs(t, err, storage.ErrNotFound)
 	})
 
 	t.Run("all by user id", func(t *testing.T) {
@@ -122,5 +181,8 @@ func TestDeleteAuthSession(t *testing.T) {
 		assert.ErrorIs(t, err, storage.ErrNotFound)
 		_, err = testSuite.AuthSessions.GetById(ctx, excludedSession.UserId, excludedSession.Id)
 		assert.NoError(t, err)
+		foundSessions, err := testSuite.AuthSessions.GetAllByUserId(ctx, userId)
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.AuthSession{*excludedSession}, foundSessions)
 	})
 }
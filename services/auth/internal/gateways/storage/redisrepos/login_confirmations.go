@@ -0,0 +1,44 @@
+package redisrepos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/pkg/redis"
+)
+
+type LoginConfirmationsRepo struct {
+	*redis.Redis
+}
+
+type LoginConfirmationData struct {
+	UserId int
+	Nonce  string
+}
+
+func (repo *LoginConfirmationsRepo) CreateWithTTL(ctx context.Context, confirmation *entity.LoginConfirmation, ttl time.Duration) error {
+	jsonData, err := json.Marshal(LoginConfirmationData{UserId: confirmation.UserId, Nonce: confirmation.Nonce})
+	if err != nil {
+		return fmt.Errorf("redisrepos - LoginConfirmationsRepo.CreateWithTTL - json.Marshal: %w", err)
+	}
+	if err := repo.Set(ctx, prefixLoginConfirmation(confirmation.SessionId), string(jsonData), ttl).Err(); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+// GetAndDelete atomically takes confirmation so it can be answered only once
+func (repo *LoginConfirmationsRepo) GetAndDelete(ctx context.Context, sessionId string) (*entity.LoginConfirmation, error) {
+	jsonData, err := repo.GetDel(ctx, prefixLoginConfirmation(sessionId)).Result()
+	if err != nil {
+		return nil, mapError(err)
+	}
+	var data LoginConfirmationData
+	if err := json.Unmarshal([]byte(jsonData), &data); err != nil {
+		return nil, fmt.Errorf("redisrepos - LoginConfirmationsRepo.GetAndDelete - json.Unmarshal: %w", err)
+	}
+	return &entity.LoginConfirmation{SessionId: sessionId, UserId: data.UserId, Nonce: data.Nonce}, nil
+}
@@ -0,0 +1,40 @@
+package redisrepos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginConfirmationIsAnsweredOnce(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	confirmation := helpers.MockLoginConfirmation()
+	err := testSuite.LoginConfirmations.CreateWithTTL(ctx, confirmation, time.Minute)
+	require.NoError(t, err)
+
+	foundConfirmation, err := testSuite.LoginConfirmations.GetAndDelete(ctx, confirmation.SessionId)
+	require.NoError(t, err)
+	assert.Equal(t, confirmation, foundConfirmation)
+
+	_, err = testSuite.LoginConfirmations.GetAndDelete(ctx, confirmation.SessionId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestLoginConfirmationExpires(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	confirmation := helpers.MockLoginConfirmation()
+	err := testSuite.LoginConfirmations.CreateWithTTL(ctx, confirmation, time.Minute)
+	require.NoError(t, err)
+
+	ttl, err := testSuite.RedisClient.TTL(ctx, "login-confirmations:"+confirmation.SessionId).Result()
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute, ttl, float64(time.Second))
+}
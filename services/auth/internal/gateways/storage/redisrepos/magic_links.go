@@ -0,0 +1,98 @@
+package redisrepos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// MagicLinksRepo stores single link per client, so requesting a new link replaces previous one
+type MagicLinksRepo struct {
+	*redis.Redis
+}
+
+type MagicLinkData struct {
+	UserId    int
+	TokenHash string
+	Code      []byte
+}
+
+func marshalMagicLink(link *entity.MagicLink) (string, error) {
+	jsonData, err := json.Marshal(MagicLinkData{UserId: link.UserId, TokenHash: link.TokenHash, Code: link.Code})
+	if err != nil {
+		return "", err
+	}
+	return string(jsonData), nil
+}
+
+func (repo *MagicLinksRepo) CreateWithTTL(ctx context.Context, link *entity.MagicLink, ttl time.Duration) error {
+	jsonData, err := marshalMagicLink(link)
+	if err != nil {
+		return fmt.Errorf("redisrepos - MagicLinksRepo.CreateWithTTL - json.Marshal: %w", err)
+	}
+	// failed attempts of replaced link are not counted against the new one
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, prefixMagicLink(link.ClientId), jsonData, ttl)
+		pipe.Del(ctx, prefixMagicLinkAttempts(link.ClientId))
+		return nil
+	})
+	if err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (repo *MagicLinksRepo) GetByClientId(ctx context.Context, clientId string) (*entity.MagicLink, error) {
+	jsonData, err := repo.Get(ctx, prefixMagicLink(clientId)).Result()
+	if err != nil {
+		return nil, mapError(err)
+	}
+	var data MagicLinkData
+	if err := json.Unmarshal([]byte(jsonData), &data); err != nil {
+		return nil, fmt.Errorf("redisrepos - MagicLinksRepo.GetByClientId - json.Unmarshal: %w", err)
+	}
+	return &entity.MagicLink{ClientId: clientId, UserId: data.UserId, TokenHash: data.TokenHash, Code: data.Code}, nil
+}
+
+// IncrementAttempts records failed attempt to use link and returns total number of failed attempts.
+// Returns storage.ErrNotFound if link was replaced or consumed already
+func (repo *MagicLinksRepo) IncrementAttempts(ctx context.Context, link *entity.MagicLink) (int, error) {
+	jsonData, err := marshalMagicLink(link)
+	if err != nil {
+		return 0, fmt.Errorf("redisrepos - MagicLinksRepo.IncrementAttempts - json.Marshal: %w", err)
+	}
+	keys := []string{prefixMagicLink(link.ClientId), prefixMagicLinkAttempts(link.ClientId)}
+	attempts, err := incrIfEqualScript.Run(ctx, repo, keys, jsonData).Int()
+	if err != nil {
+		return 0, mapError(err)
+	}
+	if attempts < 0 {
+		return 0, storage.ErrNotFound
+	}
+	return attempts, nil
+}
+
+// Delete consumes link only if it was not replaced or consumed already, otherwise storage.ErrNotFound is returned
+func (repo *MagicLinksRepo) Delete(ctx context.Context, link *entity.MagicLink) error {
+	jsonData, err := marshalMagicLink(link)
+	if err != nil {
+		return fmt.Errorf("redisrepos - MagicLinksRepo.Delete - json.Marshal: %w", err)
+	}
+	deleted, err := deleteIfEqualScript.Run(ctx, repo, []string{prefixMagicLink(link.ClientId)}, jsonData).Int()
+	if err != nil {
+		return mapError(err)
+	}
+	if deleted == 0 {
+		return storage.ErrNotFound
+	}
+	if err = repo.Del(ctx, prefixMagicLinkAttempts(link.ClientId)).Err(); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
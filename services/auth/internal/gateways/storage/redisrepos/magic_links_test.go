@@ -0,0 +1,105 @@
+package redisrepos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMagicLinkIsReplacedByNewOne(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	link := helpers.MockMagicLink()
+	err := testSuite.MagicLinks.CreateWithTTL(ctx, link, time.Minute)
+	require.NoError(t, err)
+
+	newLink := helpers.MockMagicLink()
+	newLink.ClientId = link.ClientId
+	err = testSuite.MagicLinks.CreateWithTTL(ctx, newLink, time.Minute)
+	require.NoError(t, err)
+
+	foundLink, err := testSuite.MagicLinks.GetByClientId(ctx, link.ClientId)
+	require.NoError(t, err)
+	assert.Equal(t, newLink, foundLink)
+
+	err = testSuite.MagicLinks.Delete(ctx, link)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestMagicLinkIsConsumedOnce(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	link := helpers.MockMagicLink()
+	err := testSuite.MagicLinks.CreateWithTTL(ctx, link, time.Minute)
+	require.NoError(t, err)
+
+	err = testSuite.MagicLinks.Delete(ctx, link)
+	require.NoError(t, err)
+
+	_, err = testSuite.MagicLinks.GetByClientId(ctx, link.ClientId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	err = testSuite.MagicLinks.Delete(ctx, link)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestMagicLinkExpires(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	link := helpers.MockMagicLink()
+	err := testSuite.MagicLinks.CreateWithTTL(ctx, link, time.Minute)
+	require.NoError(t, err)
+
+	ttl, err := testSuite.RedisClient.TTL(ctx, "magic-links:"+link.ClientId).Result()
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute, ttl, float64(time.Second))
+}
+
+func TestMagicLinkAttempts(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	link := helpers.MockMagicLink()
+	err := testSuite.MagicLinks.CreateWithTTL(ctx, link, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("increment attempts", func(t *testing.T) {
+		attempts, err := testSuite.MagicLinks.IncrementAttempts(ctx, link)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+		attempts, err = testSuite.MagicLinks.IncrementAttempts(ctx, link)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+
+		ttl, err := testSuite.RedisClient.TTL(ctx, "magic-link-attempts:"+link.ClientId).Result()
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, ttl, float64(time.Second))
+	})
+
+	t.Run("reset by new link", func(t *testing.T) {
+		newLink := helpers.MockMagicLink()
+		newLink.ClientId = link.ClientId
+		require.NoError(t, testSuite.MagicLinks.CreateWithTTL(ctx, newLink, time.Minute))
+
+		_, err := testSuite.MagicLinks.IncrementAttempts(ctx, link)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		attempts, err := testSuite.MagicLinks.IncrementAttempts(ctx, newLink)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+		link = newLink
+	})
+
+	t.Run("consumed link", func(t *testing.T) {
+		require.NoError(t, testSuite.MagicLinks.Delete(ctx, link))
+
+		_, err := testSuite.MagicLinks.IncrementAttempts(ctx, link)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		exists, err := testSuite.RedisClient.Exists(ctx, "magic-link-attempts:"+link.ClientId).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
@@ -2,10 +2,13 @@ package redisrepos
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type OtpRepo struct {
@@ -13,36 +16,88 @@ type OtpRepo struct {
 }
 
 func (repo *OtpRepo) GetByEmail(ctx context.Context, email string) (*entity.OTP, error) {
-	otpCode, err := repo.Get(ctx, prefixOtpByEmail(email)).Result()
-	if err != nil {
-		return nil, mapError(err)
+	otp := &entity.OTP{UserEmail: email}
+	if err := repo.load(ctx, otp); err != nil {
+		return nil, err
 	}
-	return &entity.OTP{Code: []byte(otpCode), UserEmail: email}, nil
+	return otp, nil
 }
 
 func (repo *OtpRepo) GetByUserId(ctx context.Context, userId int) (*entity.OTP, error) {
-	otpCode, err := repo.Get(ctx, prefixOtpByUserId(userId)).Result()
-	if err != nil {
-		return nil, mapError(err)
+	otp := &entity.OTP{UserId: userId}
+	if err := repo.load(ctx, otp); err != nil {
+		return nil, err
 	}
-	return &entity.OTP{Code: []byte(otpCode), UserId: userId}, nil
+	return otp, nil
 }
 
-func (repo *OtpRepo) Delete(ctx context.Context, otp *entity.OTP) error {
-	if err := repo.Del(ctx, repo.GetKey(otp)).Err(); err != nil {
+func (repo *OtpRepo) GetByPurpose(ctx context.Context, purpose entity.OTPPurpose, userId int) (*entity.OTP, error) {
+	otp := &entity.OTP{UserId: userId, Purpose: purpose}
+	if err := repo.load(ctx, otp); err != nil {
+		return nil, err
+	}
+	return otp, nil
+}
+
+// load fills otp identified by its key with stored code, binding and number of failed attempts
+func (repo *OtpRepo) load(ctx context.Context, otp *entity.OTP) error {
+	data, err := repo.HGetAll(ctx, repo.GetKey(otp)).Result()
+	if err != nil {
 		return mapError(err)
 	}
+	if len(data) == 0 {
+		return storage.ErrNotFound
+	}
+	attempts, err := strconv.Atoi(data["Attempts"])
+	if err != nil {
+		return err
+	}
+	otp.Code = []byte(data["Code"])
+	otp.Binding = data["Binding"]
+	otp.Attempts = attempts
 	return nil
 }
 
-func (repo *OtpRepo) CreateWithTTL(ctx context.Context, otp *entity.OTP, ttl time.Duration) error {
-	if err := repo.Set(ctx, repo.GetKey(otp), otp.Code, ttl).Err(); err != nil {
+// IncrementAttempts records failed attempt to use otp and returns total number of failed attempts
+func (repo *OtpRepo) IncrementAttempts(ctx context.Context, otp *entity.OTP) (int, error) {
+	attempts, err := hincrIfExistsScript.Run(ctx, repo, []string{repo.GetKey(otp)}, "Attempts").Int()
+	if err != nil {
+		return 0, mapError(err)
+	}
+	if attempts < 0 {
+		return 0, storage.ErrNotFound
+	}
+	return attempts, nil
+}
+
+// Delete removes otp returning storage.ErrNotFound if it does not exist,
+// so that only one of concurrent callers succeeds to use the code
+func (repo *OtpRepo) Delete(ctx context.Context, otp *entity.OTP) error {
+	deleted, err := repo.Del(ctx, repo.GetKey(otp)).Result()
+	if err != nil {
 		return mapError(err)
 	}
+	if deleted == 0 {
+		return storage.ErrNotFound
+	}
 	return nil
 }
 
+func (repo *OtpRepo) CreateWithTTL(ctx context.Context, otp *entity.OTP, ttl time.Duration) error {
+	key := repo.GetKey(otp)
+	_, err := repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "Code", otp.Code, "Binding", otp.Binding, "Attempts", 0)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	return mapError(err)
+}
+
 func (repo *OtpRepo) GetKey(otp *entity.OTP) string {
+	if otp.Purpose != "" {
+		return prefixOtpByPurpose(otp.Purpose, otp.UserId)
+	}
 	if otp.UserId != 0 {
 		return prefixOtpByUserId(otp.UserId)
 	} 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,4 +51,72 @@ func TestCreateOtpWithTTL(t *testing.T) {
 		assert.Equal(t, mockOtp.Code, otp.Code)
 		assert.Equal(t, mockOtp.UserId, otp.UserId)
 	})
+
+	t.Run("increment attempts", func(t *testing.T) {
+		mockOtp := &entity.OTP{
+			Code:      []byte(gofakeit.Numerify("######")),
+			UserEmail: gofakeit.Email(),
+		}
+		require.NoError(t, testSuite.Otp.CreateWithTTL(ctx, mockOtp, time.Minute))
+
+		attempts, err := testSuite.Otp.IncrementAttempts(ctx, mockOtp)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+		otp, err := testSuite.Otp.GetByEmail(ctx, mockOtp.UserEmail)
+		require.NoError(t, err)
+		assert.Equal(t, 1, otp.Attempts)
+		assert.Equal(t, mockOtp.Code, otp.Code)
+	})
+}
+
+func TestOtpWithPurpose(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	userId := gofakeit.IntRange(1, 100000)
+	mockOtp := &entity.OTP{
+		Code:    []byte(gofakeit.Numerify("######")),
+		UserId:  userId,
+		Purpose: entity.OTP_PURPOSE_LOGIN_CHALLENGE,
+		Binding: gofakeit.UUID(),
+	}
+	expectedTTL := time.Minute
+	require.NoError(t, testSuite.Otp.CreateWithTTL(ctx, mockOtp, expectedTTL))
+
+	t.Run("get", func(t *testing.T) {
+		actualTTL, err := testSuite.RedisClient.TTL(ctx, testSuite.Otp.GetKey(mockOtp)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, expectedTTL, actualTTL)
+		otp, err := testSuite.Otp.GetByPurpose(ctx, mockOtp.Purpose, userId)
+		require.NoError(t, err)
+		assert.Equal(t, mockOtp.Code, otp.Code)
+		assert.Equal(t, mockOtp.Binding, otp.Binding)
+		assert.Zero(t, otp.Attempts)
+	})
+
+	t.Run("does not share slot with 2FA code", func(t *testing.T) {
+		_, err := testSuite.Otp.GetByUserId(ctx, userId)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("increment attempts", func(t *testing.T) {
+		attempts, err := testSuite.Otp.IncrementAttempts(ctx, mockOtp)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts)
+		attempts, err = testSuite.Otp.IncrementAttempts(ctx, mockOtp)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		otp, err := testSuite.Otp.GetByPurpose(ctx, mockOtp.Purpose, userId)
+		require.NoError(t, err)
+		assert.Equal(t, 2, otp.Attempts)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, testSuite.Otp.Delete(ctx, mockOtp))
+
+		_, err := testSuite.Otp.GetByPurpose(ctx, mockOtp.Purpose, userId)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = testSuite.Otp.IncrementAttempts(ctx, mockOtp)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+		assert.ErrorIs(t, testSuite.Otp.Delete(ctx, mockOtp), storage.ErrNotFound)
+	})
 }
@@ -8,8 +8,11 @@ import (
 
 	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
 )
 
+// QRLoginTokensRepo stores tokens under separate keys and indexes them by client
+// and by watch they were issued for with sets living as long as the latest token
 type QRLoginTokensRepo struct {
 	*redis.Redis
 }
@@ -17,15 +20,26 @@ type QRLoginTokensRepo struct {
 type TokenData struct {
 	IpAddr     string
 	DeviceInfo string
+	WatchId    string
 }
 
 func (repo *QRLoginTokensRepo) CreateWithTTL(ctx context.Context, token *entity.QRCodeLoginToken, ttl time.Duration) error {
-	data := TokenData{IpAddr: token.IpAddr, DeviceInfo: token.DeviceInfo}
+	data := TokenData{IpAddr: token.IpAddr, DeviceInfo: token.DeviceInfo, WatchId: token.WatchId}
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return fmt.Errorf("redisrepos - QRLoginTokenRepo.CreateWithTTL - json.Marshal: %w", err)
 	}
-	if err := repo.Set(ctx, prefixQRLoginToken(token.Value, token.ClientId), string(jsonData), ttl).Err(); err != nil {
+	indexKey := prefixQRLoginTokensIndex(token.ClientId)
+	watchIndexKey := prefixQRLoginWatchIndex(token.ClientId, token.WatchId)
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, prefixQRLoginToken(token.Value, token.ClientId), string(jsonData), ttl)
+		pipe.SAdd(ctx, indexKey, token.Value)
+		extendTTLScript.Eval(ctx, pipe, []string{indexKey}, ttl.Milliseconds())
+		pipe.SAdd(ctx, watchIndexKey, token.Value)
+		extendTTLScript.Eval(ctx, pipe, []string{watchIndexKey}, ttl.Milliseconds())
+		return nil
+	})
+	if err != nil {
 		return mapError(err)
 	}
 	return nil
@@ -40,16 +54,98 @@ func (repo *QRLoginTokensRepo) FindOne(ctx context.Context, value string, client
 	if err := json.Unmarshal([]byte(jsonData), &data); err != nil {
 		return nil, fmt.Errorf("redisrepos - QRLoginTokenRepo.GetByValue - json.Unmarshal: %w", err)
 	}
-	return &entity.QRCodeLoginToken{Value: value, ClientId: clientId, IpAddr: data.IpAddr, DeviceInfo: data.DeviceInfo}, nil
+	return &entity.QRCodeLoginToken{
+		Value:      value,
+		ClientId:   clientId,
+		WatchId:    data.WatchId,
+		IpAddr:     data.IpAddr,
+		DeviceInfo: data.DeviceInfo,
+	}, nil
 }
 
 func (repo *QRLoginTokensRepo) DeleteAllByClient(ctx context.Context, clientId string) error {
-	keys, err := repo.Keys(ctx, prefixQRLoginTokenSearchByClient(clientId)).Result()
+	indexKey := prefixQRLoginTokensIndex(clientId)
+	values, err := repo.SMembers(ctx, indexKey).Result()
 	if err != nil {
 		return mapError(err)
 	}
+	keys := make([]string, 0, len(values)+1)
+	for _, value := range values {
+		keys = append(keys, prefixQRLoginToken(value, clientId))
+	}
+	keys = append(keys, indexKey)
 	if err := repo.Del(ctx, keys...).Err(); err != nil {
 		return mapError(err)
 	}
 	return nil
 }
+
+// DeleteAllByWatch deletes tokens issued for watchId only, so tokens of other watches of the same client keep working.
+// Empty watchId deletes tokens exported without watching
+func (repo *QRLoginTokensRepo) DeleteAllByWatch(ctx context.Context, clientId string, watchId string) error {
+	watchIndexKey := prefixQRLoginWatchIndex(clientId, watchId)
+	values, err := repo.SMembers(ctx, watchIndexKey).Result()
+	if err != nil {
+		return mapError(err)
+	}
+	keys := make([]string, 0, len(values)+1)
+	members := make([]any, 0, len(values))
+	for _, value := range values {
+		keys = append(keys, prefixQRLoginToken(value, clientId))
+		members = append(members, value)
+	}
+	keys = append(keys, watchIndexKey)
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		if len(members) > 0 {
+			pipe.SRem(ctx, prefixQRLoginTokensIndex(clientId), members...)
+		}
+		return nil
+	})
+	if err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (repo *QRLoginTokensRepo) PublishAcceptance(ctx context.Context, watchId string, acceptance *entity.QRLoginAcceptance) error {
+	jsonData, err := json.Marshal(acceptance)
+	if err != nil {
+		return fmt.Errorf("redisrepos - QRLoginTokenRepo.PublishAcceptance - json.Marshal: %w", err)
+	}
+	if err := repo.Publish(ctx, prefixQRLoginAcceptances(watchId), string(jsonData)).Err(); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+// SubscribeAcceptances returns channel receiving acceptances published for watchId and function closing subscription.
+// Subscription is confirmed before return, so acceptance published afterwards is never missed
+func (repo *QRLoginTokensRepo) SubscribeAcceptances(
+	ctx context.Context,
+	watchId string,
+) (<-chan *entity.QRLoginAcceptance, func() error, error) {
+	pubsub := repo.Subscribe(ctx, prefixQRLoginAcceptances(watchId))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, nil, mapError(err)
+	}
+
+	acceptances := make(chan *entity.QRLoginAcceptance, 1)
+	go func() {
+		defer close(acceptances)
+		for msg := range pubsub.Channel() {
+			var acceptance entity.QRLoginAcceptance
+			if err := json.Unmarshal([]byte(msg.Payload), &acceptance); err != nil {
+				continue
+			}
+			select {
+			case acceptances <- &acceptance:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return acceptances, pubsub.Close, nil
+}
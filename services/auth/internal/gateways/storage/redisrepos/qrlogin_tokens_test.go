@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
 	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
@@ -28,6 +29,7 @@ func TestCreateQRLoginTokenWithTTL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedToken.Value, foundToken.Value)
 	assert.Equal(t, expectedToken.ClientId, foundToken.ClientId)
+	assert.Equal(t, expectedToken.WatchId, foundToken.WatchId)
 	assert.Equal(t, expectedToken.IpAddr, foundToken.IpAddr)
 	assert.Equal(t, expectedToken.DeviceInfo, foundToken.DeviceInfo)
 }
@@ -46,3 +48,82 @@ func TestDeleteQRLoginTokensByClient(t *testing.T) {
 	assert.ErrorIs(t, err, storage.ErrNotFound)
 	assert.Empty(t, foundToken)
 }
+
+func TestDeleteQRLoginTokensByClientWithoutTokens(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+
+	err := testSuite.QRLoginTokens.DeleteAllByClient(ctx, helpers.MockLoginToken().ClientId)
+	assert.NoError(t, err)
+}
+
+func TestDeleteQRLoginTokensByClientDeletesAllTokensAndIndex(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	expectedToken := helpers.MockLoginToken()
+	expectedTTL := time.Minute
+	err := testSuite.QRLoginTokens.CreateWithTTL(ctx, expectedToken, expectedTTL)
+	require.NoError(t, err)
+	anotherToken := helpers.MockLoginToken()
+	anotherToken.ClientId = expectedToken.ClientId
+	err = testSuite.QRLoginTokens.CreateWithTTL(ctx, anotherToken, expectedTTL)
+	require.NoError(t, err)
+
+	err = testSuite.QRLoginTokens.DeleteAllByClient(ctx, expectedToken.ClientId)
+	assert.NoError(t, err)
+	foundToken, err := testSuite.QRLoginTokens.FindOne(ctx, expectedToken.Value, expectedToken.ClientId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	assert.Empty(t, foundToken)
+	_, err = testSuite.QRLoginTokens.FindOne(ctx, anotherToken.Value, anotherToken.ClientId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	indexExists, err := testSuite.RedisClient.Exists(ctx, "qrlogin-index:"+expectedToken.ClientId).Result()
+	require.NoError(t, err)
+	assert.Zero(t, indexExists)
+}
+
+func TestQRLoginAcceptanceIsDeliveredToSubscriber(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	token := helpers.MockLoginToken()
+	acceptances, closeSubscription, err := testSuite.QRLoginTokens.SubscribeAcceptances(ctx, token.WatchId)
+	require.NoError(t, err)
+	defer closeSubscription()
+	expectedAcceptance := &entity.QRLoginAcceptance{UserId: 1, SessionId: "session"}
+
+	err = testSuite.QRLoginTokens.PublishAcceptance(ctx, helpers.MockLoginToken().WatchId, &entity.QRLoginAcceptance{UserId: 2})
+	require.NoError(t, err)
+	err = testSuite.QRLoginTokens.PublishAcceptance(ctx, token.WatchId, expectedAcceptance)
+	require.NoError(t, err)
+
+	select {
+	case acceptance := <-acceptances:
+		assert.Equal(t, expectedAcceptance, acceptance)
+	case <-time.After(time.Second):
+		t.Fatal("acceptance was not delivered")
+	}
+}
+
+func TestDeleteQRLoginTokensByWatchKeepsOtherWatches(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	watchedToken := helpers.MockLoginToken()
+	watchedToken.WatchId = "first-watch"
+	err := testSuite.QRLoginTokens.CreateWithTTL(ctx, watchedToken, time.Minute)
+	require.NoError(t, err)
+	anotherWatchToken := helpers.MockLoginToken()
+	anotherWatchToken.ClientId = watchedToken.ClientId
+	anotherWatchToken.WatchId = "second-watch"
+	err = testSuite.QRLoginTokens.CreateWithTTL(ctx, anotherWatchToken, time.Minute)
+	require.NoError(t, err)
+
+	err = testSuite.QRLoginTokens.DeleteAllByWatch(ctx, watchedToken.ClientId, watchedToken.WatchId)
+
+	require.NoError(t, err)
+	_, err = testSuite.QRLoginTokens.FindOne(ctx, watchedToken.Value, watchedToken.ClientId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = testSuite.QRLoginTokens.FindOne(ctx, anotherWatchToken.Value, anotherWatchToken.ClientId)
+	assert.NoError(t, err)
+	indexed, err := testSuite.RedisClient.SMembers(ctx, "qrlogin-index:"+watchedToken.ClientId).Result()
+	require.NoError(t, err)
+	assert.Equal(t, []string{anotherWatchToken.Value}, indexed)
+}
@@ -13,6 +13,11 @@ type Repositories struct {
 	AuthSessions   *AuthSessionsRepo
 	QRLoginTokens  *QRLoginTokensRepo
 	PasskeySession *PasskeySessionsRepo
+	TelegramLinks  *TelegramLinksRepo
+
+	LoginConfirmations *LoginConfirmationsRepo
+	SessionProofNonces *SessionProofNoncesRepo
+	MagicLinks         *MagicLinksRepo
 }
 
 func New(rdb *redis.Redis) *Repositories {
@@ -21,6 +26,11 @@ func New(rdb *redis.Redis) *Repositories {
 		AuthSessions:   &AuthSessionsRepo{rdb},
 		QRLoginTokens:  &QRLoginTokensRepo{rdb},
 		PasskeySession: &PasskeySessionsRepo{rdb},
+		TelegramLinks:  &TelegramLinksRepo{rdb},
+
+		LoginConfirmations: &LoginConfirmationsRepo{rdb},
+		SessionProofNonces: &SessionProofNoncesRepo{rdb},
+		MagicLinks:         &MagicLinksRepo{rdb},
 	}
 }
 
@@ -0,0 +1,26 @@
+package redisrepos
+
+import (
+	"context"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/pkg/redis"
+)
+
+// SessionProofNoncesRepo remembers nonces of accepted session proofs to reject their replays
+type SessionProofNoncesRepo struct {
+	*redis.Redis
+}
+
+// Reserve marks nonce as used within session for ttl. Returns storage.ErrAlreadyExists if nonce was already used
+func (repo *SessionProofNoncesRepo) Reserve(ctx context.Context, sessionId string, nonce string, ttl time.Duration) error {
+	reserved, err := repo.SetNX(ctx, prefixSessionProofNonce(sessionId, nonce), 1, ttl).Result()
+	if err != nil {
+		return mapError(err)
+	}
+	if !reserved {
+		return storage.ErrAlreadyExists
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package redisrepos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveSessionProofNonce(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	sessionId := gofakeit.UUID()
+	nonce := gofakeit.LetterN(16)
+
+	err := testSuite.SessionProofNonces.Reserve(ctx, sessionId, nonce, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("replay", func(t *testing.T) {
+		err := testSuite.SessionProofNonces.Reserve(ctx, sessionId, nonce, time.Minute)
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+
+	t.Run("same nonce within another session", func(t *testing.T) {
+		err := testSuite.SessionProofNonces.Reserve(ctx, gofakeit.UUID(), nonce, time.Minute)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+package redisrepos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type TelegramLinksRepo struct {
+	*redis.Redis
+}
+
+type TelegramLinkData struct {
+	UserId int
+	ChatId string
+}
+
+func (repo *TelegramLinksRepo) CreateWithTTL(ctx context.Context, link *entity.TelegramLink, ttl time.Duration) error {
+	jsonData, err := json.Marshal(TelegramLinkData{UserId: link.UserId, ChatId: link.ChatId})
+	if err != nil {
+		return fmt.Errorf("redisrepos - TelegramLinksRepo.CreateWithTTL - json.Marshal: %w", err)
+	}
+	_, err = repo.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, prefixTelegramLinkByCode(link.Code), string(jsonData), ttl)
+		pipe.Set(ctx, prefixTelegramLinkByUserId(link.UserId), link.Code, ttl)
+		return nil
+	})
+	if err != nil {
+		return mapError(err)
+	}
+	return nil
+}
+
+func (repo *TelegramLinksRepo) GetByCode(ctx context.Context, code string) (*entity.TelegramLink, error) {
+	jsonData, err := repo.Get(ctx, prefixTelegramLinkByCode(code)).Result()
+	if err != nil {
+		return nil, mapError(err)
+	}
+	var data TelegramLinkData
+	if err := json.Unmarshal([]byte(jsonData), &data); err != nil {
+		return nil, fmt.Errorf("redisrepos - TelegramLinksRepo.GetByCode - json.Unmarshal: %w", err)
+	}
+	return &entity.TelegramLink{Code: code, UserId: data.UserId, ChatId: data.ChatId}, nil
+}
+
+func (repo *TelegramLinksRepo) GetByUserId(ctx context.Context, userId int) (*entity.TelegramLink, error) {
+	code, err := repo.Get(ctx, prefixTelegramLinkByUserId(userId)).Result()
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return repo.GetByCode(ctx, code)
+}
+
+// Update overwrites link's data preserving its remaining ttl
+func (repo *TelegramLinksRepo) Update(ctx context.Context, link *entity.TelegramLink) error {
+	jsonData, err := json.Marshal(TelegramLinkData{UserId: link.UserId, ChatId: link.ChatId})
+	if err != nil {
+		return fmt.Errorf("redisrepos - TelegramLinksRepo.Update - json.Marshal: %w", err)
+	}
+	updated, err := repo.SetXX(ctx, prefixTelegramLinkByCode(link.Code), string(jsonData), goredis.KeepTTL).Result()
+	if err != nil {
+		return mapError(err)
+	}
+	if !updated {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (repo *TelegramLinksRepo) Delete(ctx context.Context, link *entity.TelegramLink) error {
+	if err := repo.Del(ctx, prefixTelegramLinkByCode(link.Code), prefixTelegramLinkByUserId(link.UserId)).Err(); err != nil {
+		return mapError(err)
+	}
+	return nil
+}
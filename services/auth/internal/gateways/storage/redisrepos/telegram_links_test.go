@@ -0,0 +1,79 @@
+package redisrepos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage/redisrepos"
+	"github.com/modulix-systems/goose-talk/tests/suite/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTelegramLinkWithTTL(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	expectedLink := helpers.MockTelegramLink()
+
+	err := testSuite.TelegramLinks.CreateWithTTL(ctx, expectedLink, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("by code", func(t *testing.T) {
+		link, err := testSuite.TelegramLinks.GetByCode(ctx, expectedLink.Code)
+		require.NoError(t, err)
+		assert.Equal(t, expectedLink, link)
+	})
+
+	t.Run("by user id", func(t *testing.T) {
+		link, err := testSuite.TelegramLinks.GetByUserId(ctx, expectedLink.UserId)
+		require.NoError(t, err)
+		assert.Equal(t, expectedLink, link)
+	})
+}
+
+func TestUpdateTelegramLink(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		link := helpers.MockTelegramLink()
+		expectedTTL := time.Minute
+		err := testSuite.TelegramLinks.CreateWithTTL(ctx, link, expectedTTL)
+		require.NoError(t, err)
+		link.ChatId = gofakeit.Numerify("#########")
+
+		err = testSuite.TelegramLinks.Update(ctx, link)
+
+		require.NoError(t, err)
+		foundLink, err := testSuite.TelegramLinks.GetByCode(ctx, link.Code)
+		require.NoError(t, err)
+		assert.Equal(t, link.ChatId, foundLink.ChatId)
+		actualTTL, err := testSuite.RedisClient.TTL(ctx, "tg-links:code:"+link.Code).Result()
+		require.NoError(t, err)
+		assert.Equal(t, expectedTTL, actualTTL)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := testSuite.TelegramLinks.Update(ctx, helpers.MockTelegramLink())
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestDeleteTelegramLink(t *testing.T) {
+	testSuite := redisrepos.NewTestSuite(t)
+	ctx := context.Background()
+	link := helpers.MockTelegramLink()
+	err := testSuite.TelegramLinks.CreateWithTTL(ctx, link, time.Minute)
+	require.NoError(t, err)
+
+	err = testSuite.TelegramLinks.Delete(ctx, link)
+
+	require.NoError(t, err)
+	_, err = testSuite.TelegramLinks.GetByCode(ctx, link.Code)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = testSuite.TelegramLinks.GetByUserId(ctx, link.UserId)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
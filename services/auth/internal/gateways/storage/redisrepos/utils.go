@@ -1,11 +1,14 @@
 package redisrepos
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/redis/go-redis/v9"
 )
@@ -25,16 +28,53 @@ func prefixOtpByUserId(userId int) string {
 	return fmt.Sprintf("otp:user:%d", userId)
 }
 
+func prefixOtpByPurpose(purpose entity.OTPPurpose, userId int) string {
+	return fmt.Sprintf("otp:%s:%d", purpose, userId)
+}
+
 func prefixPasskeySession(userId int) string {
 	return fmt.Sprintf("passkey-sessions:%d", userId)
 }
 
+func prefixTelegramLinkByCode(code string) string {
+	return fmt.Sprintf("tg-links:code:%s", code)
+}
+
+func prefixTelegramLinkByUserId(userId int) string {
+	return fmt.Sprintf("tg-links:user:%d", userId)
+}
+
+func prefixSessionProofNonce(sessionId string, nonce string) string {
+	return fmt.Sprintf("session-proof-nonces:%s:%s", sessionId, nonce)
+}
+
+func prefixLoginConfirmation(sessionId string) string {
+	return fmt.Sprintf("login-confirmations:%s", sessionId)
+}
+
+func prefixMagicLink(clientId string) string {
+	return fmt.Sprintf("magic-links:%s", clientId)
+}
+
+func prefixMagicLinkAttempts(clientId string) string {
+	return fmt.Sprintf("magic-link-attempts:%s", clientId)
+}
+
 func prefixQRLoginToken(value string, clientId string) string {
 	return fmt.Sprintf("qrlogin:%s:%s", clientId, value)
 }
 
-func prefixQRLoginTokenSearchByClient(clientId string) string {
-	return fmt.Sprintf("qrlogin:%s:*", clientId)
+func prefixQRLoginTokensIndex(clientId string) string {
+	return fmt.Sprintf("qrlogin-index:%s", clientId)
+}
+
+// prefixQRLoginWatchIndex indexes tokens of a single watch, watchId is empty for tokens exported without watching
+func prefixQRLoginWatchIndex(clientId string, watchId string) string {
+	return fmt.Sprintf("qrlogin-watch-index:%s:%s", clientId, watchId)
+}
+
+func prefixQRLoginAcceptances(watchId string) string {
+	return fmt.Sprintf("qrlogin-acceptances:%s", watchId)
 }
 
 func prefixAuthSession(userId int, sessionId string) string {
@@ -52,6 +92,59 @@ func extractAuthSessionUserId(key string) int {
 	return id
 }
 
-func prefixAuthSessionSearchByUser(userId int) string {
-	return fmt.Sprintf("auth-sessions:%d:*", userId)
-}
\ No newline at end of file
+func prefixAuthSessionsIndex(userId int) string {
+	return fmt.Sprintf("auth-sessions-index:%d", userId)
+}
+
+func prefixAuthSessionByLoginData(userId int, ip string, deviceKey string) string {
+	loginDataHash := sha1.Sum([]byte(ip + "|" + deviceKey))
+	return fmt.Sprintf("auth-sessions-login:%d:%s", userId, hex.EncodeToString(loginDataHash[:]))
+}
+
+// extendTTLScript sets key's ttl to ARGV[1] milliseconds only if it's greater than the current one
+var extendTTLScript = redis.NewScript(`
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl >= 0 and ttl >= tonumber(ARGV[1]) then
+	return 0
+end
+return redis.call("PEXPIRE", KEYS[1], ARGV[1])
+`)
+
+// deleteIfEqualScript deletes key only if it holds ARGV[1]
+var deleteIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// hincrIfExistsScript increments hash field ARGV[1] only if hash exists, returns -1 otherwise
+var hincrIfExistsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+`)
+
+// incrIfEqualScript increments counter KEYS[2] only if KEYS[1] holds ARGV[1], returns -1 otherwise.
+// Counter expires together with KEYS[1]
+var incrIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return -1
+end
+local count = redis.call("INCR", KEYS[2])
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl > 0 then
+	redis.call("PEXPIRE", KEYS[2], ttl)
+end
+return count
+`)
+
+// hsetIfExistsScript sets hash fields from ARGV field-value pairs only if hash exists
+var hsetIfExistsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV))
+return 1
+`)
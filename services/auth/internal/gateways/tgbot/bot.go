@@ -24,7 +24,7 @@ func New(botToken string) (*Client, error) {
 	httpClient := httpclient.New("https://api.telegram.org/bot" + botToken)
 
 	var response GetMeResponse
-	err := httpClient.Get("getMe", url.Values{}, &response)
+	err := httpClient.Get(context.Background(), "getMe", url.Values{}, &response)
 	if err != nil {
 		return nil, fmt.Errorf("tgbot - New - getMe: %w", err)
 	}
@@ -40,28 +40,70 @@ func (c *Client) GetStartLinkWithCode(code string) string {
 }
 
 func (c *Client) SendTextMsg(ctx context.Context, chatId string, text string) error {
-	err := c.httpClient.Post("sendMessage", map[string]string{"chat_id": chatId, "text": text}, nil)
+	err := c.httpClient.Post(ctx, "sendMessage", map[string]string{"chat_id": chatId, "text": text}, nil)
 	if err != nil {
 		return fmt.Errorf("tgbot - SendTextMsg - sendMessage: %w", err)
 	}
 	return nil
 }
 
-func (c *Client) GetLatestMsg(ctx context.Context) (*gateways.TelegramMsg, error) {
+func (c *Client) SendTextMsgWithButtons(ctx context.Context, chatId string, text string, buttons []gateways.TelegramInlineButton) error {
+	keyboardRow := make([]InlineKeyboardButton, 0, len(buttons))
+	for _, button := range buttons {
+		keyboardRow = append(keyboardRow, InlineKeyboardButton{Text: button.Text, CallbackData: button.CallbackData})
+	}
+	body := SendMessageRequest{
+		ChatId:      chatId,
+		Text:        text,
+		ReplyMarkup: &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{keyboardRow}},
+	}
+	if err := c.httpClient.Post(ctx, "sendMessage", body, nil); err != nil {
+		return fmt.Errorf("tgbot - SendTextMsgWithButtons - sendMessage: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) AnswerCallback(ctx context.Context, callbackId string, text string) error {
+	err := c.httpClient.Post(ctx, "answerCallbackQuery", map[string]string{"callback_query_id": callbackId, "text": text}, nil)
+	if err != nil {
+		return fmt.Errorf("tgbot - AnswerCallback - answerCallbackQuery: %w", err)
+	}
+	return nil
+}
+
+// GetUpdates long polls bot updates starting from offset.
+// Updates with id lower than offset are considered confirmed by telegram and won't be returned again
+func (c *Client) GetUpdates(ctx context.Context, offset int, timeout time.Duration) ([]gateways.TelegramUpdate, error) {
 	query := url.Values{}
-	query.Add("allowed_updates", `["message"]`)
-	query.Add("offset", "-1")
+	query.Add("allowed_updates", `["message","callback_query"]`)
+	query.Add("offset", strconv.Itoa(offset))
+	query.Add("timeout", strconv.Itoa(int(timeout.Seconds())))
 
 	var response GetUpdatesResponse
-	err := c.httpClient.Get("getUpdates", query, &response)
+	err := c.httpClient.Get(ctx, "getUpdates", query, &response)
 	if err != nil {
-		return nil, fmt.Errorf("tgbot - GetLatestMsg - getUpdates: %w", err)
+		return nil, fmt.Errorf("tgbot - GetUpdates - getUpdates: %w", err)
 	}
 
-	message := response.Result[0].Message
-	return &gateways.TelegramMsg{
-		DateSent: time.Unix(int64(message.Date), 0),
-		Text:     message.Text,
-		ChatId:   strconv.Itoa(message.Chat.ID),
-	}, nil
+	updates := make([]gateways.TelegramUpdate, 0, len(response.Result))
+	for _, result := range response.Result {
+		update := gateways.TelegramUpdate{Id: result.UpdateID}
+		if result.Message != nil {
+			update.Message = &gateways.TelegramMsg{
+				DateSent: time.Unix(int64(result.Message.Date), 0),
+				Text:     result.Message.Text,
+				ChatId:   strconv.Itoa(result.Message.Chat.ID),
+			}
+		}
+		if result.CallbackQuery != nil {
+			update.Callback = &gateways.TelegramCallback{
+				Id:     result.CallbackQuery.ID,
+				ChatId: strconv.Itoa(result.CallbackQuery.Message.Chat.ID),
+				Data:   result.CallbackQuery.Data,
+			}
+		}
+		updates = append(updates, update)
+	}
+
+	return updates, nil
 }
@@ -1,27 +1,34 @@
 package tgbot
 
+type Message struct {
+	MessageID int `json:"message_id"`
+	From      struct {
+		ID           int    `json:"id"`
+		IsBot        bool   `json:"is_bot"`
+		FirstName    string `json:"first_name"`
+		Username     string `json:"username"`
+		LanguageCode string `json:"language_code"`
+	} `json:"from"`
+	Chat struct {
+		ID        int    `json:"id"`
+		FirstName string `json:"first_name"`
+		Username  string `json:"username"`
+		Type      string `json:"type"`
+	} `json:"chat"`
+	Date int    `json:"date"`
+	Text string `json:"text"`
+}
+
 type GetUpdatesResponse struct {
 	Ok     bool `json:"ok"`
 	Result []struct {
-		UpdateID int `json:"update_id"`
-		Message  struct {
-			MessageID int `json:"message_id"`
-			From      struct {
-				ID           int    `json:"id"`
-				IsBot        bool   `json:"is_bot"`
-				FirstName    string `json:"first_name"`
-				Username     string `json:"username"`
-				LanguageCode string `json:"language_code"`
-			} `json:"from"`
-			Chat struct {
-				ID        int    `json:"id"`
-				FirstName string `json:"first_name"`
-				Username  string `json:"username"`
-				Type      string `json:"type"`
-			} `json:"chat"`
-			Date int    `json:"date"`
-			Text string `json:"text"`
-		} `json:"message"`
+		UpdateID      int      `json:"update_id"`
+		Message       *Message `json:"message"`
+		CallbackQuery *struct {
+			ID      string  `json:"id"`
+			Data    string  `json:"data"`
+			Message Message `json:"message"`
+		} `json:"callback_query"`
 	} `json:"result"`
 }
 
@@ -40,3 +47,18 @@ type GetMeResponse struct {
 		HasTopicsEnabled        bool   `json:"has_topics_enabled"`
 	} `json:"result"`
 }
+
+type InlineKeyboardButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data"`
+}
+
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+type SendMessageRequest struct {
+	ChatId      string                `json:"chat_id"`
+	Text        string                `json:"text"`
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
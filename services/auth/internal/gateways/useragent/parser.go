@@ -0,0 +1,113 @@
+package useragent
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+)
+
+// Parser extracts structured device information from user agent strings
+// sent by browsers and native applications
+type Parser struct{}
+
+func New() *Parser {
+	return &Parser{}
+}
+
+type pattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+var (
+	botRe     = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|headless`)
+	productRe = regexp.MustCompile(`^([A-Za-z][\w.\-]*)/([\w.]+)`)
+	tabletRe  = regexp.MustCompile(`(?i)ipad|tablet|kindle|silk`)
+	mobileRe  = regexp.MustCompile(`(?i)mobi|iphone|ipod|phone`)
+
+	// order matters: more specific patterns go first
+	platformPatterns = []pattern{
+		{"Windows Phone", regexp.MustCompile(`Windows Phone(?: OS)? ([\d.]+)`)},
+		{"Windows", regexp.MustCompile(`Windows NT ([\d.]+)`)},
+		{"iPadOS", regexp.MustCompile(`iPad.*? OS ([\d_]+)`)},
+		{"iOS", regexp.MustCompile(`(?:iPhone|iPod).*? OS ([\d_]+)`)},
+		{"iOS", regexp.MustCompile(`\biOS ([\d.]+)`)},
+		{"Android", regexp.MustCompile(`Android ?([\d.]*)`)},
+		{"ChromeOS", regexp.MustCompile(`CrOS \S+ ([\d.]+)`)},
+		{"macOS", regexp.MustCompile(`Mac OS X ?([\d_.]*)`)},
+		{"macOS", regexp.MustCompile(`\bmacOS ?([\d.]*)`)},
+		{"Linux", regexp.MustCompile(`Linux()`)},
+	}
+	clientPatterns = []pattern{
+		{"Edge", regexp.MustCompile(`(?:Edg|Edge|EdgA|EdgiOS)/([\d.]+)`)},
+		{"Opera", regexp.MustCompile(`(?:OPR|Opera)/([\d.]+)`)},
+		{"Yandex Browser", regexp.MustCompile(`YaBrowser/([\d.]+)`)},
+		{"Samsung Internet", regexp.MustCompile(`SamsungBrowser/([\d.]+)`)},
+		{"Firefox", regexp.MustCompile(`(?:Firefox|FxiOS)/([\d.]+)`)},
+		{"Chrome", regexp.MustCompile(`(?:CriOS|Chrome)/([\d.]+)`)},
+		{"Safari", regexp.MustCompile(`Version/([\d.]+).*Safari/`)},
+	}
+	windowsVersions = map[string]string{
+		"10.0": "10",
+		"6.3":  "8.1",
+		"6.2":  "8",
+		"6.1":  "7",
+		"6.0":  "Vista",
+		"5.1":  "XP",
+	}
+)
+
+// Parse never fails, unknown parts of user agent are left empty
+func (p *Parser) Parse(userAgent string) entity.Device {
+	userAgent = strings.TrimSpace(userAgent)
+	device := entity.Device{Type: entity.DEVICE_TYPE_UNKNOWN}
+	if userAgent == "" {
+		return device
+	}
+
+	device.Platform, device.OsVersion = matchFirst(platformPatterns, userAgent)
+	device.OsVersion = strings.ReplaceAll(device.OsVersion, "_", ".")
+	if device.Platform == "Windows" {
+		if version, ok := windowsVersions[device.OsVersion]; ok {
+			device.OsVersion = version
+		}
+	}
+
+	if strings.HasPrefix(userAgent, "Mozilla/") {
+		device.Client, device.ClientVersion = matchFirst(clientPatterns, userAgent)
+	} else if match := productRe.FindStringSubmatch(userAgent); match != nil {
+		// native applications and http clients usually put their own name first
+		device.Client, device.ClientVersion = match[1], match[2]
+	}
+
+	device.Type = detectType(userAgent, device.Platform)
+	return device
+}
+
+func matchFirst(patterns []pattern, userAgent string) (string, string) {
+	for _, p := range patterns {
+		if match := p.re.FindStringSubmatch(userAgent); match != nil {
+			return p.name, match[1]
+		}
+	}
+	return "", ""
+}
+
+func detectType(userAgent string, platform string) entity.DeviceType {
+	switch {
+	case botRe.MatchString(userAgent):
+		return entity.DEVICE_TYPE_BOT
+	case tabletRe.MatchString(userAgent) || platform == "iPadOS":
+		return entity.DEVICE_TYPE_TABLET
+	case mobileRe.MatchString(userAgent) || platform == "iOS":
+		return entity.DEVICE_TYPE_MOBILE
+	// android devices without "Mobile" token are tablets
+	case platform == "Android":
+		return entity.DEVICE_TYPE_TABLET
+	case platform != "":
+		return entity.DEVICE_TYPE_DESKTOP
+	default:
+		return entity.DEVICE_TYPE_UNKNOWN
+	}
+}
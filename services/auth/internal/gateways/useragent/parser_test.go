@@ -0,0 +1,87 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	parser := New()
+	testCases := []struct {
+		name      string
+		userAgent string
+		expected  entity.Device
+	}{
+		{
+			name:      "chrome on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.130 Safari/537.36",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_DESKTOP, Platform: "Windows", OsVersion: "10", Client: "Chrome", ClientVersion: "120.0.6099.130"},
+		},
+		{
+			name:      "edge on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_DESKTOP, Platform: "Windows", OsVersion: "10", Client: "Edge", ClientVersion: "120.0.2210.91"},
+		},
+		{
+			name:      "safari on macos",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_DESKTOP, Platform: "macOS", OsVersion: "10.15.7", Client: "Safari", ClientVersion: "17.2"},
+		},
+		{
+			name:      "safari on iphone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_MOBILE, Platform: "iOS", OsVersion: "17.2", Client: "Safari", ClientVersion: "17.2"},
+		},
+		{
+			name:      "chrome on ipad",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Mobile/15E148 Safari/604.1",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_TABLET, Platform: "iPadOS", OsVersion: "16.6", Client: "Chrome", ClientVersion: "119.0.6045.169"},
+		},
+		{
+			name:      "firefox on android phone",
+			userAgent: "Mozilla/5.0 (Android 14; Mobile; rv:121.0) Gecko/121.0 Firefox/121.0",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_MOBILE, Platform: "Android", OsVersion: "14", Client: "Firefox", ClientVersion: "121.0"},
+		},
+		{
+			name:      "android tablet",
+			userAgent: "Mozilla/5.0 (Linux; Android 13; SM-X710) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_TABLET, Platform: "Android", OsVersion: "13", Client: "Chrome", ClientVersion: "120.0.0.0"},
+		},
+		{
+			name:      "firefox on linux",
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_DESKTOP, Platform: "Linux", Client: "Firefox", ClientVersion: "121.0"},
+		},
+		{
+			name:      "native app",
+			userAgent: "GooseTalk/1.4.2 (iPhone; iOS 17.1)",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_MOBILE, Platform: "iOS", OsVersion: "17.1", Client: "GooseTalk", ClientVersion: "1.4.2"},
+		},
+		{
+			name:      "bot",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_BOT},
+		},
+		{
+			name:      "unknown",
+			userAgent: "some device",
+			expected:  entity.Device{Type: entity.DEVICE_TYPE_UNKNOWN},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parser.Parse(tc.userAgent))
+		})
+	}
+}
+
+func TestDeviceFamilyIgnoresVersions(t *testing.T) {
+	parser := New()
+	oldDevice := parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36")
+	newDevice := parser.Parse("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	assert.Equal(t, oldDevice.Family(), newDevice.Family())
+	assert.Equal(t, "Chrome 120 on Windows 10", newDevice.Name())
+}
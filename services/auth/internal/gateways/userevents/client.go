@@ -0,0 +1,95 @@
+package userevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	usersContracts "github.com/modulix-systems/goose-talk/contracts/rmqcontracts/users"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rabbitmq"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// Client publishes account lifecycle events for other services
+type Client struct {
+	channel   *amqp091.Channel
+	contracts *usersContracts.Contracts
+	log       logger.Interface
+}
+
+func New(rmq *rabbitmq.RabbitMQ, log logger.Interface) (*Client, error) {
+	op := "userevents.Client.New"
+	channel, err := rmq.NewChannel()
+	if err != nil {
+		return nil, fmt.Errorf("%s - rmq.NewChannel: %w", op, err)
+	}
+
+	contracts := usersContracts.New()
+
+	if err := rmq.ExchangeDeclare(contracts.Exchanges.Events, channel); err != nil {
+		return nil, fmt.Errorf("%s - rmq.ExchangeDeclare declare events exchange: %w", op, err)
+	}
+	// reply queue must exist before any service answers export request
+	if _, err := rmq.QueueDeclare(contracts.Queues.DataExportParts, channel); err != nil {
+		return nil, fmt.Errorf("%s - rmq.QueueDeclare declare data export parts queue: %w", op, err)
+	}
+
+	return &Client{channel: channel, contracts: contracts, log: log}, nil
+}
+
+func (c *Client) publishEvent(ctx context.Context, typ usersContracts.EventType, payload any) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "userevents.Client.publishEvent"
+	log := c.log.With("op", op, "correlationId", correlationId, "typ", typ)
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		log.Error("marshal payload failed", "err", err)
+		return fmt.Errorf("%s - marshal payload %s: %w", op, typ, err)
+	}
+	message := usersContracts.EventMessage{
+		Type:       typ,
+		OccurredAt: time.Now().UTC(),
+		Data:       payloadJson,
+	}
+	messageJson, err := json.Marshal(message)
+	if err != nil {
+		log.Error("marshal event message failed", "err", err)
+		return fmt.Errorf("%s - marshal event message: %w", op, err)
+	}
+
+	publishing := amqp091.Publishing{
+		Body:          messageJson,
+		CorrelationId: correlationId,
+		DeliveryMode:  amqp091.Persistent,
+	}
+	exchange := c.contracts.Exchanges.Events.Name
+	if err = c.channel.PublishWithContext(ctx, exchange, string(typ), false, false, publishing); err != nil {
+		log.Error("rmq publish failed", "err", err)
+		return fmt.Errorf("%s - publish to exchange: %w", op, err)
+	}
+	log.Info("User event published")
+
+	return nil
+}
+
+func (c *Client) PublishUserDeleted(ctx context.Context, userId int, deletedAt time.Time) error {
+	payload := usersContracts.UserDeletedEvent{
+		UserId:    userId,
+		DeletedAt: deletedAt,
+	}
+
+	return c.publishEvent(ctx, usersContracts.EVENT_TYPE_USER_DELETED, payload)
+}
+
+// PublishDataExportRequested asks other services to send user's data they hold to data export parts queue
+func (c *Client) PublishDataExportRequested(ctx context.Context, exportId string, userId int) error {
+	payload := usersContracts.DataExportRequestedEvent{
+		ExportId:   exportId,
+		UserId:     userId,
+		ReplyQueue: c.contracts.Queues.DataExportParts.Name,
+	}
+
+	return c.publishEvent(ctx, usersContracts.EVENT_TYPE_USER_DATA_EXPORT_REQUESTED, payload)
+}
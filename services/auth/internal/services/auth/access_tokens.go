@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// accessTokenPrefix makes tokens recognizable e.g by secret scanners
+const accessTokenPrefix = "gt_pat_"
+
+// CreateAccessToken issues named token for non-interactive access limited by scopes.
+// Plain token is returned only once, its hash is stored
+func (s *Service) CreateAccessToken(ctx context.Context, dto *dtos.CreateAccessTokenRequest) (*dtos.CreateAccessTokenResponse, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.CreateAccessToken"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("CreateAccessToken finished", "duration", time.Since(start)) }()
+
+	if dto.ExpiresAt.Before(time.Now()) || time.Until(dto.ExpiresAt) > s.accessTokenMaxTTL {
+		return nil, ErrInvalidAccessTokenExpiry
+	}
+	if err := s.requireRecentAuth(ctx, dto.UserId, dto.SessionId); err != nil {
+		return nil, err
+	}
+
+	plainToken := accessTokenPrefix + s.securityProvider.GenerateSecretTokenUrlSafe(config.ACCESS_TOKEN_LENGTH)
+	token, err := s.accessTokensRepo.Create(ctx, &entity.AccessToken{
+		UserId:    dto.UserId,
+		Name:      dto.Name,
+		Prefix:    plainToken[:len(accessTokenPrefix)+config.ACCESS_TOKEN_VISIBLE_PREFIX_LENGTH],
+		TokenHash: s.securityProvider.HashToken(plainToken),
+		Scopes:    dto.Scopes,
+		ExpiresAt: dto.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to create access token", "err", err)
+		return nil, err
+	}
+	log.Debug("created access token", "tokenId", token.Id)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  dto.UserId,
+		Type:    entity.SECURITY_EVENT_ACCESS_TOKEN_CREATED,
+		Details: map[string]string{"token_id": strconv.Itoa(token.Id), "name": token.Name},
+	})
+
+	return &dtos.CreateAccessTokenResponse{AccessToken: token, Token: plainToken}, nil
+}
+
+// AuthenticateAccessToken resolves token owner and ensures token grants requiredScope.
+// It is meant to be called for every request authenticated with access token
+func (s *Service) AuthenticateAccessToken(ctx context.Context, plainToken string, requiredScope entity.AccessTokenScope) (*entity.AccessToken, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.AuthenticateAccessToken"
+	log := s.log.With("op", op, "correlationId", correlationId, "scope", requiredScope)
+
+	if !strings.HasPrefix(plainToken, accessTokenPrefix) {
+		return nil, ErrInvalidAccessToken
+	}
+	token, err := s.accessTokensRepo.GetByTokenHash(ctx, s.securityProvider.HashToken(plainToken))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidAccessToken
+		}
+		log.Error("failed to get access token", "err", err)
+		return nil, err
+	}
+	log = log.With("userId", token.UserId, "tokenId", token.Id)
+
+	user, err := s.usersRepo.GetByID(ctx, token.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidAccessToken
+		}
+		log.Error("failed to get token owner", "err", err)
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+	if !token.HasScope(requiredScope) {
+		return nil, ErrInsufficientScope
+	}
+
+	now := time.Now()
+	if err = s.accessTokensRepo.UpdateLastUsedAt(ctx, token.Id, now); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - AuthenticateAccessToken - accessTokensRepo.UpdateLastUsedAt: %w", err),
+			"correlationId", correlationId, "userId", token.UserId, "tokenId", token.Id,
+		)
+	} else {
+		token.LastUsedAt = &now
+	}
+
+	return token, nil
+}
+
+func (s *Service) GetAccessTokens(ctx context.Context, userId int) ([]entity.AccessToken, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetAccessTokens"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetAccessTokens finished", "duration", time.Since(start)) }()
+
+	tokens, err := s.accessTokensRepo.GetAllByUserId(ctx, userId)
+	if err != nil {
+		log.Error("failed to get access tokens", "err", err)
+		return nil, err
+	}
+	log.Debug("fetched access tokens", "count", len(tokens))
+
+	return tokens, nil
+}
+
+func (s *Service) RevokeAccessToken(ctx context.Context, userId int, tokenId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RevokeAccessToken"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "tokenId", tokenId)
+	start := time.Now()
+	defer func() { log.Debug("RevokeAccessToken finished", "duration", time.Since(start)) }()
+
+	if err := s.accessTokensRepo.DeleteById(ctx, userId, tokenId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrAccessTokenNotFound
+		}
+		log.Error("failed to revoke access token", "err", err)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_ACCESS_TOKEN_REVOKED,
+		Details: map[string]string{"token_id": strconv.Itoa(tokenId)},
+	})
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// RequestAccountDeletion schedules erasure of account after grace period, user may cancel it until then.
+// All sessions except the one which requested deletion are signed out and access tokens are revoked
+func (s *Service) RequestAccountDeletion(ctx context.Context, userId int, sessionId string) (time.Time, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestAccountDeletion"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("RequestAccountDeletion finished", "duration", time.Since(start)) }()
+
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return time.Time{}, err
+	}
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return time.Time{}, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return time.Time{}, err
+	}
+	if user.DeletionScheduledAt != nil {
+		return time.Time{}, ErrDeletionAlreadyScheduled
+	}
+
+	scheduledAt := time.Now().UTC().Add(s.deletionGracePeriod)
+	if err = s.usersRepo.ScheduleDeletionById(ctx, userId, scheduledAt); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return time.Time{}, ErrDeletionAlreadyScheduled
+		}
+		log.Error("failed to schedule account deletion", "err", err)
+		return time.Time{}, err
+	}
+	log.Info("account deletion scheduled", "scheduledAt", scheduledAt)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_DELETION_SCHEDULED,
+		Details: map[string]string{"scheduled_at": scheduledAt.Format(time.RFC3339)},
+	})
+
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, userId, sessionId); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - RequestAccountDeletion - sessionsRepo.DeleteAllByUserId: %w", err),
+			"correlationId", correlationId, "userId", userId,
+		)
+	}
+	if err = s.accessTokensRepo.DeleteAllByUserId(ctx, userId); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - RequestAccountDeletion - accessTokensRepo.DeleteAllByUserId: %w", err),
+			"correlationId", correlationId, "userId", userId,
+		)
+	}
+	if err = s.notificationsClient.SendAccountDeletionScheduledEmail(
+		ctx, user.Email, user.GetDisplayName(), scheduledAt, user.Language,
+	); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - RequestAccountDeletion - notificationsClient.SendAccountDeletionScheduledEmail: %w", err),
+			"correlationId", correlationId, "userId", userId,
+		)
+	}
+
+	return scheduledAt, nil
+}
+
+// CancelAccountDeletion keeps account if its grace period is not over yet.
+// Any active session may cancel deletion
+func (s *Service) CancelAccountDeletion(ctx context.Context, userId int, sessionId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.CancelAccountDeletion"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("CancelAccountDeletion finished", "duration", time.Since(start)) }()
+
+	if _, err := s.sessionsRepo.GetById(ctx, userId, sessionId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrSessionNotFound
+		}
+		log.Error("failed to get session", "err", err)
+		return err
+	}
+	if err := s.usersRepo.CancelDeletionById(ctx, userId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrDeletionNotScheduled
+		}
+		log.Error("failed to cancel account deletion", "err", err)
+		return err
+	}
+	log.Info("account deletion cancelled")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{UserId: userId, Type: entity.SECURITY_EVENT_DELETION_CANCELLED})
+
+	return nil
+}
+
+// ProcessAccountDeletions reminds users about upcoming erasure, erases accounts whose grace period is over
+// and notifies other services about erased accounts. Work is done in batches until nothing is left
+// or a batch fails, remaining work is picked up by the next call
+func (s *Service) ProcessAccountDeletions(ctx context.Context, batchSize int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ProcessAccountDeletions"
+	log := s.log.With("op", op, "correlationId", correlationId)
+	start := time.Now()
+	defer func() { log.Debug("ProcessAccountDeletions finished", "duration", time.Since(start)) }()
+
+	reminded, err := s.sendDeletionReminders(ctx, batchSize)
+	if err != nil {
+		log.Error("failed to send deletion reminders", "err", err)
+		return err
+	}
+	erased, err := s.eraseDueAccounts(ctx, batchSize)
+	if err != nil {
+		log.Error("failed to erase accounts", "err", err)
+		return err
+	}
+	published, err := s.publishDeletedUsers(ctx, batchSize)
+	if err != nil {
+		log.Error("failed to publish deleted users", "err", err)
+		return err
+	}
+	log.Info("processed account deletions", "reminded", reminded, "erased", erased, "published", published)
+
+	return nil
+}
+
+func (s *Service) sendDeletionReminders(ctx context.Context, batchSize int) (int, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	reminded := 0
+	for {
+		users, err := s.usersRepo.GetAllPendingDeletionReminder(ctx, time.Now().Add(s.deletionRemindBefore), batchSize)
+		if err != nil {
+			return reminded, err
+		}
+		failed := 0
+		for _, user := range users {
+			if err = s.notificationsClient.SendAccountDeletionReminderEmail(
+				ctx, user.Email, user.GetDisplayName(), *user.DeletionScheduledAt, user.Language,
+			); err != nil {
+				// reminder is not marked as sent, so it is retried by the next call
+				s.log.Error(
+					fmt.Errorf("AuthService - sendDeletionReminders - notificationsClient.SendAccountDeletionReminderEmail: %w", err),
+					"correlationId", correlationId, "userId", user.Id,
+				)
+				failed++
+				continue
+			}
+			if err = s.usersRepo.UpdateDeletionReminderSentAtById(ctx, user.Id, time.Now()); err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					// deletion was cancelled concurrently
+					continue
+				}
+				return reminded, err
+			}
+			reminded++
+		}
+		// failed reminders are fetched again, so batch where every one of them failed would repeat forever
+		if len(users) < batchSize || failed == len(users) {
+			break
+		}
+	}
+	if reminded > 0 {
+		s.log.Debug("sent account deletion reminders", "correlationId", correlationId, "count", reminded)
+	}
+
+	return reminded, nil
+}
+
+// eraseDueAccounts removes accounts whose grace period is over.
+// Short-lived data kept outside of primary storage is dropped before erasure
+func (s *Service) eraseDueAccounts(ctx context.Context, batchSize int) (int, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	erased := 0
+	for {
+		users, err := s.usersRepo.GetAllDueForDeletion(ctx, batchSize)
+		if err != nil {
+			return erased, err
+		}
+		for _, user := range users {
+			if err = s.sessionsRepo.DeleteAllByUserId(ctx, user.Id, ""); err != nil {
+				return erased, err
+			}
+			for _, otp := range []*entity.OTP{
+				{UserId: user.Id},
+				{UserEmail: user.Email},
+				{UserId: user.Id, Purpose: entity.OTP_PURPOSE_LOGIN_CHALLENGE},
+				{UserId: user.Id, Purpose: entity.OTP_PURPOSE_PASSWORD_RESET},
+				{UserId: user.Id, Purpose: entity.OTP_PURPOSE_REAUTHENTICATION},
+				{UserId: user.Id, Purpose: entity.OTP_PURPOSE_ACCOUNT_REACTIVATION},
+			} {
+				if err = s.otpRepo.Delete(ctx, otp); err != nil && !errors.Is(err, storage.ErrNotFound) {
+					return erased, err
+				}
+			}
+			link, err := s.telegramLinksRepo.GetByUserId(ctx, user.Id)
+			if err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return erased, err
+			}
+			if link != nil {
+				if err = s.telegramLinksRepo.Delete(ctx, link); err != nil {
+					return erased, err
+				}
+			}
+			if err = s.removeDataExportFiles(ctx, user.Id); err != nil {
+				return erased, err
+			}
+
+			if err = s.usersRepo.EraseById(ctx, user.Id); err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					// deletion was cancelled concurrently
+					continue
+				}
+				return erased, err
+			}
+			s.log.Info("account erased", "correlationId", correlationId, "userId", user.Id)
+			erased++
+		}
+		if len(users) < batchSize {
+			break
+		}
+	}
+
+	return erased, nil
+}
+
+// publishDeletedUsers notifies other services about erased accounts which they were not notified about yet
+func (s *Service) publishDeletedUsers(ctx context.Context, batchSize int) (int, error) {
+	published := 0
+	for {
+		deletedUsers, err := s.deletedUsersRepo.GetAllUnpublished(ctx, batchSize)
+		if err != nil {
+			return published, err
+		}
+		for _, deletedUser := range deletedUsers {
+			if err = s.userEvents.PublishUserDeleted(ctx, deletedUser.UserId, deletedUser.DeletedAt); err != nil {
+				return published, err
+			}
+			if err = s.deletedUsersRepo.UpdateEventPublishedAt(ctx, deletedUser.UserId, time.Now()); err != nil {
+				return published, err
+			}
+			published++
+		}
+		if len(deletedUsers) < batchSize {
+			break
+		}
+	}
+
+	return published, nil
+}
@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const accountLockReasonFailedSignIns = "too_many_failed_sign_ins"
+
+// accountStateError returns error explaining why account may not be used or nil if it is active
+func accountStateError(user *entity.User) error {
+	if user.IsActive() {
+		return nil
+	}
+	switch user.EffectiveState() {
+	case entity.ACCOUNT_STATE_LOCKED:
+		return ErrAccountLocked
+	case entity.ACCOUNT_STATE_SUSPENDED:
+		return ErrAccountSuspended
+	case entity.ACCOUNT_STATE_BANNED:
+		return ErrAccountBanned
+	default:
+		return ErrDeactivatedAccount
+	}
+}
+
+// changeAccountState moves account to new state and records the transition in audit log.
+// Sessions and access tokens are revoked if account may not be used in new state, except for lock
+// which only keeps attacker from signing in and must not sign out account owner
+func (s *Service) changeAccountState(
+	ctx context.Context,
+	user *entity.User,
+	change *entity.AccountStateChange,
+) (*entity.User, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	updatedUser, err := s.usersRepo.UpdateStateById(ctx, user.Id, change)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidAccountStateTransition
+		}
+		return nil, err
+	}
+
+	details := map[string]string{"from": string(user.EffectiveState()), "to": string(change.State)}
+	if change.Reason != "" {
+		details["reason"] = change.Reason
+	}
+	if change.Until != nil {
+		details["until"] = change.Until.Format(time.RFC3339)
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  user.Id,
+		Type:    entity.SECURITY_EVENT_ACCOUNT_STATE_CHANGED,
+		Details: details,
+	})
+	s.log.Info(
+		"account state changed",
+		"correlationId", correlationId, "userId", user.Id, "from", details["from"], "to", change.State,
+	)
+
+	if updatedUser.IsActive() || change.State == entity.ACCOUNT_STATE_LOCKED {
+		return updatedUser, nil
+	}
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, user.Id, ""); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - changeAccountState - sessionsRepo.DeleteAllByUserId: %w", err),
+			"correlationId", correlationId, "userId", user.Id,
+		)
+	}
+	if err = s.accessTokensRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - changeAccountState - accessTokensRepo.DeleteAllByUserId: %w", err),
+			"correlationId", correlationId, "userId", user.Id,
+		)
+	}
+
+	return updatedUser, nil
+}
+
+// notifyAccountStateChanged emails user about state of their account. Failures are logged
+func (s *Service) notifyAccountStateChanged(ctx context.Context, user *entity.User) {
+	if err := s.notificationsClient.SendAccountStateChangedEmail(
+		ctx, user.Email, user.GetDisplayName(), string(user.State), user.StateReason, user.StateUntil, user.Language,
+	); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - notifyAccountStateChanged - notificationsClient.SendAccountStateChangedEmail: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id,
+		)
+	}
+}
+
+// lockAccountIfAbused temporarily locks account once all of its recent sign in attempts within lock window failed
+// and their number reached the limit. Attempts made before account's last state change are not counted.
+// Failures are logged and never interrupt sign in
+func (s *Service) lockAccountIfAbused(ctx context.Context, user *entity.User) {
+	if s.maxFailedSignIns <= 0 {
+		return
+	}
+	correlationId := logger.CorrelationIDFromContext(ctx)
+
+	attempts, err := s.securityEventsRepo.GetMany(ctx, &dtos.SecurityEventsFilter{
+		UserId: user.Id,
+		Types:  []entity.SecurityEventType{entity.SECURITY_EVENT_SIGN_IN_FAILED, entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED},
+		Limit:  s.maxFailedSignIns,
+	})
+	if err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - lockAccountIfAbused - securityEventsRepo.GetMany: %w", err),
+			"correlationId", correlationId, "userId", user.Id,
+		)
+		return
+	}
+	if len(attempts) < s.maxFailedSignIns {
+		return
+	}
+	countedSince := time.Now().Add(-s.accountLockWindow)
+	if user.StateChangedAt != nil && user.StateChangedAt.After(countedSince) {
+		countedSince = *user.StateChangedAt
+	}
+	for _, attempt := range attempts {
+		if attempt.Type != entity.SECURITY_EVENT_SIGN_IN_FAILED || attempt.CreatedAt.Before(countedSince) {
+			return
+		}
+	}
+
+	until := time.Now().Add(s.accountLockDuration)
+	lockedUser, err := s.changeAccountState(ctx, user, &entity.AccountStateChange{
+		State:  entity.ACCOUNT_STATE_LOCKED,
+		Reason: accountLockReasonFailedSignIns,
+		Until:  &until,
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidAccountStateTransition) {
+			s.log.Error(
+				fmt.Errorf("AuthService - lockAccountIfAbused - changeAccountState: %w", err),
+				"correlationId", correlationId, "userId", user.Id,
+			)
+		}
+		return
+	}
+	s.notifyAccountStateChanged(ctx, lockedUser)
+}
+
+// RequestAccountReactivation sends code which reactivates deactivated or locked account.
+// It does not reveal whether account with such email exists or what state it is in
+func (s *Service) RequestAccountReactivation(ctx context.Context, email string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestAccountReactivation"
+	log := s.log.With("op", op, "correlationId", correlationId, "email", email)
+	start := time.Now()
+	defer func() { log.Debug("RequestAccountReactivation finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, email)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Debug("reactivation requested for unknown email")
+			return nil
+		}
+		return err
+	}
+	if state := user.EffectiveState(); state != entity.ACCOUNT_STATE_DEACTIVATED && state != entity.ACCOUNT_STATE_LOCKED {
+		log.Debug("reactivation requested for account which can not be reactivated", "userId", user.Id, "state", state)
+		return nil
+	}
+
+	otpCode, err := s.createScopedOtp(ctx, entity.OTP_PURPOSE_ACCOUNT_REACTIVATION, user.Id, "")
+	if err != nil {
+		log.Error("failed to create reactivation code", "err", err, "userId", user.Id)
+		return err
+	}
+	if err = s.notificationsClient.SendAccountReactivationEmail(
+		ctx, user.Email, user.GetDisplayName(), otpCode, user.Language,
+	); err != nil {
+		log.Error("failed to send reactivation code", "err", err, "userId", user.Id)
+		return err
+	}
+	log.Debug("reactivation code sent", "userId", user.Id)
+
+	return nil
+}
+
+// ReactivateAccount restores deactivated or locked account if code sent to its email is valid.
+// Suspended and banned accounts may only be restored by admin
+func (s *Service) ReactivateAccount(ctx context.Context, dto *dtos.ReactivateAccountRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ReactivateAccount"
+	log := s.log.With("op", op, "correlationId", correlationId, "email", dto.Email)
+	start := time.Now()
+	defer func() { log.Debug("ReactivateAccount finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, dto.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrOtpIsNotValid
+		}
+		return err
+	}
+
+	if err = s.consumeScopedOtp(ctx, entity.OTP_PURPOSE_ACCOUNT_REACTIVATION, user.Id, "", dto.Code); err != nil {
+		log.Error("invalid reactivation code", "err", err, "userId", user.Id)
+		return err
+	}
+
+	switch user.EffectiveState() {
+	case entity.ACCOUNT_STATE_DEACTIVATED, entity.ACCOUNT_STATE_LOCKED:
+	case entity.ACCOUNT_STATE_ACTIVE, entity.ACCOUNT_STATE_PENDING_DELETION:
+		// lock expired while code was delivered
+		return nil
+	default:
+		return ErrAccountNotReactivatable
+	}
+
+	if _, err = s.changeAccountState(ctx, user, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_ACTIVE}); err != nil {
+		if errors.Is(err, ErrInvalidAccountStateTransition) {
+			return ErrAccountNotReactivatable
+		}
+		log.Error("failed to reactivate account", "err", err, "userId", user.Id)
+		return err
+	}
+	log.Info("account reactivated", "userId", user.Id)
+
+	return nil
+}
+
+// ChangeAccountState suspends, bans or restores account on admin's decision and notifies its owner
+func (s *Service) ChangeAccountState(ctx context.Context, dto *dtos.ChangeAccountStateRequest) (*entity.User, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ChangeAccountState"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId, "state", dto.State)
+	start := time.Now()
+	defer func() { log.Debug("ChangeAccountState finished", "duration", time.Since(start)) }()
+
+	change := &entity.AccountStateChange{State: dto.State, Reason: dto.Reason}
+	if dto.State == entity.ACCOUNT_STATE_SUSPENDED && dto.Until != nil {
+		if !dto.Until.After(time.Now()) {
+			return nil, ErrInvalidAccountStateTransition
+		}
+		change.Until = dto.Until
+	}
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_USERS_WRITE); err != nil {
+		return nil, err
+	}
+	user, err := s.usersRepo.GetByID(ctx, dto.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return nil, err
+	}
+	details := map[string]string{"state": string(change.State), "reason": change.Reason}
+	if change.Until != nil {
+		details["until"] = change.Until.Format(time.RFC3339)
+	}
+	if err = s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId:      dto.AdminId,
+		TargetUserId: user.Id,
+		Action:       entity.ADMIN_ACTION_CHANGE_ACCOUNT_STATE,
+		Details:      details,
+	}); err != nil {
+		return nil, err
+	}
+	user, err = s.changeAccountState(ctx, user, change)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidAccountStateTransition) {
+			log.Error("failed to change account state", "err", err)
+		}
+		return nil, err
+	}
+	s.notifyAccountStateChanged(ctx, user)
+
+	return user, nil
+}
@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rbac"
+)
+
+const (
+	defaultUserSearchLimit      = 20
+	maxUserSearchLimit          = 100
+	defaultAdminActionsPageSize = 20
+	maxAdminActionsPageSize     = 100
+)
+
+// requirePermission ensures that action is performed by active user who was granted permission
+// through one of their roles within recently authenticated session
+func (s *Service) requirePermission(ctx context.Context, userId int, sessionId string, permission rbac.Permission) error {
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return err
+	}
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrPermissionDenied
+		}
+		return err
+	}
+	if !user.IsActive() {
+		return ErrPermissionDenied
+	}
+	granted, err := s.rolesRepo.GetPermissionsByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(granted, permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// recordAdminAction appends action to admin audit log. Unlike security events admin actions
+// must not happen unnoticed, so action is recorded before it is performed and is refused if recording fails
+func (s *Service) recordAdminAction(ctx context.Context, action *entity.AdminAction) error {
+	if _, err := s.adminActionsRepo.Create(ctx, action); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - recordAdminAction - adminActionsRepo.Create: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "adminId", action.AdminId, "action", action.Action,
+		)
+		return err
+	}
+	return nil
+}
+
+// getAdminTarget returns user targeted by admin's action after checking permission and recording the action in audit log
+func (s *Service) getAdminTarget(
+	ctx context.Context,
+	dto *dtos.AdminUserRequest,
+	permission rbac.Permission,
+	action entity.AdminActionType,
+) (*entity.User, error) {
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, permission); err != nil {
+		return nil, err
+	}
+	user, err := s.usersRepo.GetByIDWithPasskeyCredentials(ctx, dto.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if err = s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId:      dto.AdminId,
+		TargetUserId: user.Id,
+		Action:       action,
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// SearchUsers finds accounts by exact id or by email or username prefix
+func (s *Service) SearchUsers(ctx context.Context, dto *dtos.AdminSearchUsersRequest) ([]entity.User, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.SearchUsers"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId)
+	start := time.Now()
+	defer func() { log.Debug("SearchUsers finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_USERS_READ); err != nil {
+		return nil, err
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId: dto.AdminId,
+		Action:  entity.ADMIN_ACTION_SEARCH_USERS,
+		Details: map[string]string{"query": dto.Query},
+	}); err != nil {
+		return nil, err
+	}
+
+	limit := dto.Limit
+	if limit <= 0 {
+		limit = defaultUserSearchLimit
+	}
+	limit = min(limit, maxUserSearchLimit)
+	users, err := s.usersRepo.Search(ctx, dto.Query, limit)
+	if err != nil {
+		log.Error("failed to search users", "err", err)
+		return nil, err
+	}
+	log.Debug("found users", "count", len(users))
+
+	return users, nil
+}
+
+// GetUserDetails returns account with its 2FA, passkeys and active sessions
+func (s *Service) GetUserDetails(ctx context.Context, dto *dtos.AdminUserRequest) (*dtos.AdminUserDetails, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetUserDetails"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("GetUserDetails finished", "duration", time.Since(start)) }()
+
+	user, err := s.getAdminTarget(ctx, dto, entity.PERMISSION_USERS_READ, entity.ADMIN_ACTION_VIEW_USER)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionsRepo.GetAllByUserId(ctx, user.Id)
+	if err != nil {
+		log.Error("failed to get sessions", "err", err)
+		return nil, err
+	}
+
+	return &dtos.AdminUserDetails{User: user, Sessions: sessions}, nil
+}
+
+// ForceLogout revokes all sessions, access tokens and trusted devices of user,
+// so that none of them can be used to get back in without signing in again
+func (s *Service) ForceLogout(ctx context.Context, dto *dtos.AdminUserRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ForceLogout"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("ForceLogout finished", "duration", time.Since(start)) }()
+
+	user, err := s.getAdminTarget(ctx, dto, entity.PERMISSION_USERS_WRITE, entity.ADMIN_ACTION_FORCE_LOGOUT)
+	if err != nil {
+		return err
+	}
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, user.Id, ""); err != nil {
+		log.Error("failed to delete sessions", "err", err)
+		return err
+	}
+	if err = s.accessTokensRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke access tokens", "err", err)
+		return err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke trusted devices", "err", err)
+		return err
+	}
+	log.Info("user logged out by admin")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  user.Id,
+		Type:    entity.SECURITY_EVENT_ALL_SESSIONS_REVOKED,
+		Details: map[string]string{"admin_id": strconv.Itoa(dto.AdminId)},
+	})
+
+	return nil
+}
+
+// ResetTwoFa removes 2FA of user who lost access to it, so that it can be set up again after sign in.
+// Trusted devices are revoked as well since they were trusted to skip removed 2FA
+func (s *Service) ResetTwoFa(ctx context.Context, dto *dtos.AdminUserRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ResetTwoFa"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("ResetTwoFa finished", "duration", time.Since(start)) }()
+
+	user, err := s.getAdminTarget(ctx, dto, entity.PERMISSION_USERS_WRITE, entity.ADMIN_ACTION_RESET_TWO_FA)
+	if err != nil {
+		return err
+	}
+	if err = s.usersRepo.DeleteTwoFaByUserId(ctx, user.Id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return Err2FANotEnabled
+		}
+		log.Error("failed to delete 2FA", "err", err)
+		return err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke trusted devices", "err", err)
+		return err
+	}
+	log.Info("2FA reset by admin")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  user.Id,
+		Type:    entity.SECURITY_EVENT_TWO_FA_DISABLED,
+		Details: map[string]string{"admin_id": strconv.Itoa(dto.AdminId)},
+	})
+
+	return nil
+}
+
+// TriggerPasswordReset refuses password sign in until user resets password with code sent to their email
+func (s *Service) TriggerPasswordReset(ctx context.Context, dto *dtos.AdminUserRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.TriggerPasswordReset"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("TriggerPasswordReset finished", "duration", time.Since(start)) }()
+
+	user, err := s.getAdminTarget(ctx, dto, entity.PERMISSION_USERS_WRITE, entity.ADMIN_ACTION_RESET_PASSWORD)
+	if err != nil {
+		return err
+	}
+	if err = s.usersRepo.UpdateMustResetPasswordById(ctx, user.Id, true); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		log.Error("failed to require password reset", "err", err)
+		return err
+	}
+	if err = s.sendPasswordResetCode(ctx, user); err != nil {
+		log.Error("failed to send password reset code", "err", err)
+		return err
+	}
+	log.Info("password reset triggered by admin")
+
+	return nil
+}
+
+// GetAdminActions returns page of admin audit log matching the filter
+func (s *Service) GetAdminActions(
+	ctx context.Context,
+	adminId int,
+	sessionId string,
+	filter *dtos.AdminActionsFilter,
+) (*dtos.AdminActionsPage, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetAdminActions"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", adminId)
+	start := time.Now()
+	defer func() { log.Debug("GetAdminActions finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, adminId, sessionId, entity.PERMISSION_ADMIN_ACTIONS_READ); err != nil {
+		return nil, err
+	}
+
+	pageSize := filter.Limit
+	if pageSize <= 0 {
+		pageSize = defaultAdminActionsPageSize
+	}
+	pageSize = min(pageSize, maxAdminActionsPageSize)
+
+	// fetch one extra action to find out whether next page exists
+	query := *filter
+	query.Limit = pageSize + 1
+	actions, err := s.adminActionsRepo.GetMany(ctx, &query)
+	if err != nil {
+		log.Error("failed to get admin actions", "err", err)
+		return nil, err
+	}
+
+	page := &dtos.AdminActionsPage{Actions: actions}
+	if len(actions) > pageSize {
+		page.Actions = actions[:pageSize]
+		page.NextCursor = page.Actions[pageSize-1].Id
+	}
+
+	return page, nil
+}
+
+// SearchSecurityEvents returns page of security events of any account matching the filter
+func (s *Service) SearchSecurityEvents(
+	ctx context.Context,
+	adminId int,
+	sessionId string,
+	filter *dtos.SecurityEventsFilter,
+) (*dtos.SecurityEventsPage, error) {
+	if errs := filter.Validate(); len(errs) > 0 {
+		return nil, ErrInvalidSecurityEventsFilter
+	}
+	if err := s.requirePermission(ctx, adminId, sessionId, entity.PERMISSION_USERS_READ); err != nil {
+		return nil, err
+	}
+	types := make([]string, 0, len(filter.Types))
+	for _, typ := range filter.Types {
+		types = append(types, string(typ))
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId:      adminId,
+		TargetUserId: filter.UserId,
+		Action:       entity.ADMIN_ACTION_VIEW_SECURITY_EVENTS,
+		Details:      map[string]string{"ip_addr": filter.IpAddr, "types": strings.Join(types, ",")},
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetSecurityEvents(ctx, filter)
+}
@@ -2,32 +2,83 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/modulix-systems/goose-talk/internal/config"
 	"github.com/modulix-systems/goose-talk/internal/entity"
 	"github.com/modulix-systems/goose-talk/internal/gateways"
 	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
 	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
 )
 
 type Service struct {
-	usersRepo           gateways.UsersRepo
-	notificationsClient gateways.NotificationsClient
-	tgApi               gateways.TelegramBotClient
-	securityProvider    gateways.SecurityProvider
-	otpRepo             gateways.OtpRepo
-	passkeySessionsRepo gateways.PasskeySessionsRepo
-	otpTTL              time.Duration
-	defaultSessionTTL   time.Duration
-	longLivedSessionTTL time.Duration
-	loginTokenTTL       time.Duration
-	sessionsRepo        gateways.AuthSessionsRepo
-	geoIpApi            gateways.GeoIpApi
-	loginTokenRepo      gateways.QRLoginTokenRepo
-	webAuthnProvider    gateways.WebAuthnProvider
-	log                 logger.Interface
+	usersRepo                gateways.UsersRepo
+	notificationsClient      gateways.NotificationsClient
+	tgApi                    gateways.TelegramBotClient
+	securityProvider         gateways.SecurityProvider
+	keyRing                  gateways.KeyRing
+	otpRepo                  gateways.OtpRepo
+	passkeySessionsRepo      gateways.PasskeySessionsRepo
+	telegramLinksRepo        gateways.TelegramLinksRepo
+	securityEventsRepo       gateways.SecurityEventsRepo
+	loginConfirmationsRepo   gateways.LoginConfirmationsRepo
+	trustedDevicesRepo       gateways.TrustedDevicesRepo
+	sessionProofNoncesRepo   gateways.SessionProofNoncesRepo
+	accessTokensRepo         gateways.AccessTokensRepo
+	keyDirectoryRepo         gateways.KeyDirectoryRepo
+	deletedUsersRepo         gateways.DeletedUsersRepo
+	dataExportsRepo          gateways.DataExportsRepo
+	adminActionsRepo         gateways.AdminActionsRepo
+	rolesRepo                gateways.RolesRepo
+	magicLinksRepo           gateways.MagicLinksRepo
+	invitesRepo              gateways.InvitesRepo
+	fileStorage              gateways.FileStorage
+	qrCodeRenderer           gateways.QRCodeRenderer
+	tokenProvider            gateways.TokenProvider
+	otpTTL                   time.Duration
+	defaultSessionTTL        time.Duration
+	longLivedSessionTTL      time.Duration
+	loginConfirmationTTL     time.Duration
+	trustedDeviceTTL         time.Duration
+	reauthWindow             time.Duration
+	sessionProofMaxSkew      time.Duration
+	accessTokenMaxTTL        time.Duration
+	signedPrekeyMaxAge       time.Duration
+	deletionGracePeriod      time.Duration
+	deletionRemindBefore     time.Duration
+	dataExportCollectTimeout time.Duration
+	dataExportTTL            time.Duration
+	accountLockWindow        time.Duration
+	accountLockDuration      time.Duration
+	magicLinkTTL             time.Duration
+	inviteMaxTTL             time.Duration
+	loginTokenTTL            time.Duration
+	sessionsRepo             gateways.AuthSessionsRepo
+	geoIpApi                 gateways.GeoIpApi
+	userAgentParser          gateways.UserAgentParser
+	riskyNetworks            gateways.RiskyNetworksList
+	securityAlerts           gateways.SecurityAlertsPublisher
+	userEvents               gateways.UserEventsPublisher
+	loginRiskThreshold       int
+	maxSessions              int
+	maxLongLivedSessions     int
+	sessionLimitPolicy       entity.SessionLimitPolicy
+	passwordPolicy           *validator.PasswordPolicy
+	maxOneTimePrekeys        int
+	prekeysLowThreshold      int
+	dataExportServices       []string
+	maxFailedSignIns         int
+	appUrl                   *url.URL
+	invitesRequired          bool
+	inviteMaxUses            int
+	loginTokenRepo           gateways.QRLoginTokenRepo
+	webAuthnProvider         gateways.WebAuthnProvider
+	log                      logger.Interface
 }
 
 func New(
@@ -36,39 +87,146 @@ func New(
 	loginTokenRepo gateways.QRLoginTokenRepo,
 	otpRepo gateways.OtpRepo,
 	passkeySessionRepo gateways.PasskeySessionsRepo,
+	telegramLinksRepo gateways.TelegramLinksRepo,
+	securityEventsRepo gateways.SecurityEventsRepo,
+	loginConfirmationsRepo gateways.LoginConfirmationsRepo,
+	trustedDevicesRepo gateways.TrustedDevicesRepo,
+	sessionProofNoncesRepo gateways.SessionProofNoncesRepo,
+	accessTokensRepo gateways.AccessTokensRepo,
+	keyDirectoryRepo gateways.KeyDirectoryRepo,
+	deletedUsersRepo gateways.DeletedUsersRepo,
+	dataExportsRepo gateways.DataExportsRepo,
+	adminActionsRepo gateways.AdminActionsRepo,
+	rolesRepo gateways.RolesRepo,
+	magicLinksRepo gateways.MagicLinksRepo,
+	invitesRepo gateways.InvitesRepo,
 
 	notificationsClient gateways.NotificationsClient,
 	webAuthnProvider gateways.WebAuthnProvider,
 	securityProvider gateways.SecurityProvider,
+	keyRing gateways.KeyRing,
 	tgApi gateways.TelegramBotClient,
 	geoIpApi gateways.GeoIpApi,
+	userAgentParser gateways.UserAgentParser,
+	riskyNetworks gateways.RiskyNetworksList,
+	securityAlerts gateways.SecurityAlertsPublisher,
+	userEvents gateways.UserEventsPublisher,
+	tokenProvider gateways.TokenProvider,
+	fileStorage gateways.FileStorage,
+	qrCodeRenderer gateways.QRCodeRenderer,
 
 	otpTTL time.Duration,
 	loginTokenTTL time.Duration,
 	defaultSessionTTL time.Duration,
 	longLivedSessionTTL time.Duration,
+	loginConfirmationTTL time.Duration,
+	trustedDeviceTTL time.Duration,
+	reauthWindow time.Duration,
+	sessionProofMaxSkew time.Duration,
+	accessTokenMaxTTL time.Duration,
+	signedPrekeyMaxAge time.Duration,
+	deletionGracePeriod time.Duration,
+	deletionRemindBefore time.Duration,
+	dataExportCollectTimeout time.Duration,
+	dataExportTTL time.Duration,
+	accountLockWindow time.Duration,
+	accountLockDuration time.Duration,
+	magicLinkTTL time.Duration,
+	inviteMaxTTL time.Duration,
+	loginRiskThreshold int,
+	maxSessions int,
+	maxLongLivedSessions int,
+	sessionLimitPolicy entity.SessionLimitPolicy,
+	passwordPolicy *validator.PasswordPolicy,
+	maxOneTimePrekeys int,
+	prekeysLowThreshold int,
+	dataExportServices []string,
+	maxFailedSignIns int,
+	appUrl *url.URL,
+	invitesRequired bool,
+	inviteMaxUses int,
 
 	log logger.Interface,
 ) *Service {
 	return &Service{
-		usersRepo:           usersRepo,
-		passkeySessionsRepo: passkeySessionRepo,
-		notificationsClient: notificationsClient,
-		otpRepo:             otpRepo,
-		otpTTL:              otpTTL,
-		defaultSessionTTL:   defaultSessionTTL,
-		longLivedSessionTTL: longLivedSessionTTL,
-		loginTokenTTL:       loginTokenTTL,
-		securityProvider:    securityProvider,
-		tgApi:               tgApi,
-		sessionsRepo:        sessionsRepo,
-		geoIpApi:            geoIpApi,
-		loginTokenRepo:      loginTokenRepo,
-		webAuthnProvider:    webAuthnProvider,
-		log:                 log,
+		usersRepo:                usersRepo,
+		passkeySessionsRepo:      passkeySessionRepo,
+		telegramLinksRepo:        telegramLinksRepo,
+		securityEventsRepo:       securityEventsRepo,
+		loginConfirmationsRepo:   loginConfirmationsRepo,
+		trustedDevicesRepo:       trustedDevicesRepo,
+		sessionProofNoncesRepo:   sessionProofNoncesRepo,
+		accessTokensRepo:         accessTokensRepo,
+		keyDirectoryRepo:         keyDirectoryRepo,
+		deletedUsersRepo:         deletedUsersRepo,
+		dataExportsRepo:          dataExportsRepo,
+		adminActionsRepo:         adminActionsRepo,
+		rolesRepo:                rolesRepo,
+		magicLinksRepo:           magicLinksRepo,
+		invitesRepo:              invitesRepo,
+		fileStorage:              fileStorage,
+		qrCodeRenderer:           qrCodeRenderer,
+		tokenProvider:            tokenProvider,
+		notificationsClient:      notificationsClient,
+		otpRepo:                  otpRepo,
+		otpTTL:                   otpTTL,
+		defaultSessionTTL:        defaultSessionTTL,
+		longLivedSessionTTL:      longLivedSessionTTL,
+		loginConfirmationTTL:     loginConfirmationTTL,
+		trustedDeviceTTL:         trustedDeviceTTL,
+		reauthWindow:             reauthWindow,
+		sessionProofMaxSkew:      sessionProofMaxSkew,
+		accessTokenMaxTTL:        accessTokenMaxTTL,
+		signedPrekeyMaxAge:       signedPrekeyMaxAge,
+		deletionGracePeriod:      deletionGracePeriod,
+		deletionRemindBefore:     deletionRemindBefore,
+		dataExportCollectTimeout: dataExportCollectTimeout,
+		dataExportTTL:            dataExportTTL,
+		accountLockWindow:        accountLockWindow,
+		accountLockDuration:      accountLockDuration,
+		magicLinkTTL:             magicLinkTTL,
+		inviteMaxTTL:             inviteMaxTTL,
+		loginTokenTTL:            loginTokenTTL,
+		securityProvider:         securityProvider,
+		keyRing:                  keyRing,
+		tgApi:                    tgApi,
+		sessionsRepo:             sessionsRepo,
+		geoIpApi:                 geoIpApi,
+		userAgentParser:          userAgentParser,
+		riskyNetworks:            riskyNetworks,
+		securityAlerts:           securityAlerts,
+		userEvents:               userEvents,
+		loginRiskThreshold:       loginRiskThreshold,
+		maxSessions:              maxSessions,
+		maxLongLivedSessions:     maxLongLivedSessions,
+		sessionLimitPolicy:       sessionLimitPolicy,
+		passwordPolicy:           passwordPolicy,
+		maxOneTimePrekeys:        maxOneTimePrekeys,
+		prekeysLowThreshold:      prekeysLowThreshold,
+		dataExportServices:       dataExportServices,
+		maxFailedSignIns:         maxFailedSignIns,
+		appUrl:                   appUrl,
+		invitesRequired:          invitesRequired,
+		inviteMaxUses:            inviteMaxUses,
+		loginTokenRepo:           loginTokenRepo,
+		webAuthnProvider:         webAuthnProvider,
+		log:                      log,
 	}
 }
 
+// hashOtp hashes verification code with fast hash, so that checking codes from unauthenticated
+// requests does not exhaust server resources. Hash does not hide the code from one who can read
+// the storage, since there are only a million of them. Guessing is bounded by checkOtp which
+// every check must go through, and the code itself lives no longer than otpTTL
+func (s *Service) hashOtp(plainCode string) []byte {
+	return []byte(s.securityProvider.HashToken(plainCode))
+}
+
+// isOtpValid compares plain code with hash produced by hashOtp in constant time
+func (s *Service) isOtpValid(hashedCode []byte, plainCode string) bool {
+	return subtle.ConstantTimeCompare(hashedCode, s.hashOtp(plainCode)) == 1
+}
+
 // createOtp generates, hashes and saves hashed otp token to database
 // returning plain code and insertion error for caller to handle
 func (s *Service) createOtp(ctx context.Context, email string, userId int) (string, error) {
@@ -77,40 +235,123 @@ func (s *Service) createOtp(ctx context.Context, email string, userId int) (stri
 	}
 
 	plainCode := s.securityProvider.GenerateOTPCode()
+	otp := &entity.OTP{Code: s.hashOtp(plainCode), UserEmail: email, UserId: userId}
+
+	return plainCode, s.otpRepo.CreateWithTTL(ctx, otp, s.otpTTL)
+}
+
+// createScopedOtp generates code which is valid only for flow identified by purpose
+// and within context described by binding, replacing code previously issued for the purpose
+func (s *Service) createScopedOtp(ctx context.Context, purpose entity.OTPPurpose, userId int, binding string) (string, error) {
+	plainCode := s.securityProvider.GenerateOTPCode()
+	otp := &entity.OTP{
+		Code:    s.hashOtp(plainCode),
+		UserId:  userId,
+		Purpose: purpose,
+		Binding: s.securityProvider.HashToken(binding),
+	}
 
-	hashedCode, err := s.securityProvider.HashPassword(plainCode)
+	return plainCode, s.otpRepo.CreateWithTTL(ctx, otp, s.otpTTL)
+}
+
+// consumeScopedOtp checks code issued by createScopedOtp and deletes it, so it can be used only once.
+// Code is deleted as well after config.MAX_OTP_ATTEMPTS failed attempts
+func (s *Service) consumeScopedOtp(ctx context.Context, purpose entity.OTPPurpose, userId int, binding string, code string) error {
+	otp, err := s.otpRepo.GetByPurpose(ctx, purpose, userId)
 	if err != nil {
-		return "", err
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrOtpIsNotValid
+		}
+		return err
 	}
 
-	otp := &entity.OTP{Code: hashedCode, UserEmail: email, UserId: userId}
+	if otp.Binding != s.securityProvider.HashToken(binding) {
+		return s.failOtpAttempt(ctx, otp)
+	}
+	if err = s.checkOtp(ctx, otp, code); err != nil {
+		return err
+	}
 
-	return plainCode, s.otpRepo.CreateWithTTL(ctx, otp, s.otpTTL)
+	if err = s.otpRepo.Delete(ctx, otp); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrOtpIsNotValid
+		}
+		return err
+	}
+	return nil
+}
+
+// checkOtp compares code with otp and counts failed attempt on mismatch,
+// deleting otp after config.MAX_OTP_ATTEMPTS failed attempts
+func (s *Service) checkOtp(ctx context.Context, otp *entity.OTP, code string) error {
+	if !s.isOtpValid(otp.Code, code) {
+		return s.failOtpAttempt(ctx, otp)
+	}
+	return nil
+}
+
+func (s *Service) failOtpAttempt(ctx context.Context, otp *entity.OTP) error {
+	attempts, err := s.otpRepo.IncrementAttempts(ctx, otp)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	if attempts >= config.MAX_OTP_ATTEMPTS {
+		if err = s.otpRepo.Delete(ctx, otp); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+	}
+	return ErrOtpIsNotValid
 }
 
-// newAuthSession inserts a new session or updates existing one based on set of params
-// if new session was created - sends 'warning' email
-func (s *Service) newAuthSession(ctx context.Context, user *entity.User, ip string, deviceInfo string, rememberMe bool, signedUp bool) (newSession *entity.AuthSession, err error) {
+// newAuthSession inserts a new session or replaces existing one based on set of params
+// if session was created from unknown device - sends 'warning' notifications.
+// authMethod is empty if user did not go through strong authentication.
+// publicKey binds session to client's key if provided
+func (s *Service) newAuthSession(
+	ctx context.Context, user *entity.User, ip string, deviceInfo string, publicKey string,
+	rememberMe bool, authMethod entity.AuthMethod, signedUp bool,
+) (*entity.AuthSession, error) {
+	if publicKey != "" {
+		if err := s.securityProvider.ValidatePublicKey(publicKey); err != nil {
+			return nil, ErrInvalidSessionKey
+		}
+	}
+
+	session := &entity.AuthSession{
+		Id:          s.securityProvider.GenerateSessionId(),
+		UserId:      user.Id,
+		IpAddr:      ip,
+		DeviceInfo:  deviceInfo,
+		Device:      s.userAgentParser.Parse(deviceInfo),
+		IsLongLived: rememberMe,
+		AuthMethod:  authMethod,
+		PublicKey:   publicKey,
+	}
+	if authMethod != "" {
+		session.AuthenticatedAt = time.Now().UTC()
+	}
+
+	isNewDevice := false
 	if !signedUp {
-		var existingSession *entity.AuthSession
-		existingSession, err = s.sessionsRepo.GetByLoginData(ctx, user.Id, ip, deviceInfo)
+		existingSession, err := s.sessionsRepo.GetByLoginData(ctx, user.Id, ip, session.DeviceKey())
 		if err != nil && !errors.Is(err, storage.ErrNotFound) {
-			return
+			return nil, err
+		}
+
+		replacedSessionId := ""
+		if existingSession != nil {
+			replacedSessionId = existingSession.Id
+		}
+		if err = s.enforceSessionLimit(ctx, user, rememberMe, replacedSessionId); err != nil {
+			return nil, err
 		}
 
 		if existingSession != nil {
 			if err = s.sessionsRepo.DeleteById(ctx, user.Id, existingSession.Id); err != nil {
-				return
+				return nil, err
 			}
-			defer func() {
-				// If new session was succesfully created - send email about new sign in and reassign the error
-				if newSession != nil {
-					err = s.notificationsClient.SendLoginNewDeviceEmail(ctx, user.Email, user.GetDisplayName(), newSession, user.Language)
-					if err != nil {
-						s.log.Error(fmt.Errorf("AuthService - newAuthSession - notificationsClient.SendSignInNewDeviceEmail: %w", err), "sessionID", newSession.Id)
-					}
-				}
-			}()
+		} else {
+			isNewDevice = true
 		}
 	}
 
@@ -118,28 +359,59 @@ func (s *Service) newAuthSession(ctx context.Context, user *entity.User, ip stri
 	if rememberMe {
 		sessionTTL = s.longLivedSessionTTL
 	}
-	s.log.Info("Query location")
-	location, err := s.geoIpApi.GetLocationByIP(ip)
+	session.Location = s.resolveLocation(ctx, ip)
+	newSession, err := s.sessionsRepo.CreateWithTTL(ctx, session, sessionTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNewDevice {
+		s.notifyLoginNewDevice(ctx, user, newSession)
+	}
+
+	return newSession, nil
+}
+
+// notifyLoginNewDevice warns user about sign in from unknown device using all available channels.
+// Email carries one-click "was this you?" answers if confirmation was issued.
+// Delivery failures are logged and never interrupt sign in
+func (s *Service) notifyLoginNewDevice(ctx context.Context, user *entity.User, newSession *entity.AuthSession) {
+	approveToken, denyToken, err := s.createLoginConfirmation(ctx, newSession)
 	if err != nil {
-		return
-	}
-	s.log.Info("Save session")
-	newSession, err = s.sessionsRepo.CreateWithTTL(
-		ctx,
-		&entity.AuthSession{
-			Id:          s.securityProvider.GenerateSessionId(),
-			UserId:      user.Id,
-			IpAddr:      ip,
-			DeviceInfo:  deviceInfo,
-			Location:    location,
-			IsLongLived: rememberMe,
-		},
-		sessionTTL,
+		s.log.Error(fmt.Errorf("AuthService - notifyLoginNewDevice - createLoginConfirmation: %w", err), "sessionID", newSession.Id)
+	}
+
+	err = s.notificationsClient.SendLoginNewDeviceEmail(
+		ctx, user.Email, user.GetDisplayName(), newSession, approveToken, denyToken, user.Language,
 	)
 	if err != nil {
-		return
+		s.log.Error(fmt.Errorf("AuthService - notifyLoginNewDevice - notificationsClient.SendLoginNewDeviceEmail: %w", err), "sessionID", newSession.Id)
 	}
-	s.log.Info("Return session")
 
-	return
+	if user.Is2FAEnabled() && user.TwoFactorAuth.Method == entity.TWO_FA_TELEGRAM && user.TwoFactorAuth.Contact != "" {
+		text := fmt.Sprintf(
+			"New login to your account\nDevice: %s\nLocation: %s\nIP: %s",
+			newSession.DeviceName(), newSession.Location, newSession.IpAddr,
+		)
+		buttons := []gateways.TelegramInlineButton{
+			{Text: "This wasn't me", CallbackData: TelegramRevokeSessionCallback(newSession.Id)},
+		}
+		if err = s.tgApi.SendTextMsgWithButtons(ctx, user.TwoFactorAuth.Contact, text, buttons); err != nil {
+			s.log.Error(fmt.Errorf("AuthService - notifyLoginNewDevice - tgApi.SendTextMsgWithButtons: %w", err), "sessionID", newSession.Id)
+		}
+	}
+}
+
+// resolveLocation returns human readable location of ip address.
+// Lookup failures are logged and never interrupt the caller, unknown location is returned instead
+func (s *Service) resolveLocation(ctx context.Context, ip string) string {
+	location, err := s.geoIpApi.GetLocationByIP(ctx, ip)
+	if err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - resolveLocation - geoIpApi.GetLocationByIP: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "ip", ip,
+		)
+		return entity.UNKNOWN_LOCATION
+	}
+	return location.String()
 }
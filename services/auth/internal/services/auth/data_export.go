@@ -0,0 +1,412 @@
+package auth
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const (
+	dataExportTokenType          = "data_export"
+	dataExportSecurityEventsPage = 100
+)
+
+// dataExportFile is JSON document put into data export archive
+type dataExportFile struct {
+	name  string
+	value any
+}
+
+// dataExportServiceName restricts names of services so they are safe to use as archive file names
+var dataExportServiceName = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// RequestDataExport starts collecting personal data of the user. Other services are asked to send
+// their data, archive is assembled once all of them replied or collection time is over
+// and download link is sent to user's email
+func (s *Service) RequestDataExport(ctx context.Context, userId int, sessionId string) (*entity.DataExport, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestDataExport"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("RequestDataExport finished", "duration", time.Since(start)) }()
+
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return nil, err
+	}
+
+	export, err := s.dataExportsRepo.Create(ctx, &entity.DataExport{
+		Id:           s.securityProvider.GenerateSessionId(),
+		UserId:       userId,
+		CollectUntil: time.Now().Add(s.dataExportCollectTimeout),
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return nil, ErrDataExportInProgress
+		}
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to create data export", "err", err)
+		return nil, err
+	}
+	log.Info("data export requested", "exportId", export.Id)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_DATA_EXPORT_REQUESTED,
+		Details: map[string]string{"export_id": export.Id},
+	})
+
+	// archive is still assembled from auth data once collection time is over,
+	// services which did not reply are listed in its manifest
+	if err = s.userEvents.PublishDataExportRequested(ctx, export.Id, userId); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - RequestDataExport - userEvents.PublishDataExportRequested: %w", err),
+			"correlationId", correlationId, "userId", userId, "exportId", export.Id,
+		)
+	}
+
+	return export, nil
+}
+
+// SaveDataExportPart stores data other service collected for export.
+// Repeated part from the same service replaces previous one
+func (s *Service) SaveDataExportPart(ctx context.Context, part *entity.DataExportPart) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.SaveDataExportPart"
+	log := s.log.With("op", op, "correlationId", correlationId, "exportId", part.ExportId, "service", part.Service)
+	start := time.Now()
+	defer func() { log.Debug("SaveDataExportPart finished", "duration", time.Since(start)) }()
+
+	if !dataExportServiceName.MatchString(part.Service) || !json.Valid(part.Data) {
+		return ErrInvalidDataExportPart
+	}
+	if err := s.dataExportsRepo.SavePart(ctx, part); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrDataExportNotFound
+		}
+		log.Error("failed to save data export part", "err", err)
+		return err
+	}
+	log.Info("data export part received")
+
+	return nil
+}
+
+// OpenDataExport returns ready archive for download token sent to user's email.
+// Caller must close returned reader
+func (s *Service) OpenDataExport(ctx context.Context, token string) (*entity.DataExport, io.ReadCloser, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.OpenDataExport"
+	log := s.log.With("op", op, "correlationId", correlationId)
+	start := time.Now()
+	defer func() { log.Debug("OpenDataExport finished", "duration", time.Since(start)) }()
+
+	claims, err := s.tokenProvider.ParseClaimsFromToken(token)
+	if err != nil {
+		return nil, nil, ErrInvalidDataExportToken
+	}
+	typ, _ := claims["typ"].(string)
+	exportId, _ := claims["eid"].(string)
+	// numeric claims are decoded as float64
+	userId, _ := claims["uid"].(float64)
+	if typ != dataExportTokenType || exportId == "" {
+		return nil, nil, ErrInvalidDataExportToken
+	}
+
+	export, err := s.dataExportsRepo.GetById(ctx, exportId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrInvalidDataExportToken
+		}
+		log.Error("failed to get data export", "err", err, "exportId", exportId)
+		return nil, nil, err
+	}
+	if export.UserId != int(userId) || export.Status != entity.DATA_EXPORT_STATUS_READY || export.IsExpired() {
+		return nil, nil, ErrInvalidDataExportToken
+	}
+
+	file, err := s.fileStorage.Open(export.FileName)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Warn("data export archive is missing", "exportId", export.Id, "fileName", export.FileName)
+			return nil, nil, ErrInvalidDataExportToken
+		}
+		log.Error("failed to open data export archive", "err", err, "exportId", export.Id)
+		return nil, nil, err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  export.UserId,
+		Type:    entity.SECURITY_EVENT_DATA_EXPORT_DOWNLOADED,
+		Details: map[string]string{"export_id": export.Id},
+	})
+
+	return export, file, nil
+}
+
+// ProcessDataExports assembles archives of exports whose data is collected and removes expired archives.
+// Work is done in batches until nothing is left, exports which failed to assemble are retried by later calls
+func (s *Service) ProcessDataExports(ctx context.Context, batchSize int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ProcessDataExports"
+	log := s.log.With("op", op, "correlationId", correlationId)
+	start := time.Now()
+	defer func() { log.Debug("ProcessDataExports finished", "duration", time.Since(start)) }()
+
+	assembled, err := s.assembleDataExports(ctx, batchSize)
+	if err != nil {
+		log.Error("failed to assemble data exports", "err", err)
+		return err
+	}
+	removed, err := s.removeExpiredDataExports(ctx, batchSize)
+	if err != nil {
+		log.Error("failed to remove expired data exports", "err", err)
+		return err
+	}
+	log.Info("processed data exports", "assembled", assembled, "removed", removed)
+
+	return nil
+}
+
+// assembleDataExports assembles every export ready to be assembled. Failed export is postponed
+// with exponential backoff and does not stop others, error is returned only if it can't be postponed
+func (s *Service) assembleDataExports(ctx context.Context, batchSize int) (int, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	assembled := 0
+	for {
+		exports, err := s.dataExportsRepo.GetAllReadyToAssemble(ctx, len(s.dataExportServices), batchSize)
+		if err != nil {
+			return assembled, err
+		}
+		for _, export := range exports {
+			if err = s.assembleDataExport(ctx, &export); err == nil {
+				assembled++
+				continue
+			}
+			s.log.Error(
+				fmt.Errorf("AuthService - assembleDataExports - assembleDataExport: %w", err),
+				"correlationId", correlationId, "userId", export.UserId, "exportId", export.Id, "attempts", export.Attempts+1,
+			)
+			nextAttemptAt := time.Now().Add(config.DATA_EXPORT_RETRY_BACKOFF << export.Attempts)
+			failed, err := s.dataExportsRepo.RecordFailedAttemptById(
+				ctx, export.Id, nextAttemptAt, config.MAX_DATA_EXPORT_ATTEMPTS,
+			)
+			if err != nil {
+				// export stays ready to assemble, so the loop would pick it up again
+				return assembled, fmt.Errorf("export %s: dataExportsRepo.RecordFailedAttemptById: %w", export.Id, err)
+			}
+			if failed.Status == entity.DATA_EXPORT_STATUS_FAILED {
+				s.log.Warn(
+					"data export given up after too many failed attempts",
+					"correlationId", correlationId, "userId", export.UserId, "exportId", export.Id,
+				)
+			}
+		}
+		if len(exports) < batchSize {
+			break
+		}
+	}
+
+	return assembled, nil
+}
+
+// assembleDataExport writes archive and emails download link to the user.
+// Export is marked as ready only after email is sent, so failed export is retried as a whole
+func (s *Service) assembleDataExport(ctx context.Context, export *entity.DataExport) error {
+	user, err := s.usersRepo.GetByIDWithPasskeyCredentials(ctx, export.UserId)
+	if err != nil {
+		return fmt.Errorf("usersRepo.GetByIDWithPasskeyCredentials: %w", err)
+	}
+	parts, err := s.dataExportsRepo.GetAllParts(ctx, export.Id)
+	if err != nil {
+		return fmt.Errorf("dataExportsRepo.GetAllParts: %w", err)
+	}
+
+	fileName := export.Id + ".zip"
+	if err = s.fileStorage.Write(fileName, func(w io.Writer) error {
+		return s.writeDataExportArchive(ctx, w, export, user, parts)
+	}); err != nil {
+		return fmt.Errorf("fileStorage.Write: %w", err)
+	}
+
+	expiresAt := time.Now().Add(s.dataExportTTL)
+	token, err := s.tokenProvider.NewToken(s.dataExportTTL, map[string]any{
+		"typ": dataExportTokenType,
+		"eid": export.Id,
+		"uid": export.UserId,
+	})
+	if err != nil {
+		return fmt.Errorf("tokenProvider.NewToken: %w", err)
+	}
+	if err = s.notificationsClient.SendDataExportReadyEmail(
+		ctx, user.Email, user.GetDisplayName(), token, expiresAt, user.Language,
+	); err != nil {
+		return fmt.Errorf("notificationsClient.SendDataExportReadyEmail: %w", err)
+	}
+	if err = s.dataExportsRepo.CompleteById(ctx, export.Id, fileName, expiresAt); err != nil {
+		return fmt.Errorf("dataExportsRepo.CompleteById: %w", err)
+	}
+	s.log.Info(
+		"data export assembled",
+		"correlationId", logger.CorrelationIDFromContext(ctx), "userId", export.UserId, "exportId", export.Id,
+	)
+
+	return nil
+}
+
+// writeDataExportArchive writes zip with auth data in "auth" folder, data of other services
+// in "services" folder and manifest describing archive content
+func (s *Service) writeDataExportArchive(
+	ctx context.Context,
+	w io.Writer,
+	export *entity.DataExport,
+	user *entity.User,
+	parts []entity.DataExportPart,
+) error {
+	sessions, err := s.sessionsRepo.GetAllByUserId(ctx, user.Id)
+	if err != nil {
+		return fmt.Errorf("sessionsRepo.GetAllByUserId: %w", err)
+	}
+	securityEvents, err := s.getAllUserSecurityEvents(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+
+	var twoFactorAuth *dtos.TwoFactorAuthExport
+	if user.TwoFactorAuth != nil {
+		twoFactorAuth = &dtos.TwoFactorAuthExport{
+			Method:  user.TwoFactorAuth.Method,
+			Contact: user.TwoFactorAuth.Contact,
+			Enabled: user.TwoFactorAuth.Enabled,
+		}
+	}
+	passkeys := user.PasskeyCredentials
+	profile := *user
+	profile.TwoFactorAuth = nil
+	profile.PasskeyCredentials = nil
+
+	manifest := dtos.DataExportManifest{
+		ExportId:        export.Id,
+		UserId:          export.UserId,
+		RequestedAt:     export.RequestedAt,
+		GeneratedAt:     time.Now().UTC(),
+		Services:        []string{},
+		MissingServices: []string{},
+	}
+	files := []dataExportFile{
+		{"auth/profile.json", profile},
+		{"auth/two_factor_auth.json", twoFactorAuth},
+		{"auth/passkeys.json", passkeys},
+		{"auth/sessions.json", sessions},
+		{"auth/login_history.json", securityEvents},
+	}
+	for _, part := range parts {
+		manifest.Services = append(manifest.Services, part.Service)
+		files = append(files, dataExportFile{"services/" + part.Service + ".json", part.Data})
+	}
+	for _, service := range s.dataExportServices {
+		if !slices.Contains(manifest.Services, service) {
+			manifest.MissingServices = append(manifest.MissingServices, service)
+		}
+	}
+
+	archive := zip.NewWriter(w)
+	if err = writeArchiveJSON(archive, "manifest.json", manifest); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err = writeArchiveJSON(archive, file.name, file.value); err != nil {
+			return err
+		}
+	}
+	if err = archive.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+
+	return nil
+}
+
+func writeArchiveJSON(archive *zip.Writer, name string, value any) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(value); err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	return nil
+}
+
+// getAllUserSecurityEvents reads whole audit log of the user page by page
+func (s *Service) getAllUserSecurityEvents(ctx context.Context, userId int) ([]entity.SecurityEvent, error) {
+	events := []entity.SecurityEvent{}
+	filter := &dtos.SecurityEventsFilter{UserId: userId, Limit: dataExportSecurityEventsPage}
+	for {
+		page, err := s.securityEventsRepo.GetMany(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("securityEventsRepo.GetMany: %w", err)
+		}
+		events = append(events, page...)
+		if len(page) < filter.Limit {
+			break
+		}
+		filter.BeforeId = page[len(page)-1].Id
+	}
+
+	return events, nil
+}
+
+func (s *Service) removeExpiredDataExports(ctx context.Context, batchSize int) (int, error) {
+	removed := 0
+	for {
+		exports, err := s.dataExportsRepo.GetAllExpired(ctx, batchSize)
+		if err != nil {
+			return removed, err
+		}
+		for _, export := range exports {
+			if err = s.fileStorage.Remove(export.FileName); err != nil {
+				return removed, err
+			}
+			if err = s.dataExportsRepo.UpdateStatusById(ctx, export.Id, entity.DATA_EXPORT_STATUS_EXPIRED); err != nil {
+				return removed, err
+			}
+			removed++
+		}
+		if len(exports) < batchSize {
+			break
+		}
+	}
+
+	return removed, nil
+}
+
+// removeDataExportFiles drops archives of the user, export records are removed along with account
+func (s *Service) removeDataExportFiles(ctx context.Context, userId int) error {
+	exports, err := s.dataExportsRepo.GetAllByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if export.FileName == "" {
+			continue
+		}
+		if err = s.fileStorage.Remove(export.FileName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
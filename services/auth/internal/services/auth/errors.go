@@ -1,6 +1,10 @@
 package auth
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
 
 var (
 	ErrOtpIsNotValid = errors.New("entered code is invalid or expired. Please obtain a new one and try again")
@@ -16,11 +20,71 @@ var (
 	)
 	Err2FaAlreadyAdded    = errors.New("two factor authentication is already associated with your account")
 	ErrDeactivatedAccount = errors.New(
-		"your account has been deactivated. Try to contact support to resolve this issue",
+		"your account has been deactivated. Request a reactivation code to your email to restore it",
+	)
+	ErrAccountLocked = errors.New(
+		"your account is temporarily locked because of too many failed sign in attempts. Try again later or unlock it with a code sent to your email",
 	)
+	ErrAccountSuspended                 = errors.New("your account has been suspended. Try to contact support to resolve this issue")
+	ErrAccountBanned                    = errors.New("your account has been banned")
+	ErrInvalidAccountStateTransition    = errors.New("account can not be moved to requested state from its current state")
+	ErrAccountNotReactivatable          = errors.New("your account can not be reactivated. Try to contact support to resolve this issue")
 	ErrSessionNotFound                  = errors.New("no active session found")
+	ErrSessionLimitExceeded             = errors.New("maximum number of active sessions is reached. Sign out from another device and try again")
+	ErrReauthenticationRequired         = errors.New("this action requires you to confirm your identity again")
+	ErrUnsupportedAuthMethod            = errors.New("authentication method is not supported")
+	ErrTooManyReauthenticationAttempts  = errors.New("too many failed attempts to confirm your identity, session was signed out")
+	ErrInvalidSessionKey                = errors.New("session public key is malformed or its algorithm is not supported")
+	ErrSessionProofRequired             = errors.New("session is bound to a key, request must be signed")
+	ErrInvalidSessionProof              = errors.New("request signature is invalid, expired or has already been used")
 	ErrInvalidLoginToken                = errors.New("your login token is invalid. Please obtain a new one")
 	ErrExpiredLoginToken                = errors.New("your login token has expired. Please obtain a new one")
+	ErrUnsupportedQRCodeFormat          = errors.New("QR code format is not supported")
 	ErrInvalidPasskeyCredential         = errors.New("invalid passkey credential")
 	ErrPasskeyRegistrationNotInProgress = errors.New("passkey registration is not in progress. Try to begin registration again")
+	ErrTelegramNotLinked                = errors.New("telegram chat is not linked to any account")
+	ErrInvalidTelegramLinkCode          = errors.New("telegram link is invalid or expired. Please obtain a new one")
+	ErrInvalidLoginConfirmation         = errors.New("confirmation link is invalid, expired or has already been used")
+	ErrInvalidMagicLink                 = errors.New("sign in link or code is invalid, expired or has already been used. Please request a new one")
+	ErrTrustedDeviceNotFound            = errors.New("trusted device not found")
+	ErrAccessTokenNotFound              = errors.New("access token not found")
+	ErrInvalidAccessToken               = errors.New("access token is invalid, expired or has been revoked")
+	ErrInsufficientScope                = errors.New("access token does not grant permission to perform this action")
+	ErrInvalidAccessTokenExpiry         = errors.New("access token expiration date must be in the future and within allowed lifetime")
+	ErrInvalidIdentityKey               = errors.New("identity key is malformed or its algorithm is not supported")
+	ErrInvalidPrekeySignature           = errors.New("signed prekey signature does not match identity key")
+	ErrIdentityKeyMismatch              = errors.New("device is already registered with another identity key. Remove it and register again")
+	ErrDeviceKeysNotFound               = errors.New("device is not registered in key directory")
+	ErrPrekeyIdTaken                    = errors.New("one of prekey ids is already in use")
+	ErrTooManyPrekeys                   = errors.New("device has reached the limit of stored one-time prekeys")
+	ErrDeletionAlreadyScheduled         = errors.New("account deletion has already been requested")
+	ErrDeletionNotScheduled             = errors.New("account deletion has not been requested")
+	ErrDataExportInProgress             = errors.New("data export has already been requested and is being prepared")
+	ErrDataExportNotFound               = errors.New("data export not found or is not collected anymore")
+	ErrInvalidDataExportPart            = errors.New("data export part must have valid service name and JSON data")
+	ErrInvalidDataExportToken           = errors.New("download link is invalid or has expired")
+	ErrPasswordPolicyViolation          = errors.New("password does not meet security requirements")
+	ErrPasswordResetRequired            = errors.New("your password must be reset before signing in. Check your email for instructions")
+	ErrPermissionDenied                 = errors.New("you do not have permission to perform this action")
+	ErrRoleNotFound                     = errors.New("role not found")
+	ErrRoleAlreadyAssigned              = errors.New("user already has this role")
+	ErrRoleNotAssigned                  = errors.New("user does not have this role")
+	ErrInviteRequired                   = errors.New("registration is invite-only. Ask someone who already uses the service for an invite")
+	ErrInvalidInvite                    = errors.New("invite code is invalid, expired, already used up or issued for another email")
+	ErrInviteNotFound                   = errors.New("invite not found")
+	ErrInvalidInviteLimits              = errors.New("invite expiration date must be in the future and invite limits must not exceed allowed ones")
+	ErrInvalidSecurityEventsFilter      = errors.New("security events filter is invalid")
 )
+
+// PasswordPolicyError lists password policy violations in the same form as request validation errors
+type PasswordPolicyError struct {
+	Violations validator.ValidationErrors
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return ErrPasswordPolicyViolation.Error()
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicyViolation
+}
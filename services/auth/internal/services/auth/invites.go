@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// inviteCodePrefix makes invite codes recognizable and distinguishable from other tokens
+const inviteCodePrefix = "gt_inv_"
+
+// checkInvite returns invite which may be redeemed by email signing up.
+// Sign up without invite is allowed unless registration is invite-only, nil invite is returned then
+func (s *Service) checkInvite(ctx context.Context, code string, email string) (*entity.Invite, error) {
+	if code == "" {
+		if s.invitesRequired {
+			return nil, ErrInviteRequired
+		}
+		return nil, nil
+	}
+	invite, err := s.invitesRepo.GetByCodeHash(ctx, s.securityProvider.HashToken(code))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidInvite
+		}
+		return nil, err
+	}
+	if !invite.IsRedeemableBy(email) {
+		return nil, ErrInvalidInvite
+	}
+	return invite, nil
+}
+
+// releaseInvite returns use of invite reserved for sign up which did not complete. Failures are logged
+func (s *Service) releaseInvite(ctx context.Context, inviteId int) {
+	if err := s.invitesRepo.Release(ctx, inviteId); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - releaseInvite - invitesRepo.Release: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "inviteId", inviteId,
+		)
+	}
+}
+
+// CreateInvite issues invite code within limits configured for users.
+// Plain code is returned only once, its hash is stored
+func (s *Service) CreateInvite(ctx context.Context, dto *dtos.CreateInviteRequest) (*dtos.CreateInviteResponse, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.CreateInvite"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("CreateInvite finished", "duration", time.Since(start)) }()
+
+	if !dto.ExpiresAt.After(time.Now()) || dto.MaxUses > s.inviteMaxUses || time.Until(dto.ExpiresAt) > s.inviteMaxTTL {
+		return nil, ErrInvalidInviteLimits
+	}
+	user, err := s.usersRepo.GetByID(ctx, dto.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+
+	return s.issueInvite(ctx, dto)
+}
+
+// CreateInviteAsAdmin issues invite code which may exceed limits configured for users.
+// Action requires permission and is recorded in admin audit log
+func (s *Service) CreateInviteAsAdmin(ctx context.Context, dto *dtos.CreateInviteRequest) (*dtos.CreateInviteResponse, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.CreateInviteAsAdmin"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("CreateInviteAsAdmin finished", "duration", time.Since(start)) }()
+
+	if !dto.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidInviteLimits
+	}
+	if err := s.requirePermission(ctx, dto.UserId, dto.SessionId, entity.PERMISSION_INVITES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId: dto.UserId,
+		Action:  entity.ADMIN_ACTION_CREATE_INVITE,
+		Details: map[string]string{
+			"email":      dto.Email,
+			"max_uses":   strconv.Itoa(dto.MaxUses),
+			"expires_at": dto.ExpiresAt.Format(time.RFC3339),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.issueInvite(ctx, dto)
+}
+
+// issueInvite saves invite with newly generated code returning plain code
+func (s *Service) issueInvite(ctx context.Context, dto *dtos.CreateInviteRequest) (*dtos.CreateInviteResponse, error) {
+	log := s.log.With("op", "auth.Service.issueInvite", "correlationId", logger.CorrelationIDFromContext(ctx), "userId", dto.UserId)
+
+	plainCode := inviteCodePrefix + s.securityProvider.GenerateSecretTokenUrlSafe(config.INVITE_CODE_LENGTH)
+	invite, err := s.invitesRepo.Create(ctx, &entity.Invite{
+		InviterId: dto.UserId,
+		Prefix:    plainCode[:len(inviteCodePrefix)+config.INVITE_CODE_VISIBLE_PREFIX_LENGTH],
+		CodeHash:  s.securityProvider.HashToken(plainCode),
+		Email:     dto.Email,
+		MaxUses:   dto.MaxUses,
+		ExpiresAt: dto.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to create invite", "err", err)
+		return nil, err
+	}
+	log.Info("invite created", "inviteId", invite.Id, "maxUses", invite.MaxUses, "expiresAt", invite.ExpiresAt)
+
+	return &dtos.CreateInviteResponse{Invite: invite, Code: plainCode}, nil
+}
+
+// GetInvites returns invites created by user including used up, expired and revoked ones
+func (s *Service) GetInvites(ctx context.Context, userId int) ([]entity.Invite, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetInvites"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetInvites finished", "duration", time.Since(start)) }()
+
+	invites, err := s.invitesRepo.GetAllByInviterId(ctx, userId)
+	if err != nil {
+		log.Error("failed to get invites", "err", err)
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+// RevokeInvite stops invite from being redeemed, users who already signed up with it are not affected
+func (s *Service) RevokeInvite(ctx context.Context, userId int, inviteId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RevokeInvite"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "inviteId", inviteId)
+	start := time.Now()
+	defer func() { log.Debug("RevokeInvite finished", "duration", time.Since(start)) }()
+
+	if err := s.invitesRepo.RevokeById(ctx, userId, inviteId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrInviteNotFound
+		}
+		log.Error("failed to revoke invite", "err", err)
+		return err
+	}
+	log.Info("invite revoked")
+
+	return nil
+}
+
+// GetInviteRedemptions returns users who signed up with invites created by user
+func (s *Service) GetInviteRedemptions(ctx context.Context, userId int) ([]entity.InviteRedemption, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetInviteRedemptions"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetInviteRedemptions finished", "duration", time.Since(start)) }()
+
+	redemptions, err := s.invitesRepo.GetAllRedemptionsByInviterId(ctx, userId)
+	if err != nil {
+		log.Error("failed to get invite redemptions", "err", err)
+		return nil, err
+	}
+
+	return redemptions, nil
+}
@@ -0,0 +1,345 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// verifySignedPrekey ensures prekey was signed by device's identity key.
+// Signature covers raw prekey bytes rather than their encoding
+func (s *Service) verifySignedPrekey(identityKey string, prekey *dtos.SignedPrekeyUpload) error {
+	prekeyBytes, err := base64.RawURLEncoding.DecodeString(prekey.PublicKey)
+	if err != nil {
+		return ErrInvalidPrekeySignature
+	}
+	if err = s.securityProvider.VerifySignature(identityKey, prekeyBytes, prekey.Signature); err != nil {
+		return ErrInvalidPrekeySignature
+	}
+	return nil
+}
+
+// storeOneTimePrekeys saves uploaded prekeys unless device would exceed its limit
+func (s *Service) storeOneTimePrekeys(ctx context.Context, userId int, deviceId string, uploads []dtos.OneTimePrekeyUpload) error {
+	count, err := s.keyDirectoryRepo.CountOneTimePrekeys(ctx, userId, deviceId)
+	if err != nil {
+		return err
+	}
+	if count+len(uploads) > s.maxOneTimePrekeys {
+		return ErrTooManyPrekeys
+	}
+
+	prekeys := make([]entity.OneTimePrekey, len(uploads))
+	for i, upload := range uploads {
+		prekeys[i] = entity.OneTimePrekey{
+			UserId:    userId,
+			DeviceId:  deviceId,
+			KeyId:     upload.KeyId,
+			PublicKey: upload.PublicKey,
+		}
+	}
+	if err = s.keyDirectoryRepo.CreateOneTimePrekeys(ctx, prekeys); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return ErrPrekeyIdTaken
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) isSignedPrekeyRotationDue(prekey *entity.SignedPrekey) bool {
+	return time.Since(prekey.CreatedAt) > s.signedPrekeyMaxAge
+}
+
+// RegisterDeviceKeys publishes device's identity key along with initial prekeys.
+// Registering already known device with the same identity key only replaces its prekeys
+func (s *Service) RegisterDeviceKeys(ctx context.Context, dto *dtos.RegisterDeviceKeysRequest) (*entity.IdentityKey, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RegisterDeviceKeys"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId, "deviceId", dto.DeviceId)
+	start := time.Now()
+	defer func() { log.Debug("RegisterDeviceKeys finished", "duration", time.Since(start)) }()
+
+	if err := s.securityProvider.ValidatePublicKey(dto.IdentityKey); err != nil {
+		return nil, ErrInvalidIdentityKey
+	}
+	if err := s.verifySignedPrekey(dto.IdentityKey, &dto.SignedPrekey); err != nil {
+		return nil, err
+	}
+
+	registered := true
+	identityKey, err := s.keyDirectoryRepo.CreateIdentityKey(ctx, &entity.IdentityKey{
+		UserId:    dto.UserId,
+		DeviceId:  dto.DeviceId,
+		PublicKey: dto.IdentityKey,
+	})
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		registered = false
+		identityKey, err = s.keyDirectoryRepo.GetIdentityKey(ctx, dto.UserId, dto.DeviceId)
+		if err == nil && identityKey.PublicKey != dto.IdentityKey {
+			return nil, ErrIdentityKeyMismatch
+		}
+	}
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to save identity key", "err", err)
+		return nil, err
+	}
+
+	if _, err = s.keyDirectoryRepo.SaveSignedPrekey(ctx, &entity.SignedPrekey{
+		UserId:    dto.UserId,
+		DeviceId:  dto.DeviceId,
+		KeyId:     dto.SignedPrekey.KeyId,
+		PublicKey: dto.SignedPrekey.PublicKey,
+		Signature: dto.SignedPrekey.Signature,
+	}); err != nil {
+		log.Error("failed to save signed prekey", "err", err)
+		return nil, err
+	}
+	if len(dto.OneTimePrekeys) > 0 {
+		if err = s.storeOneTimePrekeys(ctx, dto.UserId, dto.DeviceId, dto.OneTimePrekeys); err != nil {
+			if !errors.Is(err, ErrTooManyPrekeys) && !errors.Is(err, ErrPrekeyIdTaken) {
+				log.Error("failed to save one-time prekeys", "err", err)
+			}
+			return nil, err
+		}
+	}
+	log.Debug("device keys saved", "registered", registered, "oneTimePrekeys", len(dto.OneTimePrekeys))
+
+	if registered {
+		s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+			UserId:  dto.UserId,
+			Type:    entity.SECURITY_EVENT_DEVICE_KEYS_REGISTERED,
+			Details: map[string]string{"device_id": dto.DeviceId},
+		})
+	}
+
+	return identityKey, nil
+}
+
+// RotateSignedPrekey replaces device's signed prekey, new one must be signed by the same identity key
+func (s *Service) RotateSignedPrekey(ctx context.Context, dto *dtos.RotateSignedPrekeyRequest) (*entity.SignedPrekey, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RotateSignedPrekey"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId, "deviceId", dto.DeviceId)
+	start := time.Now()
+	defer func() { log.Debug("RotateSignedPrekey finished", "duration", time.Since(start)) }()
+
+	identityKey, err := s.keyDirectoryRepo.GetIdentityKey(ctx, dto.UserId, dto.DeviceId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrDeviceKeysNotFound
+		}
+		log.Error("failed to get identity key", "err", err)
+		return nil, err
+	}
+	if err = s.verifySignedPrekey(identityKey.PublicKey, &dto.SignedPrekey); err != nil {
+		return nil, err
+	}
+
+	prekey, err := s.keyDirectoryRepo.SaveSignedPrekey(ctx, &entity.SignedPrekey{
+		UserId:    dto.UserId,
+		DeviceId:  dto.DeviceId,
+		KeyId:     dto.SignedPrekey.KeyId,
+		PublicKey: dto.SignedPrekey.PublicKey,
+		Signature: dto.SignedPrekey.Signature,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrDeviceKeysNotFound
+		}
+		log.Error("failed to save signed prekey", "err", err)
+		return nil, err
+	}
+	log.Debug("signed prekey rotated", "keyId", prekey.KeyId)
+
+	return prekey, nil
+}
+
+func (s *Service) UploadOneTimePrekeys(ctx context.Context, dto *dtos.UploadOneTimePrekeysRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.UploadOneTimePrekeys"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId, "deviceId", dto.DeviceId)
+	start := time.Now()
+	defer func() { log.Debug("UploadOneTimePrekeys finished", "duration", time.Since(start)) }()
+
+	if _, err := s.keyDirectoryRepo.GetIdentityKey(ctx, dto.UserId, dto.DeviceId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrDeviceKeysNotFound
+		}
+		log.Error("failed to get identity key", "err", err)
+		return err
+	}
+	if err := s.storeOneTimePrekeys(ctx, dto.UserId, dto.DeviceId, dto.Prekeys); err != nil {
+		if !errors.Is(err, ErrTooManyPrekeys) && !errors.Is(err, ErrPrekeyIdTaken) {
+			log.Error("failed to save one-time prekeys", "err", err)
+		}
+		return err
+	}
+	log.Debug("one-time prekeys uploaded", "count", len(dto.Prekeys))
+
+	return nil
+}
+
+// GetPrekeyBundles returns bundle for every registered device of user so that
+// caller can start encrypted session with each of them.
+// One-time prekeys included into bundles are consumed and never returned again
+func (s *Service) GetPrekeyBundles(ctx context.Context, userId int) ([]entity.PrekeyBundle, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetPrekeyBundles"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetPrekeyBundles finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+
+	identityKeys, err := s.keyDirectoryRepo.GetAllIdentityKeys(ctx, userId)
+	if err != nil {
+		log.Error("failed to get identity keys", "err", err)
+		return nil, err
+	}
+
+	bundles := make([]entity.PrekeyBundle, 0, len(identityKeys))
+	for _, identityKey := range identityKeys {
+		signedPrekey, err := s.keyDirectoryRepo.GetSignedPrekey(ctx, userId, identityKey.DeviceId)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				// device has not completed registration yet
+				continue
+			}
+			log.Error("failed to get signed prekey", "err", err, "deviceId", identityKey.DeviceId)
+			return nil, err
+		}
+		oneTimePrekey, err := s.keyDirectoryRepo.ConsumeOneTimePrekey(ctx, userId, identityKey.DeviceId)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Error("failed to consume one-time prekey", "err", err, "deviceId", identityKey.DeviceId)
+			return nil, err
+		}
+		if oneTimePrekey != nil {
+			s.notifyIfPrekeysLow(ctx, signedPrekey)
+		}
+
+		bundles = append(bundles, entity.PrekeyBundle{
+			IdentityKey:   identityKey,
+			SignedPrekey:  *signedPrekey,
+			OneTimePrekey: oneTimePrekey,
+		})
+	}
+	log.Debug("prekey bundles fetched", "count", len(bundles))
+
+	return bundles, nil
+}
+
+// notifyIfPrekeysLow asks device to replenish one-time prekeys. Notification is sent
+// only when number of prekeys drops below threshold and when the last one is consumed
+func (s *Service) notifyIfPrekeysLow(ctx context.Context, signedPrekey *entity.SignedPrekey) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	userId, deviceId := signedPrekey.UserId, signedPrekey.DeviceId
+
+	remaining, err := s.keyDirectoryRepo.CountOneTimePrekeys(ctx, userId, deviceId)
+	if err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - notifyIfPrekeysLow - keyDirectoryRepo.CountOneTimePrekeys: %w", err),
+			"correlationId", correlationId, "userId", userId, "deviceId", deviceId,
+		)
+		return
+	}
+	if remaining != s.prekeysLowThreshold-1 && remaining != 0 {
+		return
+	}
+
+	if err = s.notificationsClient.SendPrekeysLowNotification(
+		ctx, userId, deviceId, remaining, s.isSignedPrekeyRotationDue(signedPrekey),
+	); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - notifyIfPrekeysLow - notificationsClient.SendPrekeysLowNotification: %w", err),
+			"correlationId", correlationId, "userId", userId, "deviceId", deviceId,
+		)
+	}
+}
+
+// GetIdentityKeys lists public identity keys of user's devices e.g for safety number verification
+func (s *Service) GetIdentityKeys(ctx context.Context, userId int) ([]entity.IdentityKey, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetIdentityKeys"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+
+	keys, err := s.keyDirectoryRepo.GetAllIdentityKeys(ctx, userId)
+	if err != nil {
+		log.Error("failed to get identity keys", "err", err)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// GetDeviceKeysStatus lets device check whether it should upload more prekeys or rotate signed one
+func (s *Service) GetDeviceKeysStatus(ctx context.Context, userId int, deviceId string) (*dtos.DeviceKeysStatus, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetDeviceKeysStatus"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "deviceId", deviceId)
+
+	signedPrekey, err := s.keyDirectoryRepo.GetSignedPrekey(ctx, userId, deviceId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrDeviceKeysNotFound
+		}
+		log.Error("failed to get signed prekey", "err", err)
+		return nil, err
+	}
+	count, err := s.keyDirectoryRepo.CountOneTimePrekeys(ctx, userId, deviceId)
+	if err != nil {
+		log.Error("failed to count one-time prekeys", "err", err)
+		return nil, err
+	}
+
+	return &dtos.DeviceKeysStatus{
+		OneTimePrekeys:          count,
+		OneTimePrekeysLow:       count < s.prekeysLowThreshold,
+		SignedPrekeyCreatedAt:   signedPrekey.CreatedAt,
+		SignedPrekeyRotationDue: s.isSignedPrekeyRotationDue(signedPrekey),
+	}, nil
+}
+
+// RemoveDeviceKeys unpublishes device from key directory, other users can't start new sessions with it
+func (s *Service) RemoveDeviceKeys(ctx context.Context, userId int, deviceId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RemoveDeviceKeys"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "deviceId", deviceId)
+	start := time.Now()
+	defer func() { log.Debug("RemoveDeviceKeys finished", "duration", time.Since(start)) }()
+
+	if err := s.keyDirectoryRepo.DeleteIdentityKey(ctx, userId, deviceId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrDeviceKeysNotFound
+		}
+		log.Error("failed to delete identity key", "err", err)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_DEVICE_KEYS_REMOVED,
+		Details: map[string]string{"device_id": deviceId},
+	})
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const loginConfirmationTokenType = "login_confirmation"
+
+// createLoginConfirmation saves pending "was this you?" question about new session
+// and returns signed tokens answering it positively and negatively.
+// Both tokens share the same nonce so whichever is used first consumes the other one
+func (s *Service) createLoginConfirmation(ctx context.Context, session *entity.AuthSession) (string, string, error) {
+	confirmation := &entity.LoginConfirmation{
+		SessionId: session.Id,
+		UserId:    session.UserId,
+		Nonce:     s.securityProvider.GenerateSecretTokenUrlSafe(config.LOGIN_CONFIRMATION_NONCE_LENGTH),
+	}
+	if err := s.loginConfirmationsRepo.CreateWithTTL(ctx, confirmation, s.loginConfirmationTTL); err != nil {
+		return "", "", fmt.Errorf("loginConfirmationsRepo.CreateWithTTL: %w", err)
+	}
+
+	tokens := make([]string, 0, 2)
+	for _, answer := range []entity.LoginConfirmationAnswer{entity.LOGIN_CONFIRMATION_APPROVE, entity.LOGIN_CONFIRMATION_DENY} {
+		token, err := s.tokenProvider.NewToken(s.loginConfirmationTTL, map[string]any{
+			"typ":    loginConfirmationTokenType,
+			"sid":    confirmation.SessionId,
+			"nonce":  confirmation.Nonce,
+			"answer": string(answer),
+		})
+		if err != nil {
+			return "", "", fmt.Errorf("tokenProvider.NewToken: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens[0], tokens[1], nil
+}
+
+// parseLoginConfirmationToken verifies token signature and extracts answered session, nonce and answer
+func (s *Service) parseLoginConfirmationToken(token string) (string, string, entity.LoginConfirmationAnswer, bool) {
+	claims, err := s.tokenProvider.ParseClaimsFromToken(token)
+	if err != nil {
+		return "", "", "", false
+	}
+	typ, _ := claims["typ"].(string)
+	sessionId, _ := claims["sid"].(string)
+	nonce, _ := claims["nonce"].(string)
+	answer, _ := claims["answer"].(string)
+	if typ != loginConfirmationTokenType || sessionId == "" || nonce == "" {
+		return "", "", "", false
+	}
+	switch entity.LoginConfirmationAnswer(answer) {
+	case entity.LOGIN_CONFIRMATION_APPROVE, entity.LOGIN_CONFIRMATION_DENY:
+		return sessionId, nonce, entity.LoginConfirmationAnswer(answer), true
+	default:
+		return "", "", "", false
+	}
+}
+
+// AnswerLoginConfirmation handles one-click answer from new device notice.
+// Denied sign in revokes every session and trusted device of the user and requires password reset before next sign in.
+// Confirmation can be answered only once
+func (s *Service) AnswerLoginConfirmation(ctx context.Context, token string) (entity.LoginConfirmationAnswer, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.AnswerLoginConfirmation"
+	log := s.log.With("op", op, "correlationId", correlationId)
+	start := time.Now()
+	defer func() { log.Debug("AnswerLoginConfirmation finished", "duration", time.Since(start)) }()
+
+	sessionId, nonce, answer, ok := s.parseLoginConfirmationToken(token)
+	if !ok {
+		log.Info("invalid login confirmation token")
+		return "", ErrInvalidLoginConfirmation
+	}
+	log = log.With("sessionId", sessionId, "answer", answer)
+
+	confirmation, err := s.loginConfirmationsRepo.GetAndDelete(ctx, sessionId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", ErrInvalidLoginConfirmation
+		}
+		log.Error("failed to get login confirmation", "err", err)
+		return "", err
+	}
+	if subtle.ConstantTimeCompare([]byte(confirmation.Nonce), []byte(nonce)) != 1 {
+		log.Info("login confirmation nonce mismatch", "userId", confirmation.UserId)
+		return "", ErrInvalidLoginConfirmation
+	}
+	log = log.With("userId", confirmation.UserId)
+
+	event := &entity.SecurityEvent{
+		UserId:  confirmation.UserId,
+		Type:    entity.SECURITY_EVENT_LOGIN_CONFIRMED,
+		Details: map[string]string{"session_id": sessionId},
+	}
+	if answer == entity.LOGIN_CONFIRMATION_APPROVE {
+		log.Debug("sign in confirmed by user")
+		s.recordSecurityEvent(ctx, event)
+		return answer, nil
+	}
+
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, confirmation.UserId, ""); err != nil {
+		log.Error("failed to revoke sessions", "err", err)
+		return "", err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, confirmation.UserId); err != nil {
+		log.Error("failed to revoke trusted devices", "err", err)
+		return "", err
+	}
+	if err = s.accessTokensRepo.DeleteAllByUserId(ctx, confirmation.UserId); err != nil {
+		log.Error("failed to revoke access tokens", "err", err)
+		return "", err
+	}
+	if err = s.usersRepo.UpdateMustResetPasswordById(ctx, confirmation.UserId, true); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", ErrUserNotFound
+		}
+		log.Error("failed to require password reset", "err", err)
+		return "", err
+	}
+	log.Info("sign in denied by user, all sessions revoked")
+	event.Type = entity.SECURITY_EVENT_LOGIN_DENIED
+	s.recordSecurityEvent(ctx, event)
+
+	user, err := s.usersRepo.GetByID(ctx, confirmation.UserId)
+	if err != nil {
+		log.Error("failed to get user", "err", err)
+		return answer, nil
+	}
+	if err = s.sendPasswordResetCode(ctx, user); err != nil {
+		log.Error("failed to send password reset code", "err", err)
+	}
+
+	return answer, nil
+}
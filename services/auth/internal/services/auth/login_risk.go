@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+var loginRiskWeights = map[entity.LoginRiskSignal]int{
+	entity.LOGIN_RISK_IMPOSSIBLE_TRAVEL: 60,
+	entity.LOGIN_RISK_RISKY_NETWORK:     40,
+	entity.LOGIN_RISK_NEW_COUNTRY:       30,
+	entity.LOGIN_RISK_NEW_DEVICE:        20,
+}
+
+var successfulLoginEvents = []entity.SecurityEventType{
+	entity.SECURITY_EVENT_SIGN_UP,
+	entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED,
+	entity.SECURITY_EVENT_QR_LOGIN,
+}
+
+func (s *Service) isLoginRiskDetectionEnabled() bool {
+	return s.loginRiskThreshold > 0
+}
+
+// deviceKey identifies device the same way as sessions do, see entity.AuthSession.DeviceKey
+func (s *Service) deviceKey(deviceInfo string) string {
+	session := entity.AuthSession{DeviceInfo: deviceInfo, Device: s.userAgentParser.Parse(deviceInfo)}
+	return session.DeviceKey()
+}
+
+// assessLoginRisk scores sign in attempt against user's recent logins and active sessions.
+// Failures to fetch history are logged and result in lower score
+func (s *Service) assessLoginRisk(ctx context.Context, user *entity.User, ip string, deviceInfo string) *entity.LoginRisk {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.assessLoginRisk"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", user.Id, "ip", ip)
+
+	risk := &entity.LoginRisk{}
+	if s.riskyNetworks.Contains(ip) {
+		risk.AddSignal(entity.LOGIN_RISK_RISKY_NETWORK, loginRiskWeights[entity.LOGIN_RISK_RISKY_NETWORK])
+	}
+
+	location, err := s.geoIpApi.GetLocationByIP(ctx, ip)
+	if err != nil {
+		log.Error("failed to resolve location", "err", err)
+	} else {
+		risk.Location = location
+	}
+
+	history, err := s.securityEventsRepo.GetMany(ctx, &dtos.SecurityEventsFilter{
+		UserId: user.Id,
+		Types:  successfulLoginEvents,
+		Limit:  config.LOGIN_RISK_HISTORY_SIZE,
+	})
+	if err != nil {
+		log.Error("failed to get login history", "err", err)
+		return risk
+	}
+	sessions, err := s.sessionsRepo.GetAllByUserId(ctx, user.Id)
+	if err != nil {
+		log.Error("failed to get active sessions", "err", err)
+		return risk
+	}
+	if len(history) == 0 && len(sessions) == 0 {
+		// nothing to compare with
+		return risk
+	}
+
+	currentDeviceKey := s.deviceKey(deviceInfo)
+	isKnownDevice := false
+	for _, session := range sessions {
+		if session.DeviceKey() == currentDeviceKey {
+			isKnownDevice = true
+			break
+		}
+	}
+
+	isKnownCountry := false
+	checkedTravel := false
+	for _, event := range history {
+		if !isKnownDevice && s.deviceKey(event.DeviceInfo) == currentDeviceKey {
+			isKnownDevice = true
+		}
+		if !location.HasCoordinates() || event.IpAddr == "" || (isKnownCountry && checkedTravel) {
+			continue
+		}
+
+		prevLocation, err := s.geoIpApi.GetLocationByIP(ctx, event.IpAddr)
+		if err != nil || !prevLocation.HasCoordinates() {
+			continue
+		}
+		if prevLocation.CountryCode == location.CountryCode {
+			isKnownCountry = true
+		}
+		// history is sorted from newest to oldest so only the latest located login is compared
+		if !checkedTravel {
+			checkedTravel = true
+			if isImpossibleTravel(prevLocation, location, time.Since(event.CreatedAt)) {
+				risk.AddSignal(entity.LOGIN_RISK_IMPOSSIBLE_TRAVEL, loginRiskWeights[entity.LOGIN_RISK_IMPOSSIBLE_TRAVEL])
+			}
+		}
+	}
+
+	if !isKnownDevice {
+		risk.AddSignal(entity.LOGIN_RISK_NEW_DEVICE, loginRiskWeights[entity.LOGIN_RISK_NEW_DEVICE])
+	}
+	if checkedTravel && !isKnownCountry {
+		risk.AddSignal(entity.LOGIN_RISK_NEW_COUNTRY, loginRiskWeights[entity.LOGIN_RISK_NEW_COUNTRY])
+	}
+	log.Debug("assessed login risk", "score", risk.Score, "signals", risk.Signals)
+
+	return risk
+}
+
+func isImpossibleTravel(from *entity.GeoLocation, to *entity.GeoLocation, elapsed time.Duration) bool {
+	distance := from.DistanceKm(to)
+	if distance < config.MIN_TRAVEL_DISTANCE_KM {
+		return false
+	}
+	hours := max(elapsed.Hours(), time.Minute.Hours())
+	return distance/hours > config.MAX_TRAVEL_SPEED_KMH
+}
+
+// reportSuspiciousLogin records flagged sign in to audit log and notifies security team.
+// Failures are logged and never interrupt sign in
+func (s *Service) reportSuspiciousLogin(ctx context.Context, user *entity.User, risk *entity.LoginRisk, ip string, deviceInfo string, challenged bool) {
+	signals := make([]string, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = string(signal)
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:     user.Id,
+		Type:       entity.SECURITY_EVENT_SUSPICIOUS_LOGIN,
+		IpAddr:     ip,
+		DeviceInfo: deviceInfo,
+		Location:   risk.Location.String(),
+		Details: map[string]string{
+			"score":      strconv.Itoa(risk.Score),
+			"signals":    strings.Join(signals, ","),
+			"challenged": strconv.FormatBool(challenged),
+		},
+	})
+
+	if err := s.securityAlerts.PublishSuspiciousLogin(ctx, user, risk, ip, deviceInfo, challenged); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - reportSuspiciousLogin - securityAlerts.PublishSuspiciousLogin: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id,
+		)
+	}
+}
+
+// loginChallengeBinding ties login challenge code to sign in attempt it was issued for
+func loginChallengeBinding(login string, ip string, deviceInfo string) string {
+	return strings.Join([]string{login, ip, deviceInfo}, "|")
+}
+
+// sendLoginChallenge delivers code confirming suspicious sign in.
+// Linked telegram chat is preferred over email
+func (s *Service) sendLoginChallenge(ctx context.Context, user *entity.User, login string, ip string, deviceInfo string, risk *entity.LoginRisk) error {
+	otpCode, err := s.createScopedOtp(ctx, entity.OTP_PURPOSE_LOGIN_CHALLENGE, user.Id, loginChallengeBinding(login, ip, deviceInfo))
+	if err != nil {
+		return err
+	}
+
+	if user.TwoFactorAuth != nil && user.TwoFactorAuth.Method == entity.TWO_FA_TELEGRAM && user.TwoFactorAuth.Contact != "" {
+		text := fmt.Sprintf(
+			"Someone is trying to sign in to your account\nLocation: %s\nIP: %s\nConfirmation code: %s",
+			risk.Location.String(), ip, otpCode,
+		)
+		return s.tgApi.SendTextMsg(ctx, user.TwoFactorAuth.Contact, text)
+	}
+
+	return s.notificationsClient.SendLoginChallengeEmail(ctx, user.Email, user.GetDisplayName(), otpCode, ip, risk.Location.String(), user.Language)
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// RequestMagicLink emails link and code which sign user in without password from the requesting client.
+// Previous link requested by the same client stops working.
+// It does not reveal whether account with such email exists or may be signed in
+func (s *Service) RequestMagicLink(ctx context.Context, dto *dtos.RequestMagicLinkRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestMagicLink"
+	log := s.log.With("op", op, "correlationId", correlationId, "email", dto.Email, "clientId", dto.ClientId)
+	start := time.Now()
+	defer func() { log.Debug("RequestMagicLink finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, dto.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Debug("magic link requested for unknown email")
+			return nil
+		}
+		return err
+	}
+	if err = accountStateError(user); err != nil {
+		log.Debug("magic link requested for inactive account", "userId", user.Id, "state", user.EffectiveState())
+		return nil
+	}
+
+	plainToken := s.securityProvider.GenerateSecretTokenUrlSafe(config.MAGIC_LINK_TOKEN_LENGTH)
+	plainCode := s.securityProvider.GenerateOTPCode()
+	link := &entity.MagicLink{
+		ClientId:  dto.ClientId,
+		UserId:    user.Id,
+		TokenHash: s.securityProvider.HashToken(plainToken),
+		Code:      s.hashOtp(plainCode),
+	}
+	if err = s.magicLinksRepo.CreateWithTTL(ctx, link, s.magicLinkTTL); err != nil {
+		log.Error("failed to save magic link", "err", err, "userId", user.Id)
+		return err
+	}
+
+	location := s.resolveLocation(ctx, dto.IpAddr)
+	if err = s.notificationsClient.SendMagicLinkEmail(
+		ctx, user.Email, user.GetDisplayName(), plainToken, plainCode, dto.IpAddr, location, user.Language,
+	); err != nil {
+		log.Error("failed to send magic link", "err", err, "userId", user.Id)
+		return err
+	}
+	log.Debug("magic link sent", "userId", user.Id)
+
+	return nil
+}
+
+// SignInWithMagicLink completes passwordless sign in with token from emailed link or with emailed code.
+// Link proves ownership of email, so suspicious sign in is not challenged once again,
+// but 2FA is still required unless device is trusted and sign in does not look suspicious
+func (s *Service) SignInWithMagicLink(ctx context.Context, dto *dtos.MagicLinkSignInRequest) (*dtos.SignInResponse, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.SignInWithMagicLink"
+	log := s.log.With("op", op, "correlationId", correlationId, "clientId", dto.ClientId)
+	start := time.Now()
+	defer func() { log.Debug("SignInWithMagicLink finished", "duration", time.Since(start)) }()
+
+	link, err := s.magicLinksRepo.GetByClientId(ctx, dto.ClientId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidMagicLink
+		}
+		return nil, err
+	}
+	user, err := s.usersRepo.GetByID(ctx, link.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidMagicLink
+		}
+		return nil, err
+	}
+
+	isValid := false
+	if dto.Token != "" {
+		isValid = subtle.ConstantTimeCompare([]byte(s.securityProvider.HashToken(dto.Token)), []byte(link.TokenHash)) == 1
+	} else if dto.Code != "" {
+		isValid = s.isOtpValid(link.Code, dto.Code)
+	}
+	if !isValid {
+		log.Info("invalid magic link", "userId", user.Id)
+		s.recordSignInFailure(ctx, user.Id, user.Email, dto.IpAddr, dto.DeviceInfo, "invalid_magic_link")
+		s.lockAccountIfAbused(ctx, user)
+		s.invalidateMagicLinkIfGuessed(ctx, link)
+		return nil, ErrInvalidMagicLink
+	}
+	// link is consumed before session is created, so it can not be used twice concurrently
+	if err = s.magicLinksRepo.Delete(ctx, link); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidMagicLink
+		}
+		log.Error("failed to delete magic link", "err", err, "userId", user.Id)
+		return nil, err
+	}
+
+	if err = accountStateError(user); err != nil {
+		s.recordSignInFailure(ctx, user.Id, user.Email, dto.IpAddr, dto.DeviceInfo, "account_"+string(user.EffectiveState()))
+		return nil, err
+	}
+	if user.MustResetPassword {
+		s.recordSignInFailure(ctx, user.Id, user.Email, dto.IpAddr, dto.DeviceInfo, "password_reset_required")
+		return nil, ErrPasswordResetRequired
+	}
+
+	suspicious := false
+	if s.isLoginRiskDetectionEnabled() {
+		risk := s.assessLoginRisk(ctx, user, dto.IpAddr, dto.DeviceInfo)
+		if risk.Score >= s.loginRiskThreshold {
+			suspicious = true
+			log.Info("suspicious sign in", "userId", user.Id, "score", risk.Score, "signals", risk.Signals)
+			s.reportSuspiciousLogin(ctx, user, risk, dto.IpAddr, dto.DeviceInfo, false)
+		}
+	}
+
+	if user.Is2FAEnabled() && !suspicious && s.isTrustedDevice(ctx, user.Id, dto.TrustedDeviceToken) {
+		log.Debug("skipping 2FA on trusted device", "userId", user.Id)
+	} else if user.Is2FAEnabled() {
+		return s.startTwoFa(ctx, user)
+	}
+
+	session, err := s.newAuthSession(
+		ctx, user, dto.IpAddr, dto.DeviceInfo, dto.PublicKey, dto.RememberMe, entity.AUTH_METHOD_MAGIC_LINK, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("created auth session", "userId", user.Id, "sessionId", session.Id)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED, session)
+
+	return &dtos.SignInResponse{
+		User:    user,
+		Session: session,
+	}, nil
+}
+
+// invalidateMagicLinkIfGuessed counts failed attempt to use link and removes it once
+// attempts are exhausted, so that short emailed code can't be brute forced. Failures are logged
+func (s *Service) invalidateMagicLinkIfGuessed(ctx context.Context, link *entity.MagicLink) {
+	log := s.log.With("correlationId", logger.CorrelationIDFromContext(ctx), "userId", link.UserId, "clientId", link.ClientId)
+
+	attempts, err := s.magicLinksRepo.IncrementAttempts(ctx, link)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			log.Error("failed to count magic link attempt", "err", err)
+		}
+		return
+	}
+	if attempts < config.MAX_OTP_ATTEMPTS {
+		return
+	}
+	if err = s.magicLinksRepo.Delete(ctx, link); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.Error("failed to delete magic link after too many attempts", "err", err)
+		return
+	}
+	log.Info("magic link invalidated after too many attempts")
+}
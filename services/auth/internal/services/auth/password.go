@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/pkg/validator"
+)
+
+// checkPasswordPolicy returns PasswordPolicyError if new password violates configured policy.
+// userInputs are user's personal values password must not contain
+func (s *Service) checkPasswordPolicy(field string, password string, userInputs ...string) error {
+	validate := validator.New()
+	validate.CheckPassword(s.passwordPolicy, field, password, userInputs...)
+	if len(validate.Errors) > 0 {
+		return &PasswordPolicyError{Violations: validate.Errors}
+	}
+	return nil
+}
+
+func (s *Service) sendPasswordResetCode(ctx context.Context, user *entity.User) error {
+	// reset may be completed from any device, so code is not bound to the one which requested it
+	otpCode, err := s.createScopedOtp(ctx, entity.OTP_PURPOSE_PASSWORD_RESET, user.Id, "")
+	if err != nil {
+		return err
+	}
+	return s.notificationsClient.SendPasswordResetEmail(ctx, user.Email, user.GetDisplayName(), otpCode, user.Language)
+}
+
+// RequestPasswordReset sends password reset code to email.
+// It does not reveal whether account with such email exists
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestPasswordReset"
+	log := s.log.With("op", op, "correlationId", correlationId, "email", email)
+	start := time.Now()
+	defer func() { log.Debug("RequestPasswordReset finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, email)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Debug("password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+	if !user.IsActive() {
+		log.Debug("password reset requested for deactivated account", "userId", user.Id)
+		return nil
+	}
+
+	if err = s.sendPasswordResetCode(ctx, user); err != nil {
+		log.Error("failed to send password reset code", "err", err, "userId", user.Id)
+		return err
+	}
+	log.Debug("password reset code sent", "userId", user.Id)
+
+	return nil
+}
+
+// ResetPassword sets new password if code is valid and signs user out everywhere
+func (s *Service) ResetPassword(ctx context.Context, dto *dtos.ResetPasswordRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ResetPassword"
+	log := s.log.With("op", op, "correlationId", correlationId, "email", dto.Email)
+	start := time.Now()
+	defer func() { log.Debug("ResetPassword finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, dto.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrOtpIsNotValid
+		}
+		return err
+	}
+	if err = accountStateError(user); err != nil {
+		return err
+	}
+
+	// policy is checked first so that weak password does not waste the code
+	if err = s.checkPasswordPolicy("new_password", dto.NewPassword, user.Username, user.Email); err != nil {
+		return err
+	}
+	if err = s.consumeScopedOtp(ctx, entity.OTP_PURPOSE_PASSWORD_RESET, user.Id, "", dto.Code); err != nil {
+		log.Error("invalid password reset code", "err", err, "userId", user.Id)
+		return err
+	}
+
+	hashedPassword, err := s.securityProvider.HashPassword(dto.NewPassword)
+	if err != nil {
+		log.Error("failed to hash password", "err", err, "userId", user.Id)
+		return err
+	}
+	if err = s.usersRepo.UpdatePasswordById(ctx, user.Id, hashedPassword); err != nil {
+		log.Error("failed to update password", "err", err, "userId", user.Id)
+		return err
+	}
+	log.Info("password reset", "userId", user.Id)
+
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, user.Id, ""); err != nil {
+		log.Error("failed to revoke sessions after password reset", "err", err, "userId", user.Id)
+		return err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke trusted devices after password reset", "err", err, "userId", user.Id)
+		return err
+	}
+	if err = s.accessTokensRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke access tokens after password reset", "err", err, "userId", user.Id)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{UserId: user.Id, Type: entity.SECURITY_EVENT_PASSWORD_RESET})
+
+	return nil
+}
+
+// rehashPasswordIfNeeded upgrades stored hash produced by outdated algorithm or parameters.
+// It must be called only after plain password was verified. Failures are logged and never interrupt sign in
+func (s *Service) rehashPasswordIfNeeded(ctx context.Context, user *entity.User, plainPassword string) {
+	if !s.securityProvider.PasswordNeedsRehash(user.Password) {
+		return
+	}
+	hashedPassword, err := s.securityProvider.HashPassword(plainPassword)
+	if err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - rehashPasswordIfNeeded - securityProvider.HashPassword: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id,
+		)
+		return
+	}
+	if err = s.usersRepo.UpdatePasswordById(ctx, user.Id, hashedPassword); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - rehashPasswordIfNeeded - usersRepo.UpdatePasswordById: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id,
+		)
+		return
+	}
+	user.Password = hashedPassword
+}
+
+// ChangePassword replaces password of signed in user and revokes all other sessions and trusted devices
+func (s *Service) ChangePassword(ctx context.Context, dto *dtos.ChangePasswordRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ChangePassword"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId, "sessionId", dto.SessionId)
+	start := time.Now()
+	defer func() { log.Debug("ChangePassword finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByID(ctx, dto.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return err
+	}
+	if err = accountStateError(user); err != nil {
+		return err
+	}
+	if err = s.securityProvider.ComparePasswords(user.Password, dto.CurrentPassword); err != nil {
+		log.Info("invalid current password", "err", err)
+		return ErrInvalidCredentials
+	}
+	if err = s.checkPasswordPolicy("new_password", dto.NewPassword, user.Username, user.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.securityProvider.HashPassword(dto.NewPassword)
+	if err != nil {
+		log.Error("failed to hash password", "err", err)
+		return err
+	}
+	if err = s.usersRepo.UpdatePasswordById(ctx, user.Id, hashedPassword); err != nil {
+		log.Error("failed to update password", "err", err)
+		return err
+	}
+	log.Info("password changed")
+
+	if err = s.sessionsRepo.DeleteAllByUserId(ctx, user.Id, dto.SessionId); err != nil {
+		log.Error("failed to revoke other sessions after password change", "err", err)
+		return err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, user.Id); err != nil {
+		log.Error("failed to revoke trusted devices after password change", "err", err)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  user.Id,
+		Type:    entity.SECURITY_EVENT_PASSWORD_CHANGED,
+		Details: map[string]string{"session_id": dto.SessionId},
+	})
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// createLoginToken saves new QR login token for client.
+// watchId is empty unless client waits for token to be accepted within WatchQRLogin
+func (s *Service) createLoginToken(
+	ctx context.Context,
+	clientId string,
+	ip string,
+	deviceInfo string,
+	watchId string,
+) (*entity.QRCodeLoginToken, error) {
+	token := &entity.QRCodeLoginToken{
+		ClientId:   clientId,
+		Value:      s.securityProvider.GenerateSecretTokenUrlSafe(config.LOGIN_TOKEN_LENGTH),
+		WatchId:    watchId,
+		IpAddr:     ip,
+		DeviceInfo: deviceInfo,
+	}
+	if err := s.loginTokenRepo.CreateWithTTL(ctx, token, s.loginTokenTTL); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// loginTokenUrl is opened by authorized device which scanned QR code to accept the token
+func (s *Service) loginTokenUrl(token *entity.QRCodeLoginToken) string {
+	tokenUrl := s.appUrl.JoinPath("qr-login")
+	tokenUrl.RawQuery = url.Values{"client_id": {token.ClientId}, "token": {token.Value}}.Encode()
+	return tokenUrl.String()
+}
+
+// RenderLoginTokenQRCode renders url accepting the token as QR code image
+func (s *Service) RenderLoginTokenQRCode(token *entity.QRCodeLoginToken, format entity.QRCodeFormat) ([]byte, error) {
+	switch format {
+	case entity.QR_CODE_FORMAT_PNG:
+		return s.qrCodeRenderer.RenderPNG(s.loginTokenUrl(token))
+	case entity.QR_CODE_FORMAT_SVG:
+		return s.qrCodeRenderer.RenderSVG(s.loginTokenUrl(token))
+	default:
+		return nil, ErrUnsupportedQRCodeFormat
+	}
+}
+
+// publishQRLoginAcceptance delivers session created by accepted token to client waiting in WatchQRLogin.
+// Failures are logged since session is already created and accepting device still receives it
+func (s *Service) publishQRLoginAcceptance(ctx context.Context, token *entity.QRCodeLoginToken, session *entity.AuthSession) {
+	if err := s.loginTokenRepo.PublishAcceptance(ctx, token.WatchId, &entity.QRLoginAcceptance{
+		UserId:    session.UserId,
+		SessionId: session.Id,
+	}); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - publishQRLoginAcceptance - loginTokenRepo.PublishAcceptance: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "clientId", token.ClientId,
+		)
+	}
+}
+
+// WatchQRLogin pushes QR login updates to unauthorized client instead of making it poll.
+// New token is sent before previous one expires, so displayed QR code always works.
+// Once any of tokens is accepted by another device created session is sent and watching is over.
+// It returns when session is sent, send fails or ctx is done
+func (s *Service) WatchQRLogin(
+	ctx context.Context,
+	dto *dtos.WatchQRLoginRequest,
+	send func(update *dtos.QRLoginUpdate) error,
+) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.WatchQRLogin"
+	log := s.log.With("op", op, "correlationId", correlationId, "clientId", dto.ClientId)
+	start := time.Now()
+	defer func() { log.Debug("WatchQRLogin finished", "duration", time.Since(start)) }()
+
+	// subscribe before the first token is sent, so that acceptance can not be missed
+	watchId := s.securityProvider.GenerateSecretTokenUrlSafe(config.LOGIN_WATCH_ID_LENGTH)
+	acceptances, closeSubscription, err := s.loginTokenRepo.SubscribeAcceptances(ctx, watchId)
+	if err != nil {
+		log.Error("failed to subscribe to login token acceptances", "err", err)
+		return err
+	}
+	defer closeSubscription()
+	// tokens must not outlive the stream, otherwise they could be accepted without anyone waiting for a session.
+	// Only tokens of this watch are deleted, other watches of the same client are not affected
+	defer func() {
+		if err := s.loginTokenRepo.DeleteAllByWatch(context.WithoutCancel(ctx), dto.ClientId, watchId); err != nil {
+			log.Error("failed to delete login tokens after watching", "err", err)
+		}
+	}()
+
+	// refresh token while a quarter of its lifetime is left, so client has time to replace QR code
+	refreshInterval := s.loginTokenTTL - s.loginTokenTTL/4
+	refresh := time.NewTimer(0)
+	defer refresh.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-refresh.C:
+			token, err := s.createLoginToken(ctx, dto.ClientId, dto.IpAddr, dto.DeviceInfo, watchId)
+			if err != nil {
+				log.Error("failed to create login token", "err", err)
+				return err
+			}
+			update := &dtos.QRLoginUpdate{
+				Token:     token,
+				Url:       s.loginTokenUrl(token),
+				ExpiresAt: time.Now().Add(s.loginTokenTTL),
+			}
+			if dto.QRCodeFormat != "" {
+				update.QRCode, err = s.RenderLoginTokenQRCode(token, dto.QRCodeFormat)
+				if err != nil {
+					log.Error("failed to render login token", "err", err, "format", dto.QRCodeFormat)
+					return err
+				}
+			}
+			if err = send(update); err != nil {
+				return err
+			}
+			log.Debug("login token refreshed")
+			refresh.Reset(refreshInterval)
+		case acceptance, ok := <-acceptances:
+			if !ok {
+				return errors.New("login token acceptances subscription closed")
+			}
+			session, err := s.sessionsRepo.GetById(ctx, acceptance.UserId, acceptance.SessionId)
+			if err != nil {
+				log.Error("failed to get session created by accepted token", "err", err, "userId", acceptance.UserId)
+				return err
+			}
+			log.Debug("login token accepted", "userId", session.UserId, "sessionId", session.Id)
+			return send(&dtos.QRLoginUpdate{Session: session})
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// requireRecentAuth ensures that user proved their identity within session recently enough to perform sensitive action
+func (s *Service) requireRecentAuth(ctx context.Context, userId int, sessionId string) error {
+	session, err := s.sessionsRepo.GetById(ctx, userId, sessionId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+	if !session.IsRecentlyAuthenticated(s.reauthWindow) {
+		return ErrReauthenticationRequired
+	}
+	return nil
+}
+
+// sendTwoFaCode delivers otp code through user's 2FA channel which supports delivery
+func (s *Service) sendTwoFaCode(ctx context.Context, user *entity.User, otpCode string) error {
+	contact := user.TwoFactorAuth.Contact
+	switch user.TwoFactorAuth.Method {
+	case entity.TWO_FA_EMAIL:
+		toEmail := user.Email
+		if contact != "" {
+			toEmail = contact
+		}
+		return s.notificationsClient.SendConfirmEmailTwoFaEmail(ctx, toEmail, user.GetDisplayName(), otpCode, user.Language)
+	case entity.TWO_FA_TELEGRAM:
+		return s.tgApi.SendTextMsg(ctx, contact, fmt.Sprintf("Authorization code: %s", otpCode))
+	default:
+		return ErrUnsupported2FAMethod
+	}
+}
+
+// RequestReauthenticationCode sends 2FA code required to re-authenticate within session.
+// Code is valid only within the session it was requested for.
+// Nothing is sent if user has TOTP app 2FA since the app generates codes on its own
+func (s *Service) RequestReauthenticationCode(ctx context.Context, userId int, sessionId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RequestReauthenticationCode"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("RequestReauthenticationCode finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return err
+	}
+	if !user.Is2FAEnabled() {
+		return Err2FANotEnabled
+	}
+	if user.TwoFactorAuth.Method == entity.TWO_FA_TOTP_APP {
+		return nil
+	}
+
+	otpCode, err := s.createScopedOtp(ctx, entity.OTP_PURPOSE_REAUTHENTICATION, user.Id, sessionId)
+	if err != nil {
+		log.Error("failed to create otp", "err", err)
+		return err
+	}
+	if err = s.sendTwoFaCode(ctx, user, otpCode); err != nil {
+		log.Error("failed to send reauthentication code", "err", err, "method", user.TwoFactorAuth.Method)
+		return err
+	}
+	log.Debug("reauthentication code sent", "method", user.TwoFactorAuth.Method)
+
+	return nil
+}
+
+// Reauthenticate verifies user's password or 2FA code and marks session as recently authenticated,
+// which is required by sensitive actions
+func (s *Service) Reauthenticate(ctx context.Context, dto *dtos.ReauthenticateRequest) (*entity.AuthSession, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.Reauthenticate"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", dto.UserId, "sessionId", dto.SessionId, "method", dto.Method)
+	start := time.Now()
+	defer func() { log.Debug("Reauthenticate finished", "duration", time.Since(start)) }()
+
+	session, err := s.sessionsRepo.GetById(ctx, dto.UserId, dto.SessionId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		log.Error("failed to get session", "err", err)
+		return nil, err
+	}
+	user, err := s.usersRepo.GetByID(ctx, dto.UserId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+
+	switch dto.Method {
+	case entity.AUTH_METHOD_PASSWORD:
+		if err = s.securityProvider.ComparePasswords(user.Password, dto.Password); err != nil {
+			log.Info("invalid password", "err", err)
+			return nil, s.failReauthentication(ctx, session, ErrInvalidCredentials)
+		}
+	case entity.AUTH_METHOD_TWO_FA:
+		if err = s.verifyReauthenticationCode(ctx, user, session.Id, dto.Code); err != nil {
+			if errors.Is(err, ErrOtpIsNotValid) {
+				log.Info("invalid reauthentication code")
+				return nil, s.failReauthentication(ctx, session, err)
+			}
+			return nil, err
+		}
+	default:
+		return nil, ErrUnsupportedAuthMethod
+	}
+
+	session.AuthenticatedAt = time.Now().UTC()
+	session.AuthMethod = dto.Method
+	if err = s.sessionsRepo.UpdateAuthenticationById(ctx, user.Id, session.Id, session.AuthenticatedAt, session.AuthMethod); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		log.Error("failed to update session authentication", "err", err)
+		return nil, err
+	}
+	log.Debug("session reauthenticated")
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_REAUTHENTICATED, session)
+
+	return session, nil
+}
+
+func (s *Service) verifyReauthenticationCode(ctx context.Context, user *entity.User, sessionId string, code string) error {
+	if !user.Is2FAEnabled() {
+		return Err2FANotEnabled
+	}
+
+	if user.TwoFactorAuth.Method == entity.TWO_FA_TOTP_APP {
+		secret, err := s.decryptTotpSecret(ctx, user)
+		if err != nil {
+			return err
+		}
+		if !s.securityProvider.ValidateTOTP(code, secret) {
+			return ErrOtpIsNotValid
+		}
+		return nil
+	}
+
+	return s.consumeScopedOtp(ctx, entity.OTP_PURPOSE_REAUTHENTICATION, user.Id, sessionId, code)
+}
+
+// failReauthentication records failed attempt and signs session out once config.MAX_OTP_ATTEMPTS attempts
+// in a row failed within it, so password or code can not be brute forced with hijacked session.
+// It returns error the caller should report
+func (s *Service) failReauthentication(ctx context.Context, session *entity.AuthSession, cause error) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_REAUTHENTICATION_FAILED, session)
+
+	attempts, err := s.securityEventsRepo.GetMany(ctx, &dtos.SecurityEventsFilter{
+		UserId: session.UserId,
+		Types:  []entity.SecurityEventType{entity.SECURITY_EVENT_REAUTHENTICATION_FAILED, entity.SECURITY_EVENT_REAUTHENTICATED},
+		Limit:  config.MAX_OTP_ATTEMPTS,
+	})
+	if err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - failReauthentication - securityEventsRepo.GetMany: %w", err),
+			"correlationId", correlationId, "userId", session.UserId,
+		)
+		return cause
+	}
+	if len(attempts) < config.MAX_OTP_ATTEMPTS {
+		return cause
+	}
+	for _, attempt := range attempts {
+		if attempt.Type != entity.SECURITY_EVENT_REAUTHENTICATION_FAILED || attempt.Details["session_id"] != session.Id {
+			return cause
+		}
+	}
+
+	if err = s.sessionsRepo.DeleteById(ctx, session.UserId, session.Id); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		s.log.Error(
+			fmt.Errorf("AuthService - failReauthentication - sessionsRepo.DeleteById: %w", err),
+			"correlationId", correlationId, "userId", session.UserId, "sessionId", session.Id,
+		)
+		return err
+	}
+	s.log.Info("session signed out after too many failed reauthentication attempts",
+		"correlationId", correlationId, "userId", session.UserId, "sessionId", session.Id)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  session.UserId,
+		Type:    entity.SECURITY_EVENT_SESSION_REVOKED,
+		Details: map[string]string{"session_id": session.Id, "reason": "too_many_reauthentication_attempts"},
+	})
+	return ErrTooManyReauthenticationAttempts
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+	"github.com/modulix-systems/goose-talk/rbac"
+)
+
+// GetUserPermissions resolves permissions granted to user by their roles.
+// It is used by other services to enforce access to their RPCs, so inactive accounts have no permissions
+func (s *Service) GetUserPermissions(ctx context.Context, userId int) ([]rbac.Permission, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetUserPermissions"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetUserPermissions finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return nil, err
+	}
+	if !user.IsActive() {
+		return []rbac.Permission{}, nil
+	}
+
+	permissions, err := s.rolesRepo.GetPermissionsByUserId(ctx, userId)
+	if err != nil {
+		log.Error("failed to get permissions", "err", err)
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (s *Service) GetRoles(ctx context.Context, adminId int, sessionId string) ([]entity.Role, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetRoles"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", adminId)
+	start := time.Now()
+	defer func() { log.Debug("GetRoles finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, adminId, sessionId, entity.PERMISSION_ROLES_READ); err != nil {
+		return nil, err
+	}
+	roles, err := s.rolesRepo.GetAll(ctx)
+	if err != nil {
+		log.Error("failed to get roles", "err", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// GetUserRoles returns roles assigned to user
+func (s *Service) GetUserRoles(ctx context.Context, dto *dtos.AdminUserRequest) ([]entity.Role, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetUserRoles"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId)
+	start := time.Now()
+	defer func() { log.Debug("GetUserRoles finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_ROLES_READ); err != nil {
+		return nil, err
+	}
+	roles, err := s.rolesRepo.GetAllByUserId(ctx, dto.UserId)
+	if err != nil {
+		log.Error("failed to get user roles", "err", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (s *Service) SaveRole(ctx context.Context, dto *dtos.SaveRoleRequest) (*entity.Role, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.SaveRole"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "role", dto.Name)
+	start := time.Now()
+	defer func() { log.Debug("SaveRole finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_ROLES_WRITE); err != nil {
+		return nil, err
+	}
+	permissions := make([]string, len(dto.Permissions))
+	for i, permission := range dto.Permissions {
+		permissions[i] = string(permission)
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId: dto.AdminId,
+		Action:  entity.ADMIN_ACTION_SAVE_ROLE,
+		Details: map[string]string{"role": dto.Name, "permissions": strings.Join(permissions, ",")},
+	}); err != nil {
+		return nil, err
+	}
+
+	role, err := s.rolesRepo.Save(ctx, &entity.Role{
+		Name:        dto.Name,
+		Description: dto.Description,
+		Permissions: dto.Permissions,
+	})
+	if err != nil {
+		log.Error("failed to save role", "err", err)
+		return nil, err
+	}
+	log.Info("role saved", "permissions", permissions)
+
+	return role, nil
+}
+
+// DeleteRole removes role and revokes it from all users
+func (s *Service) DeleteRole(ctx context.Context, adminId int, sessionId string, name string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.DeleteRole"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", adminId, "role", name)
+	start := time.Now()
+	defer func() { log.Debug("DeleteRole finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, adminId, sessionId, entity.PERMISSION_ROLES_WRITE); err != nil {
+		return err
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId: adminId,
+		Action:  entity.ADMIN_ACTION_DELETE_ROLE,
+		Details: map[string]string{"role": name},
+	}); err != nil {
+		return err
+	}
+
+	if err := s.rolesRepo.DeleteByName(ctx, name); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrRoleNotFound
+		}
+		log.Error("failed to delete role", "err", err)
+		return err
+	}
+	log.Info("role deleted")
+
+	return nil
+}
+
+func (s *Service) AssignRole(ctx context.Context, dto *dtos.UserRoleRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.AssignRole"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId, "role", dto.RoleName)
+	start := time.Now()
+	defer func() { log.Debug("AssignRole finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_ROLES_WRITE); err != nil {
+		return err
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId:      dto.AdminId,
+		TargetUserId: dto.UserId,
+		Action:       entity.ADMIN_ACTION_ASSIGN_ROLE,
+		Details:      map[string]string{"role": dto.RoleName},
+	}); err != nil {
+		return err
+	}
+
+	if err := s.rolesRepo.AssignToUser(ctx, dto.UserId, dto.RoleName, dto.AdminId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrRoleNotFound
+		}
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return ErrRoleAlreadyAssigned
+		}
+		log.Error("failed to assign role", "err", err)
+		return err
+	}
+	log.Info("role assigned")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  dto.UserId,
+		Type:    entity.SECURITY_EVENT_ROLE_ASSIGNED,
+		Details: map[string]string{"role": dto.RoleName, "admin_id": strconv.Itoa(dto.AdminId)},
+	})
+
+	return nil
+}
+
+func (s *Service) RevokeRole(ctx context.Context, dto *dtos.UserRoleRequest) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RevokeRole"
+	log := s.log.With("op", op, "correlationId", correlationId, "adminId", dto.AdminId, "userId", dto.UserId, "role", dto.RoleName)
+	start := time.Now()
+	defer func() { log.Debug("RevokeRole finished", "duration", time.Since(start)) }()
+
+	if err := s.requirePermission(ctx, dto.AdminId, dto.SessionId, entity.PERMISSION_ROLES_WRITE); err != nil {
+		return err
+	}
+	if err := s.recordAdminAction(ctx, &entity.AdminAction{
+		AdminId:      dto.AdminId,
+		TargetUserId: dto.UserId,
+		Action:       entity.ADMIN_ACTION_REVOKE_ROLE,
+		Details:      map[string]string{"role": dto.RoleName},
+	}); err != nil {
+		return err
+	}
+
+	if err := s.rolesRepo.RevokeFromUser(ctx, dto.UserId, dto.RoleName); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrRoleNotAssigned
+		}
+		log.Error("failed to revoke role", "err", err)
+		return err
+	}
+	log.Info("role revoked")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  dto.UserId,
+		Type:    entity.SECURITY_EVENT_ROLE_REVOKED,
+		Details: map[string]string{"role": dto.RoleName, "admin_id": strconv.Itoa(dto.AdminId)},
+	})
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// decryptTotpSecret returns plain TOTP secret of user.
+// Secrets encrypted with user's private key before key ring was introduced are re-encrypted on the fly,
+// migration failures are logged and do not prevent verification
+func (s *Service) decryptTotpSecret(ctx context.Context, user *entity.User) (string, error) {
+	encrypted := user.TwoFactorAuth.TotpSecret
+	if s.keyRing.IsEncrypted(encrypted) {
+		secret, err := s.keyRing.Decrypt(encrypted)
+		if err != nil {
+			return "", fmt.Errorf("keyRing.Decrypt: %w", err)
+		}
+		return string(secret), nil
+	}
+
+	secret, err := s.securityProvider.DecryptSymmetric(encrypted, user.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("securityProvider.DecryptSymmetric: %w", err)
+	}
+	if err = s.reencryptTotpSecret(ctx, user.Id, encrypted, []byte(secret)); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - decryptTotpSecret - reencryptTotpSecret: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id,
+		)
+	}
+	return secret, nil
+}
+
+func (s *Service) reencryptTotpSecret(ctx context.Context, userId int, current []byte, secret []byte) error {
+	replacement, err := s.keyRing.Encrypt(secret)
+	if err != nil {
+		return fmt.Errorf("keyRing.Encrypt: %w", err)
+	}
+	if err = s.usersRepo.ReplaceTotpSecret(ctx, userId, current, replacement); err != nil {
+		return fmt.Errorf("usersRepo.ReplaceTotpSecret: %w", err)
+	}
+	return nil
+}
+
+// ReencryptTotpSecrets walks over all TOTP secrets in batches and re-encrypts ones
+// which are not encrypted with active master key, including legacy ones. Returns number of re-encrypted secrets.
+// Secrets changed concurrently are skipped since they are already encrypted with active key
+func (s *Service) ReencryptTotpSecrets(ctx context.Context, batchSize int) (int, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.ReencryptTotpSecrets"
+	log := s.log.With("op", op, "correlationId", correlationId)
+	start := time.Now()
+	defer func() { log.Debug("ReencryptTotpSecrets finished", "duration", time.Since(start)) }()
+
+	reencrypted := 0
+	lastUserId := 0
+	for {
+		users, err := s.usersRepo.GetAllWithTotpSecret(ctx, lastUserId, batchSize)
+		if err != nil {
+			log.Error("failed to get users with totp secret", "err", err, "afterUserId", lastUserId)
+			return reencrypted, err
+		}
+		for _, user := range users {
+			lastUserId = user.Id
+			encrypted := user.TwoFactorAuth.TotpSecret
+			if !s.keyRing.NeedsRotation(encrypted) {
+				continue
+			}
+
+			var secret []byte
+			if s.keyRing.IsEncrypted(encrypted) {
+				secret, err = s.keyRing.Decrypt(encrypted)
+			} else {
+				var legacySecret string
+				legacySecret, err = s.securityProvider.DecryptSymmetric(encrypted, user.PrivateKey)
+				secret = []byte(legacySecret)
+			}
+			if err != nil {
+				log.Error("failed to decrypt totp secret", "err", err, "userId", user.Id)
+				continue
+			}
+
+			if err = s.reencryptTotpSecret(ctx, user.Id, encrypted, secret); err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+				log.Error("failed to re-encrypt totp secret", "err", err, "userId", user.Id)
+				return reencrypted, err
+			}
+			reencrypted++
+		}
+		if len(users) < batchSize {
+			break
+		}
+	}
+	log.Info("re-encrypted totp secrets", "count", reencrypted)
+
+	return reencrypted, nil
+}
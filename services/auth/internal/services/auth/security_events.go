@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const (
+	defaultSecurityEventsPageSize = 20
+	maxSecurityEventsPageSize     = 100
+)
+
+// recordSecurityEvent appends event to audit log resolving location by ip if it is missing.
+// Audit failures are logged and never interrupt the usecase
+func (s *Service) recordSecurityEvent(ctx context.Context, event *entity.SecurityEvent) {
+	if event.Location == "" && event.IpAddr != "" {
+		event.Location = s.resolveLocation(ctx, event.IpAddr)
+	}
+
+	if _, err := s.securityEventsRepo.Create(ctx, event); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - recordSecurityEvent - securityEventsRepo.Create: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", event.UserId, "type", event.Type,
+		)
+	}
+}
+
+// recordSessionEvent appends event describing action performed within given auth session
+func (s *Service) recordSessionEvent(ctx context.Context, typ entity.SecurityEventType, session *entity.AuthSession) {
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:     session.UserId,
+		Type:       typ,
+		IpAddr:     session.IpAddr,
+		DeviceInfo: session.DeviceInfo,
+		Location:   session.Location,
+		Details:    map[string]string{"session_id": session.Id},
+	})
+}
+
+// recordSignInFailure appends failed sign in attempt, userId is zero if login does not match any account
+func (s *Service) recordSignInFailure(ctx context.Context, userId int, login string, ip string, deviceInfo string, reason string) {
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:     userId,
+		Type:       entity.SECURITY_EVENT_SIGN_IN_FAILED,
+		IpAddr:     ip,
+		DeviceInfo: deviceInfo,
+		Details:    map[string]string{"login": login, "reason": reason},
+	})
+}
+
+// GetSecurityEvents returns page of audit log matching the filter.
+// It does not restrict access so caller must ensure that filter is scoped properly
+func (s *Service) GetSecurityEvents(ctx context.Context, filter *dtos.SecurityEventsFilter) (*dtos.SecurityEventsPage, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetSecurityEvents"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", filter.UserId, "ip", filter.IpAddr, "types", filter.Types)
+	start := time.Now()
+	defer func() { log.Debug("GetSecurityEvents finished", "duration", time.Since(start)) }()
+
+	if errs := filter.Validate(); len(errs) > 0 {
+		log.Debug("invalid security events filter", "errors", errs)
+		return nil, ErrInvalidSecurityEventsFilter
+	}
+
+	pageSize := filter.Limit
+	if pageSize <= 0 {
+		pageSize = defaultSecurityEventsPageSize
+	}
+	pageSize = min(pageSize, maxSecurityEventsPageSize)
+
+	// fetch one extra event to find out whether next page exists
+	query := *filter
+	query.Limit = pageSize + 1
+	events, err := s.securityEventsRepo.GetMany(ctx, &query)
+	if err != nil {
+		log.Error("failed to get security events", "err", err)
+		return nil, err
+	}
+	log.Debug("fetched security events", "count", len(events))
+
+	page := &dtos.SecurityEventsPage{Events: events}
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		page.NextCursor = page.Events[pageSize-1].Id
+	}
+
+	return page, nil
+}
+
+// GetUserSecurityEvents returns page of user's own login history and security events
+func (s *Service) GetUserSecurityEvents(ctx context.Context, userId int, beforeId int64, limit int) (*dtos.SecurityEventsPage, error) {
+	return s.GetSecurityEvents(ctx, &dtos.SecurityEventsFilter{UserId: userId, BeforeId: beforeId, Limit: limit})
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// sessionLimit returns max number of concurrent sessions of given kind, not positive value means no limit
+func (s *Service) sessionLimit(isLongLived bool) int {
+	if isLongLived {
+		return s.maxLongLivedSessions
+	}
+	return s.maxSessions
+}
+
+// enforceSessionLimit makes room for a new session of given kind according to configured policy.
+// Session being replaced by the new one is not counted.
+// Either least recently seen sessions are evicted or ErrSessionLimitExceeded is returned
+func (s *Service) enforceSessionLimit(ctx context.Context, user *entity.User, isLongLived bool, replacedSessionId string) error {
+	limit := s.sessionLimit(isLongLived)
+	if limit <= 0 {
+		return nil
+	}
+
+	// sessions are ordered from most to least recently seen
+	sessions, err := s.sessionsRepo.GetAllByUserId(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+	sameKindSessions := make([]entity.AuthSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsLongLived == isLongLived && session.Id != replacedSessionId {
+			sameKindSessions = append(sameKindSessions, session)
+		}
+	}
+	if len(sameKindSessions) < limit {
+		return nil
+	}
+	if s.sessionLimitPolicy == entity.SESSION_LIMIT_POLICY_REJECT {
+		return ErrSessionLimitExceeded
+	}
+
+	for _, session := range sameKindSessions[limit-1:] {
+		if err = s.sessionsRepo.DeleteById(ctx, user.Id, session.Id); err != nil {
+			return err
+		}
+		s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SESSION_EVICTED, &session)
+		if err = s.notificationsClient.SendSessionEvictedEmail(ctx, user.Email, user.GetDisplayName(), &session, user.Language); err != nil {
+			s.log.Error(
+				fmt.Errorf("AuthService - enforceSessionLimit - notificationsClient.SendSessionEvictedEmail: %w", err),
+				"correlationId", logger.CorrelationIDFromContext(ctx), "sessionID", session.Id,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/dtos"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+// verifySessionProof ensures that request within session bound to client's key is signed with that key.
+// Nonce is remembered for twice the allowed clock skew so the same proof can not be replayed while its timestamp is valid.
+// Sessions which are not bound to a key do not require proof
+func (s *Service) verifySessionProof(ctx context.Context, session *entity.AuthSession, proof *dtos.SessionProof) error {
+	if session.PublicKey == "" {
+		return nil
+	}
+	if proof == nil {
+		return ErrSessionProofRequired
+	}
+
+	reason := ""
+	if errs := proof.Validate(); len(errs) > 0 {
+		reason = "malformed"
+	} else if skew := time.Since(proof.Timestamp).Abs(); skew > s.sessionProofMaxSkew {
+		reason = "expired"
+	} else if err := s.securityProvider.VerifySignature(session.PublicKey, proof.Message(session.Id), proof.Signature); err != nil {
+		reason = "invalid_signature"
+	} else if err := s.sessionProofNoncesRepo.Reserve(ctx, session.Id, proof.Nonce, 2*s.sessionProofMaxSkew); err != nil {
+		if !errors.Is(err, storage.ErrAlreadyExists) {
+			return err
+		}
+		reason = "replayed"
+	}
+	if reason == "" {
+		return nil
+	}
+
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  session.UserId,
+		Type:    entity.SECURITY_EVENT_SESSION_PROOF_FAILED,
+		Details: map[string]string{"session_id": session.Id, "reason": reason, "method": proof.Method},
+	})
+	return ErrInvalidSessionProof
+}
+
+// AuthenticateSession resolves session which request is made within and verifies its proof.
+// Every session-scoped request must pass it before calling other usecases
+func (s *Service) AuthenticateSession(
+	ctx context.Context,
+	userId int,
+	sessionId string,
+	proof *dtos.SessionProof,
+) (*entity.AuthSession, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.AuthenticateSession"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+
+	session, err := s.sessionsRepo.GetById(ctx, userId, sessionId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		log.Error("failed to get session", "err", err)
+		return nil, err
+	}
+
+	if err = s.verifySessionProof(ctx, session, proof); err != nil {
+		if !errors.Is(err, ErrSessionProofRequired) && !errors.Is(err, ErrInvalidSessionProof) {
+			log.Error("failed to verify session proof", "err", err)
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
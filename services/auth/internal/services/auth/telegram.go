@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/config"
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const telegramRevokeSessionCallbackPrefix = "revoke:"
+
+// TelegramRevokeSessionCallback builds callback data for "this wasn't me" button
+// attached to new device login message
+func TelegramRevokeSessionCallback(sessionId string) string {
+	return telegramRevokeSessionCallbackPrefix + sessionId
+}
+
+// ParseTelegramRevokeSessionCallback extracts session id from callback data.
+// Returns false if callback data was built for another action
+func ParseTelegramRevokeSessionCallback(data string) (string, bool) {
+	sessionId, found := strings.CutPrefix(data, telegramRevokeSessionCallbackPrefix)
+	return sessionId, found && sessionId != ""
+}
+
+func (s *Service) handleAddTwoFaTelegram(ctx context.Context, userId int) (string, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.handleAddTwoFaTelegram"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("handleAddTwoFaTelegram finished", "duration", time.Since(start)) }()
+
+	link := &entity.TelegramLink{
+		Code:   s.securityProvider.GenerateSecretTokenUrlSafe(config.TELEGRAM_LINK_CODE_LENGTH),
+		UserId: userId,
+	}
+	if err := s.telegramLinksRepo.CreateWithTTL(ctx, link, s.otpTTL); err != nil {
+		log.Error("failed to create telegram link", "err", err)
+		return "", err
+	}
+	log.Debug("created telegram link, waiting for user to start the bot")
+
+	return s.tgApi.GetStartLinkWithCode(link.Code), nil
+}
+
+// LinkTelegramChat binds telegram chat which sent start code to pending link
+// and sends code required to complete adding 2FA into that chat
+func (s *Service) LinkTelegramChat(ctx context.Context, code string, chatId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.LinkTelegramChat"
+	log := s.log.With("op", op, "correlationId", correlationId, "chatId", chatId)
+	start := time.Now()
+	defer func() { log.Debug("LinkTelegramChat finished", "duration", time.Since(start)) }()
+
+	link, err := s.telegramLinksRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrInvalidTelegramLinkCode
+		}
+		log.Error("failed to get telegram link", "err", err)
+		return err
+	}
+	log.Debug("found telegram link", "userId", link.UserId)
+
+	link.ChatId = chatId
+	if err = s.telegramLinksRepo.Update(ctx, link); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrInvalidTelegramLinkCode
+		}
+		log.Error("failed to update telegram link", "err", err, "userId", link.UserId)
+		return err
+	}
+
+	otpCode, err := s.createOtp(ctx, "", link.UserId)
+	if err != nil {
+		log.Error("failed to create otp", "err", err, "userId", link.UserId)
+		return err
+	}
+
+	if err = s.tgApi.SendTextMsg(ctx, chatId, fmt.Sprintf("Authorization code: %s", otpCode)); err != nil {
+		log.Error("failed to send telegram otp", "err", err, "userId", link.UserId)
+		return err
+	}
+	log.Debug("telegram chat linked", "userId", link.UserId)
+
+	return nil
+}
+
+// GetUserByTelegramChat resolves account which uses telegram chat as 2FA contact
+func (s *Service) GetUserByTelegramChat(ctx context.Context, chatId string) (*entity.User, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetUserByTelegramChat"
+	log := s.log.With("op", op, "correlationId", correlationId, "chatId", chatId)
+
+	user, err := s.usersRepo.GetByTwoFaContact(ctx, entity.TWO_FA_TELEGRAM, chatId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrTelegramNotLinked
+		}
+		log.Error("failed to get user by telegram chat", "err", err)
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/modulix-systems/goose-talk/internal/entity"
+	"github.com/modulix-systems/goose-talk/internal/gateways/storage"
+	"github.com/modulix-systems/goose-talk/logger"
+)
+
+const trustedDeviceTokenType = "trusted_device"
+
+// trustDevice issues signed token which allows to skip 2FA on device of given session.
+// Token is returned to caller only once, its hash is stored
+func (s *Service) trustDevice(ctx context.Context, session *entity.AuthSession) (string, error) {
+	token, err := s.tokenProvider.NewToken(s.trustedDeviceTTL, map[string]any{
+		"typ": trustedDeviceTokenType,
+		"uid": session.UserId,
+		// makes tokens issued within the same second unique
+		"jti": s.securityProvider.GenerateSessionId(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("tokenProvider.NewToken: %w", err)
+	}
+
+	device := &entity.TrustedDevice{
+		UserId:    session.UserId,
+		TokenHash: s.securityProvider.HashToken(token),
+		Label:     session.DeviceName(),
+		ExpiresAt: time.Now().Add(s.trustedDeviceTTL),
+	}
+	device, err = s.trustedDevicesRepo.Create(ctx, device)
+	if err != nil {
+		return "", fmt.Errorf("trustedDevicesRepo.Create: %w", err)
+	}
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_TRUSTED_DEVICE_ADDED, session)
+
+	return token, nil
+}
+
+// isTrustedDevice reports whether token was issued to user by trustDevice and was not revoked since.
+// Lookup failures are logged and treated as untrusted device
+func (s *Service) isTrustedDevice(ctx context.Context, userId int, token string) bool {
+	if token == "" {
+		return false
+	}
+	claims, err := s.tokenProvider.ParseClaimsFromToken(token)
+	if err != nil {
+		return false
+	}
+	typ, _ := claims["typ"].(string)
+	// numeric claims are decoded as float64
+	tokenUserId, _ := claims["uid"].(float64)
+	if typ != trustedDeviceTokenType || int(tokenUserId) != userId {
+		return false
+	}
+
+	device, err := s.trustedDevicesRepo.GetByTokenHash(ctx, userId, s.securityProvider.HashToken(token))
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			s.log.Error(
+				fmt.Errorf("AuthService - isTrustedDevice - trustedDevicesRepo.GetByTokenHash: %w", err),
+				"correlationId", logger.CorrelationIDFromContext(ctx), "userId", userId,
+			)
+		}
+		return false
+	}
+	if err = s.trustedDevicesRepo.UpdateLastUsedAt(ctx, device.Id, time.Now()); err != nil {
+		s.log.Error(
+			fmt.Errorf("AuthService - isTrustedDevice - trustedDevicesRepo.UpdateLastUsedAt: %w", err),
+			"correlationId", logger.CorrelationIDFromContext(ctx), "userId", userId, "deviceId", device.Id,
+		)
+	}
+
+	return true
+}
+
+func (s *Service) GetTrustedDevices(ctx context.Context, userId int) ([]entity.TrustedDevice, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.GetTrustedDevices"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("GetTrustedDevices finished", "duration", time.Since(start)) }()
+
+	devices, err := s.trustedDevicesRepo.GetAllByUserId(ctx, userId)
+	if err != nil {
+		log.Error("failed to get trusted devices", "err", err)
+		return nil, err
+	}
+	log.Debug("fetched trusted devices", "count", len(devices))
+
+	return devices, nil
+}
+
+// RevokeTrustedDevice makes device go through 2FA on next sign in
+func (s *Service) RevokeTrustedDevice(ctx context.Context, userId int, deviceId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RevokeTrustedDevice"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "deviceId", deviceId)
+	start := time.Now()
+	defer func() { log.Debug("RevokeTrustedDevice finished", "duration", time.Since(start)) }()
+
+	if err := s.trustedDevicesRepo.DeleteById(ctx, userId, deviceId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrTrustedDeviceNotFound
+		}
+		log.Error("failed to revoke trusted device", "err", err)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_TRUSTED_DEVICE_REVOKED,
+		Details: map[string]string{"device_id": strconv.Itoa(deviceId)},
+	})
+
+	return nil
+}
+
+// RevokeAllTrustedDevices makes every device go through 2FA on next sign in
+func (s *Service) RevokeAllTrustedDevices(ctx context.Context, userId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.RevokeAllTrustedDevices"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("RevokeAllTrustedDevices finished", "duration", time.Since(start)) }()
+
+	if err := s.trustedDevicesRepo.DeleteAllByUserId(ctx, userId); err != nil {
+		log.Error("failed to revoke trusted devices", "err", err)
+		return err
+	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_TRUSTED_DEVICE_REVOKED,
+		Details: map[string]string{"device_id": "all"},
+	})
+
+	return nil
+}
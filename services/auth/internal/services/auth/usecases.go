@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/modulix-systems/goose-talk/internal/config"
@@ -26,6 +25,10 @@ func (s *Service) SignUp(
 	defer func() { log.Debug("SignUp finished", "duration", time.Since(start)) }()
 
 	log.Debug("Signing up", "username", dto.Username, "firstName", dto.FirstName, "lastName", dto.LastName, "birthDate", dto.BirthDate, "ip", dto.IpAddr, "confirmationCode", dto.ConfirmationCode, "deviceInfo", dto.DeviceInfo, "photoUrl", dto.PhotoUrl)
+	if err := s.checkPasswordPolicy("password", dto.Password, dto.Username, dto.Email); err != nil {
+		return nil, err
+	}
+
 	userExists, err := s.usersRepo.CheckExistsWithEmail(ctx, dto.Email)
 	if err != nil {
 		log.Error("error checking user existence", "err", err)
@@ -35,6 +38,11 @@ func (s *Service) SignUp(
 	if userExists {
 		return nil, ErrUserAlreadyExists
 	}
+	// invite is checked before email verification so that user does not verify email in vain
+	invite, err := s.checkInvite(ctx, dto.InviteCode, dto.Email)
+	if err != nil {
+		return nil, err
+	}
 
 	if dto.ConfirmationCode == "" {
 		otpCode, err := s.createOtp(ctx, dto.Email, 0)
@@ -57,10 +65,9 @@ func (s *Service) SignUp(
 	}
 
 	log.Debug("comparing otp codes", "otpPresent", otp != nil)
-	err = s.securityProvider.ComparePasswords(otp.Code, dto.ConfirmationCode)
-	if err != nil {
+	if err = s.checkOtp(ctx, otp, dto.ConfirmationCode); err != nil {
 		log.Error("invalid otp", "err", err, "otpUserEmail", otp.UserEmail)
-		return nil, ErrOtpIsNotValid
+		return nil, err
 	}
 
 	log.Debug("hashing password", "pwdLen", len(dto.Password))
@@ -69,20 +76,32 @@ func (s *Service) SignUp(
 		return nil, err
 	}
 
+	if invite != nil {
+		if err = s.invitesRepo.Reserve(ctx, invite.Id, dto.Email); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				// used up or revoked since it was checked
+				return nil, ErrInvalidInvite
+			}
+			log.Error("failed to reserve invite", "err", err, "inviteId", invite.Id)
+			return nil, err
+		}
+	}
 	user, err := s.usersRepo.Save(
 		ctx,
 		&entity.User{
-			FirstName:  dto.FirstName,
-			LastName:   dto.LastName,
-			Username:   dto.Username,
-			BirthDate:  dto.BirthDate,
-			Email:      dto.Email,
-			AboutMe:    dto.AboutMe,
-			Password:   hashedPassword,
-			PrivateKey: s.securityProvider.GeneratePrivateKey(),
+			FirstName: dto.FirstName,
+			LastName:  dto.LastName,
+			Username:  dto.Username,
+			BirthDate: dto.BirthDate,
+			Email:     dto.Email,
+			AboutMe:   dto.AboutMe,
+			Password:  hashedPassword,
 		},
 	)
 	if err != nil {
+		if invite != nil {
+			s.releaseInvite(ctx, invite.Id)
+		}
 		if errors.Is(err, storage.ErrAlreadyExists) {
 			return nil, ErrUserAlreadyExists
 		}
@@ -90,12 +109,21 @@ func (s *Service) SignUp(
 		return nil, err
 	}
 	log.Debug("user saved", "userId", user.Id, "email", user.Email)
+	if invite != nil {
+		// use of invite is already counted, so failure only loses track of inviter
+		if err = s.invitesRepo.CreateRedemption(ctx, invite.Id, user.Id); err != nil {
+			log.Error("failed to record invite redemption", "err", err, "inviteId", invite.Id, "userId", user.Id)
+		} else {
+			log.Debug("invite redeemed", "inviteId", invite.Id, "inviterId", invite.InviterId)
+		}
+	}
 
-	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, false, true)
+	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, "", false, entity.AUTH_METHOD_PASSWORD, true)
 	if err != nil {
 		return nil, err
 	}
 	log.Debug("created auth session", "userId", user.Id, "sessionId", session.Id)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SIGN_UP, session)
 
 	if err = s.otpRepo.Delete(ctx, otp); err != nil {
 		log.Error("failed to delete otp after signup", "err", err, "otpUserEmail", otp.UserEmail)
@@ -111,6 +139,33 @@ func (s *Service) SignUp(
 	return &dtos.SignUpResponse{Session: session, User: user}, nil
 }
 
+// startTwoFa sends 2FA code to user who passed the first sign in factor.
+// Sign in is completed with VerifyTwoFa
+func (s *Service) startTwoFa(ctx context.Context, user *entity.User) (*dtos.SignInResponse, error) {
+	log := s.log.With("correlationId", logger.CorrelationIDFromContext(ctx), "userId", user.Id, "method", user.TwoFactorAuth.Method)
+	log.Debug("user has 2FA enabled")
+	otpCode, err := s.createOtp(ctx, "", user.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch user.TwoFactorAuth.Method {
+	case entity.TWO_FA_EMAIL, entity.TWO_FA_TELEGRAM:
+		log.Debug("sending 2fa code")
+		if err = s.sendTwoFaCode(ctx, user, otpCode); err != nil {
+			log.Error("failed to send 2fa code", "err", err)
+			return nil, err
+		}
+	case entity.TWO_FA_TOTP_APP:
+		return &dtos.SignInResponse{
+			User:             user,
+			ConfirmationCode: otpCode,
+		}, nil
+	default:
+		return nil, ErrUnsupported2FAMethod
+	}
+	return &dtos.SignInResponse{User: user}, nil
+}
+
 func (s *Service) SignIn(ctx context.Context, dto *dtos.SignInRequest) (*dtos.SignInResponse, error) {
 	correlationId := logger.CorrelationIDFromContext(ctx)
 	op := "auth.Service.SignIn"
@@ -121,62 +176,64 @@ func (s *Service) SignIn(ctx context.Context, dto *dtos.SignInRequest) (*dtos.Si
 	user, err := s.usersRepo.GetByLogin(ctx, dto.Login)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
+			s.recordSignInFailure(ctx, 0, dto.Login, dto.IpAddr, dto.DeviceInfo, "user_not_found")
 			return nil, ErrInvalidCredentials
 		}
 		return nil, err
 	}
-	log.Debug("fetched user by login", "userId", user.Id, "isActive", user.IsActive)
-	if !user.IsActive {
-		return nil, ErrDeactivatedAccount
+	log.Debug("fetched user by login", "userId", user.Id, "state", user.State)
+	if err = accountStateError(user); err != nil {
+		s.recordSignInFailure(ctx, user.Id, dto.Login, dto.IpAddr, dto.DeviceInfo, "account_"+string(user.EffectiveState()))
+		return nil, err
 	}
 
 	log.Debug("comparing password hash", "userId", user.Id)
 	err = s.securityProvider.ComparePasswords(user.Password, dto.Password)
 	if err != nil {
 		log.Error("invalid password", "err", err, "login", dto.Login, "userId", user.Id)
+		s.recordSignInFailure(ctx, user.Id, dto.Login, dto.IpAddr, dto.DeviceInfo, "invalid_password")
+		s.lockAccountIfAbused(ctx, user)
 		return nil, ErrInvalidCredentials
 	}
-
-	if user.Is2FAEnabled() {
-		log.Debug("user has 2FA enabled", "userId", user.Id, "method", user.TwoFactorAuth.Method)
-		otpCode, err := s.createOtp(ctx, "", user.Id)
-		if err != nil {
-			return nil, err
+	if user.MustResetPassword {
+		s.recordSignInFailure(ctx, user.Id, dto.Login, dto.IpAddr, dto.DeviceInfo, "password_reset_required")
+		return nil, ErrPasswordResetRequired
+	}
+	s.rehashPasswordIfNeeded(ctx, user, dto.Password)
+
+	challengeRequired := false
+	suspicious := false
+	if s.isLoginRiskDetectionEnabled() {
+		risk := s.assessLoginRisk(ctx, user, dto.IpAddr, dto.DeviceInfo)
+		if risk.Score >= s.loginRiskThreshold {
+			suspicious = true
+			// 2FA is a challenge on its own
+			challengeRequired = !user.Is2FAEnabled()
+			log.Info("suspicious sign in", "userId", user.Id, "score", risk.Score, "signals", risk.Signals)
+			s.reportSuspiciousLogin(ctx, user, risk, dto.IpAddr, dto.DeviceInfo, challengeRequired)
 		}
-		contact := user.TwoFactorAuth.Contact
-		switch user.TwoFactorAuth.Method {
-		case entity.TWO_FA_EMAIL:
-			toEmail := user.Email
-			if contact != "" {
-				toEmail = contact
-			}
-			log.Debug("sending 2fa email", "to", toEmail, "userId", user.Id)
-			if err = s.notificationsClient.SendConfirmEmailTwoFaEmail(ctx, toEmail, user.GetDisplayName(), otpCode, user.Language); err != nil {
-				log.Error("failed to send 2fa email", "err", err, "to", toEmail, "userId", user.Id)
-				return nil, err
-			}
-		case entity.TWO_FA_TELEGRAM:
-			log.Debug("sending 2fa telegram message", "contact", contact, "userId", user.Id)
-			if err = s.tgApi.SendTextMsg(ctx, contact, fmt.Sprintf("Authorization code: %s", otpCode)); err != nil {
-				log.Error("failed to send 2fa telegram message", "err", err, "contact", contact, "userId", user.Id)
+		if challengeRequired {
+			if err = s.sendLoginChallenge(ctx, user, dto.Login, dto.IpAddr, dto.DeviceInfo, risk); err != nil {
+				log.Error("failed to send login challenge", "err", err, "userId", user.Id)
 				return nil, err
 			}
-		case entity.TWO_FA_TOTP_APP:
-			return &dtos.SignInResponse{
-				User:             user,
-				ConfirmationCode: otpCode,
-			}, nil
-		default:
-			return nil, ErrUnsupported2FAMethod
+			return &dtos.SignInResponse{User: user, ChallengeRequired: true}, nil
 		}
-		return &dtos.SignInResponse{User: user}, nil
 	}
 
-	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, dto.RememberMe, false)
+	// trusted device replaces 2FA unless sign in looks suspicious
+	if user.Is2FAEnabled() && !suspicious && s.isTrustedDevice(ctx, user.Id, dto.TrustedDeviceToken) {
+		log.Debug("skipping 2FA on trusted device", "userId", user.Id)
+	} else if user.Is2FAEnabled() {
+		return s.startTwoFa(ctx, user)
+	}
+
+	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, dto.PublicKey, dto.RememberMe, entity.AUTH_METHOD_PASSWORD, false)
 	if err != nil {
 		return nil, err
 	}
 	log.Debug("created auth session", "userId", user.Id, "sessionId", session.Id)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED, session)
 
 	return &dtos.SignInResponse{
 		User:    user,
@@ -184,7 +241,7 @@ func (s *Service) SignIn(ctx context.Context, dto *dtos.SignInRequest) (*dtos.Si
 	}, nil
 }
 
-func (s *Service) VerifyTwoFa(ctx context.Context, dto *dtos.Verify2FARequest) (*entity.AuthSession, error) {
+func (s *Service) VerifyTwoFa(ctx context.Context, dto *dtos.Verify2FARequest) (*dtos.Verify2FAResponse, error) {
 	correlationId := logger.CorrelationIDFromContext(ctx)
 	op := "auth.Service.VerifyTwoFa"
 	log := s.log.With("op", op, "correlationId", correlationId, "email", dto.Email)
@@ -205,10 +262,12 @@ func (s *Service) VerifyTwoFa(ctx context.Context, dto *dtos.Verify2FARequest) (
 	}
 
 	log.Debug("comparing otp for verify twofa", "email", dto.Email)
-	err = s.securityProvider.ComparePasswords(otp.Code, otpToCompare)
-	if err != nil {
+	if err = s.checkOtp(ctx, otp, otpToCompare); err != nil {
 		log.Error("invalid otp", "err", err, "email", dto.Email)
-		return nil, ErrOtpIsNotValid
+		if errors.Is(err, ErrOtpIsNotValid) {
+			s.recordSignInFailure(ctx, otp.UserId, dto.Email, dto.IpAddr, dto.DeviceInfo, "invalid_two_fa_code")
+		}
+		return nil, err
 	}
 
 	user, err := s.usersRepo.GetByLogin(ctx, otp.UserEmail)
@@ -220,15 +279,18 @@ func (s *Service) VerifyTwoFa(ctx context.Context, dto *dtos.Verify2FARequest) (
 		return nil, err
 	}
 	log.Debug("verify twofa fetched user", "userId", user.Id)
-	if !user.IsActive {
-		return nil, ErrDeactivatedAccount
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+	if user.MustResetPassword {
+		return nil, ErrPasswordResetRequired
 	}
 	if !user.Is2FAEnabled() {
 		return nil, Err2FANotEnabled
 	}
 	if dto.TwoFATyp == entity.TWO_FA_TOTP_APP {
 		log.Debug("validating totp app code", "userId", user.Id)
-		decryptedSecret, err := s.securityProvider.DecryptSymmetric(user.TwoFactorAuth.TotpSecret, user.PrivateKey)
+		decryptedSecret, err := s.decryptTotpSecret(ctx, user)
 		if err != nil {
 			log.Error("failed to decrypt totp secret", "err", err, "userId", user.Id)
 			return nil, err
@@ -236,21 +298,74 @@ func (s *Service) VerifyTwoFa(ctx context.Context, dto *dtos.Verify2FARequest) (
 		isValid := s.securityProvider.ValidateTOTP(dto.Code, decryptedSecret)
 		if !isValid {
 			log.Error("invalid totp code", "userId", user.Id)
+			s.recordSignInFailure(ctx, user.Id, dto.Email, dto.IpAddr, dto.DeviceInfo, "invalid_two_fa_code")
 			return nil, ErrOtpIsNotValid
 		}
 		log.Debug("totp code validated", "userId", user.Id)
 	}
 
-	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, dto.RememberMe, false)
+	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, dto.PublicKey, dto.RememberMe, entity.AUTH_METHOD_TWO_FA, false)
 	if err != nil {
 		return nil, err
 	}
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED, session)
 
 	if err = s.otpRepo.Delete(ctx, otp); err != nil {
 		log.Error("failed to delete otp after verify twofa", "err", err, "email", otp.UserEmail)
 		return nil, err
 	}
 
+	response := &dtos.Verify2FAResponse{Session: session}
+	if dto.TrustDevice {
+		// session is already created so failure only costs user another 2FA next time
+		response.TrustedDeviceToken, err = s.trustDevice(ctx, session)
+		if err != nil {
+			log.Error("failed to trust device", "err", err, "userId", user.Id)
+		}
+	}
+
+	return response, nil
+}
+
+// VerifyLoginChallenge completes suspicious sign in which required step-up verification
+func (s *Service) VerifyLoginChallenge(ctx context.Context, dto *dtos.VerifyLoginChallengeRequest) (*entity.AuthSession, error) {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.VerifyLoginChallenge"
+	log := s.log.With("op", op, "correlationId", correlationId, "login", dto.Login)
+	start := time.Now()
+	defer func() { log.Debug("VerifyLoginChallenge finished", "duration", time.Since(start)) }()
+
+	user, err := s.usersRepo.GetByLogin(ctx, dto.Login)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrOtpIsNotValid
+		}
+		return nil, err
+	}
+	if err = accountStateError(user); err != nil {
+		return nil, err
+	}
+	if user.MustResetPassword {
+		return nil, ErrPasswordResetRequired
+	}
+
+	binding := loginChallengeBinding(dto.Login, dto.IpAddr, dto.DeviceInfo)
+	err = s.consumeScopedOtp(ctx, entity.OTP_PURPOSE_LOGIN_CHALLENGE, user.Id, binding, dto.Code)
+	if err != nil {
+		if errors.Is(err, ErrOtpIsNotValid) {
+			log.Error("invalid login challenge code", "err", err, "userId", user.Id)
+			s.recordSignInFailure(ctx, user.Id, dto.Login, dto.IpAddr, dto.DeviceInfo, "invalid_challenge_code")
+		}
+		return nil, err
+	}
+
+	session, err := s.newAuthSession(ctx, user, dto.IpAddr, dto.DeviceInfo, dto.PublicKey, dto.RememberMe, entity.AUTH_METHOD_TWO_FA, false)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("created auth session", "userId", user.Id, "sessionId", session.Id)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED, session)
+
 	return session, nil
 }
 
@@ -284,7 +399,7 @@ func (s *Service) CompleteAddingTwoFa(ctx context.Context, dto *dtos.Confirm2FAR
 			return nil, ErrOtpIsNotValid
 		}
 
-		encryptedSecret, err := s.securityProvider.EncryptSymmetric(dto.TotpSecret, user.PrivateKey)
+		encryptedSecret, err := s.keyRing.Encrypt([]byte(dto.TotpSecret))
 		if err != nil {
 			log.Error("failed to encrypt totp secret", "err", err, "userId", user.Id)
 			return nil, err
@@ -300,10 +415,9 @@ func (s *Service) CompleteAddingTwoFa(ctx context.Context, dto *dtos.Confirm2FAR
 		}
 		log.Debug("fetched otp for user during complete add 2fa", "userId", dto.UserId)
 
-		err = s.securityProvider.ComparePasswords(otp.Code, dto.ConfirmationCode)
-		if err != nil {
+		if err = s.checkOtp(ctx, otp, dto.ConfirmationCode); err != nil {
 			log.Error("invalid confirmation code during complete add 2fa", "err", err, "userId", dto.UserId)
-			return nil, ErrOtpIsNotValid
+			return nil, err
 		}
 
 		if err := s.otpRepo.Delete(ctx, otp); err != nil {
@@ -315,11 +429,34 @@ func (s *Service) CompleteAddingTwoFa(ctx context.Context, dto *dtos.Confirm2FAR
 	if dto.Typ == entity.TWO_FA_EMAIL || dto.Typ == entity.TWO_FA_SMS {
 		twoFactorAuth.Contact = dto.Contact
 	}
+	var telegramLink *entity.TelegramLink
+	if dto.Typ == entity.TWO_FA_TELEGRAM {
+		telegramLink, err = s.telegramLinksRepo.GetByUserId(ctx, dto.UserId)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Error("failed to get telegram link", "err", err, "userId", dto.UserId)
+			return nil, err
+		}
+		if telegramLink == nil || telegramLink.ChatId == "" {
+			return nil, ErrTelegramNotLinked
+		}
+		twoFactorAuth.Contact = telegramLink.ChatId
+	}
 
 	twoFactorAuth, err = s.usersRepo.CreateTwoFa(ctx, twoFactorAuth)
 	if err != nil {
 		return nil, err
 	}
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  dto.UserId,
+		Type:    entity.SECURITY_EVENT_TWO_FA_ENABLED,
+		Details: map[string]string{"method": string(dto.Typ)},
+	})
+
+	if telegramLink != nil {
+		if err := s.telegramLinksRepo.Delete(ctx, telegramLink); err != nil {
+			log.Error("failed to delete telegram link", "err", err, "userId", dto.UserId)
+		}
+	}
 
 	return twoFactorAuth, nil
 }
@@ -343,64 +480,6 @@ func (s *Service) handleAddTwoFaEmail(ctx context.Context, user *entity.User, co
 	return s.notificationsClient.SendConfirmEmailTwoFaEmail(ctx, to, user.GetDisplayName(), otpCode, user.Language)
 }
 
-func (s *Service) handleAddTwoFaTelegram(ctx context.Context, userId int) (string, error) {
-	correlationId := logger.CorrelationIDFromContext(ctx)
-	op := "auth.Service.handleAddTwoFaTelegram"
-	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
-	start := time.Now()
-	defer func() { log.Debug("handleAddTwoFaTelegram finished", "duration", time.Since(start)) }()
-
-	otpCode, err := s.createOtp(ctx, "", userId)
-	if err != nil {
-		return "", err
-	}
-
-	msgCode := s.securityProvider.GenerateOTPCode()
-	link := s.tgApi.GetStartLinkWithCode(msgCode)
-
-	go func() {
-		startTime := time.Now()
-		retryTimeout := 5 * time.Second
-		attemptsLeft := s.otpTTL / retryTimeout
-		log.Debug("starting telegram 2fa background listener", "attempts", attemptsLeft)
-		for attemptsLeft > 0 {
-			time.Sleep(retryTimeout)
-			attemptsLeft--
-
-			msg, err := s.tgApi.GetLatestMsg(ctx)
-			if err != nil {
-				log.Error("failed to get latest telegram message", "err", err, "attemptsLeft", attemptsLeft)
-				continue
-			}
-
-			msgParts := strings.Split(msg.Text, " ")
-			if msg.DateSent.Before(startTime) || len(msgParts) < 2 || msgParts[1] != msgCode {
-				log.Debug("telegram msg did not match code or is old", "msgDate", msg.DateSent, "attemptsLeft", attemptsLeft)
-				continue
-			}
-
-			log.Debug("telegram code matched, updating twofa contact", "chatId", msg.ChatId, "userId", userId)
-			err = s.usersRepo.UpdateTwoFaContact(ctx, userId, msg.ChatId)
-			if err != nil {
-				log.Error("failed to update 2fa contact", "err", err, "chatId", msg.ChatId)
-				continue
-			}
-
-			err = s.tgApi.SendTextMsg(ctx, msg.ChatId, fmt.Sprintf("Authorization code: %s", otpCode))
-			if err != nil {
-				log.Error("failed to send telegram otp", "err", err, "chatId", msg.ChatId)
-				continue
-			}
-
-			log.Debug("telegram 2fa flow completed for user", "userId", userId, "chatId", msg.ChatId)
-			return
-		}
-		log.Debug("telegram 2fa background listener finished without match", "userId", userId)
-	}()
-
-	return link, nil
-}
-
 func (s *Service) RequestAddingTwoFa(ctx context.Context, dto *dtos.Add2FARequest) (*TwoFAConnectInfo, error) {
 	correlationId := logger.CorrelationIDFromContext(ctx)
 	op := "auth.Service.RequestAddingTwoFa"
@@ -408,6 +487,10 @@ func (s *Service) RequestAddingTwoFa(ctx context.Context, dto *dtos.Add2FAReques
 	start := time.Now()
 	defer func() { log.Debug("RequestAddingTwoFa finished", "duration", time.Since(start)) }()
 
+	if err := s.requireRecentAuth(ctx, dto.UserId, dto.SessionId); err != nil {
+		return nil, err
+	}
+
 	user, err := s.usersRepo.GetByID(ctx, dto.UserId)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
@@ -444,19 +527,93 @@ func (s *Service) RequestAddingTwoFa(ctx context.Context, dto *dtos.Add2FAReques
 	}
 }
 
-func (s *Service) DeactivateAccount(ctx context.Context, userId int) error {
+// DisableTwoFa removes user's 2FA. It requires recent authentication within session which initiated it.
+// Trusted devices are revoked as well since they were trusted to skip removed 2FA
+func (s *Service) DisableTwoFa(ctx context.Context, userId int, sessionId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.DisableTwoFa"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("DisableTwoFa finished", "duration", time.Since(start)) }()
+
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return err
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		log.Error("failed to get user", "err", err)
+		return err
+	}
+	if !user.Is2FAEnabled() {
+		return Err2FANotEnabled
+	}
+	if err = s.usersRepo.DeleteTwoFaByUserId(ctx, userId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return Err2FANotEnabled
+		}
+		log.Error("failed to delete 2FA", "err", err)
+		return err
+	}
+	if err = s.trustedDevicesRepo.DeleteAllByUserId(ctx, userId); err != nil {
+		log.Error("failed to revoke trusted devices", "err", err)
+		return err
+	}
+	log.Info("2FA disabled")
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_TWO_FA_DISABLED,
+		Details: map[string]string{"method": string(user.TwoFactorAuth.Method)},
+	})
+
+	return nil
+}
+
+// DeactivateAccount requires recent authentication within session which initiated it
+func (s *Service) DeactivateAccount(ctx context.Context, userId int, sessionId string) error {
 	correlationId := logger.CorrelationIDFromContext(ctx)
 	op := "auth.Service.DeactivateAccount"
-	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
 	start := time.Now()
 	defer func() { log.Debug("DeactivateAccount finished", "duration", time.Since(start)) }()
 
-	user, err := s.usersRepo.UpdateIsActiveById(ctx, userId, false)
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return err
+	}
+
+	return s.deactivateAccount(ctx, userId)
+}
+
+// DeactivateAccountFromTelegram deactivates account on request from telegram chat.
+// Caller must ensure that the chat is linked to the account since no other confirmation is required
+func (s *Service) DeactivateAccountFromTelegram(ctx context.Context, userId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.DeactivateAccountFromTelegram"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("DeactivateAccountFromTelegram finished", "duration", time.Since(start)) }()
+
+	return s.deactivateAccount(ctx, userId)
+}
+
+func (s *Service) deactivateAccount(ctx context.Context, userId int) error {
+	log := s.log.With("correlationId", logger.CorrelationIDFromContext(ctx), "userId", userId)
+	user, err := s.usersRepo.GetByID(ctx, userId)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
 			return ErrUserNotFound
 		}
-		log.Error("failed to deactivate account", "err", err, "userId", userId)
+		log.Error("failed to get user", "err", err)
+		return err
+	}
+	user, err = s.changeAccountState(ctx, user, &entity.AccountStateChange{State: entity.ACCOUNT_STATE_DEACTIVATED})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidAccountStateTransition) {
+			log.Error("failed to deactivate account", "err", err, "userId", userId)
+		}
 		return err
 	}
 	log.Debug("account deactivated", "userId", userId, "email", user.Email)
@@ -503,9 +660,59 @@ func (s *Service) DeleteSession(ctx context.Context, userId int, sessionId strin
 		return err
 	}
 	log.Debug("deleted session", "userId", userId, "sessionId", sessionId)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_SESSION_REVOKED,
+		Details: map[string]string{"session_id": sessionId},
+	})
+	return nil
+}
+
+// DeleteAllSessions terminates all user's sessions except the one which initiated the action.
+// The session must be recently authenticated
+func (s *Service) DeleteAllSessions(ctx context.Context, userId int, sessionId string) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.DeleteAllSessions"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
+	start := time.Now()
+	defer func() { log.Debug("DeleteAllSessions finished", "duration", time.Since(start)) }()
+
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return err
+	}
+
+	return s.deleteAllSessions(ctx, userId, sessionId)
+}
+
+// DeleteAllSessionsFromTelegram terminates every user's session on request from telegram chat.
+// Caller must ensure that the chat is linked to the account since no other confirmation is required
+func (s *Service) DeleteAllSessionsFromTelegram(ctx context.Context, userId int) error {
+	correlationId := logger.CorrelationIDFromContext(ctx)
+	op := "auth.Service.DeleteAllSessionsFromTelegram"
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	start := time.Now()
+	defer func() { log.Debug("DeleteAllSessionsFromTelegram finished", "duration", time.Since(start)) }()
+
+	return s.deleteAllSessions(ctx, userId, "")
+}
+
+func (s *Service) deleteAllSessions(ctx context.Context, userId int, excludeSessionId string) error {
+	log := s.log.With("correlationId", logger.CorrelationIDFromContext(ctx), "userId", userId, "excludeSessionId", excludeSessionId)
+	if err := s.sessionsRepo.DeleteAllByUserId(ctx, userId, excludeSessionId); err != nil {
+		log.Error("failed to delete sessions", "err", err)
+		return err
+	}
+	log.Debug("deleted all sessions", "userId", userId)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{
+		UserId:  userId,
+		Type:    entity.SECURITY_EVENT_ALL_SESSIONS_REVOKED,
+		Details: map[string]string{"excluded_session_id": excludeSessionId},
+	})
 	return nil
 }
 
+// PingSession extends session and updates its last seen time.
+// Session must be authenticated with AuthenticateSession beforehand
 func (s *Service) PingSession(
 	ctx context.Context,
 	userId int,
@@ -535,6 +742,9 @@ func (s *Service) PingSession(
 	now := time.Now()
 	err = s.sessionsRepo.UpdateById(ctx, userId, sessionId, now, sessionTTL)
 	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrSessionNotFound
+		}
 		log.Error("failed to update session", "err", err, "userId", userId, "sessionId", sessionId)
 		return nil, err
 	}
@@ -551,22 +761,16 @@ func (s *Service) ExportLoginToken(ctx context.Context, dto *dtos.ExportLoginTok
 	start := time.Now()
 	defer func() { log.Debug("ExportLoginToken finished", "duration", time.Since(start)) }()
 
-	err := s.loginTokenRepo.DeleteAllByClient(ctx, dto.ClientId)
+	// previously exported tokens are replaced, tokens of clients watching QR login are kept
+	err := s.loginTokenRepo.DeleteAllByWatch(ctx, dto.ClientId, "")
 	if err != nil {
 		log.Error("failed to delete login tokens", "err", err, "clientId", dto.ClientId)
 		return nil, err
 	}
 	log.Debug("deleted existing login tokens for client", "clientId", dto.ClientId)
 
-	tokenValue := s.securityProvider.GenerateSecretTokenUrlSafe(config.LOGIN_TOKEN_LENGTH)
-	token := &entity.QRCodeLoginToken{
-		ClientId:   dto.ClientId,
-		Value:      tokenValue,
-		IpAddr:     dto.IpAddr,
-		DeviceInfo: dto.DeviceInfo,
-	}
-
-	if err := s.loginTokenRepo.CreateWithTTL(ctx, token, s.loginTokenTTL); err != nil {
+	token, err := s.createLoginToken(ctx, dto.ClientId, dto.IpAddr, dto.DeviceInfo, "")
+	if err != nil {
 		log.Error("failed to create login token", "err", err, "clientId", dto.ClientId)
 		return nil, err
 	}
@@ -603,29 +807,37 @@ func (s *Service) AcceptQRLoginToken(ctx context.Context, userId int, unauthoriz
 	}
 	log.Debug("fetched user for qr accept", "userId", userId)
 
-	session, err := s.newAuthSession(ctx, user, token.IpAddr, token.DeviceInfo, true, false)
+	session, err := s.newAuthSession(ctx, user, token.IpAddr, token.DeviceInfo, "", true, "", false)
 	if err != nil {
 		log.Error("failed to create auth session", "err", err)
 		return nil, err
 	}
 	log.Debug("created auth session", "userId", user.Id, "sessionId", session.Id)
+	s.recordSessionEvent(ctx, entity.SECURITY_EVENT_QR_LOGIN, session)
 
-	if err := s.loginTokenRepo.DeleteAllByClient(ctx, token.ClientId); err != nil {
+	if err := s.loginTokenRepo.DeleteAllByWatch(ctx, token.ClientId, token.WatchId); err != nil {
 		log.Error("failed to delete login tokens", "err", err, "clientId", token.ClientId)
 		return nil, err
 	}
 	log.Debug("deleted login tokens after accept", "clientId", token.ClientId)
+	if token.WatchId != "" {
+		s.publishQRLoginAcceptance(ctx, token, session)
+	}
 
 	return session, nil
 }
 
-func (s *Service) RequestPasskeyRegistrationOptions(ctx context.Context, userId int) (gateways.WebAuthnRegistrationOptions, error) {
+func (s *Service) RequestPasskeyRegistrationOptions(ctx context.Context, userId int, sessionId string) (gateways.WebAuthnRegistrationOptions, error) {
 	correlationId := logger.CorrelationIDFromContext(ctx)
 	op := "auth.Service.RequestPasskeyRegistrationOptions"
-	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId)
+	log := s.log.With("op", op, "correlationId", correlationId, "userId", userId, "sessionId", sessionId)
 	start := time.Now()
 	defer func() { log.Debug("RequestPasskeyRegistrationOptions finished", "duration", time.Since(start)) }()
 
+	if err := s.requireRecentAuth(ctx, userId, sessionId); err != nil {
+		return nil, err
+	}
+
 	user, err := s.usersRepo.GetByIDWithPasskeyCredentials(ctx, userId)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
@@ -679,6 +891,7 @@ func (s *Service) CompletePasskeyRegistration(ctx context.Context, userId int, r
 		return err
 	}
 	log.Debug("passkey credential created", "userId", userId)
+	s.recordSecurityEvent(ctx, &entity.SecurityEvent{UserId: userId, Type: entity.SECURITY_EVENT_PASSKEY_REGISTERED})
 
 	return nil
 }
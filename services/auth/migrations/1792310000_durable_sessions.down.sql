@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS user_session_active_user_id_idx;
+
+ALTER TABLE user_session ALTER COLUMN client_identity_id DROP NOT NULL;
+ALTER TABLE user_session ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE user_session DROP COLUMN IF EXISTS is_long_lived;
+
+COMMIT;
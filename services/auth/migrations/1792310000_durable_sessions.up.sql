@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE user_session ADD COLUMN IF NOT EXISTS is_long_lived BOOL DEFAULT false NOT NULL;
+ALTER TABLE user_session ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE user_session ALTER COLUMN client_identity_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS user_session_active_user_id_idx ON user_session(user_id) WHERE deactived_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS security_event_append_only ON security_event;
+DROP FUNCTION IF EXISTS security_event_forbid_modification();
+DROP TABLE IF EXISTS security_event;
+
+COMMIT;
@@ -0,0 +1,38 @@
+BEGIN;
+
+-- Append-only audit log of security relevant actions.
+-- user_id is nullable because failed sign in attempts may target unknown accounts.
+-- Events of erased account are kept but detached from it
+CREATE TABLE IF NOT EXISTS security_event (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id INT REFERENCES "user"(id) ON DELETE SET NULL,
+  type TEXT NOT NULL,
+  ip_addr INET,
+  device_info TEXT DEFAULT '' NOT NULL,
+  location TEXT DEFAULT '' NOT NULL,
+  details JSONB DEFAULT '{}'::jsonb NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS security_event_user_id_idx ON security_event(user_id, id DESC);
+CREATE INDEX IF NOT EXISTS security_event_ip_addr_idx ON security_event(ip_addr, id DESC);
+CREATE INDEX IF NOT EXISTS security_event_type_idx ON security_event(type, id DESC);
+
+-- The only allowed modification is detaching event from erased user which is done by foreign key action
+CREATE OR REPLACE FUNCTION security_event_forbid_modification() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'UPDATE' AND OLD.user_id IS NOT NULL AND NEW.user_id IS NULL
+    AND (NEW.id, NEW.type, NEW.ip_addr, NEW.device_info, NEW.location, NEW.details, NEW.created_at)
+      IS NOT DISTINCT FROM (OLD.id, OLD.type, OLD.ip_addr, OLD.device_info, OLD.location, OLD.details, OLD.created_at)
+  THEN
+    RETURN NEW;
+  END IF;
+  RAISE EXCEPTION 'security_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER security_event_append_only
+  BEFORE UPDATE OR DELETE ON security_event
+  FOR EACH ROW EXECUTE FUNCTION security_event_forbid_modification();
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_key;
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_client_version;
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_client;
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_os_version;
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_platform;
+ALTER TABLE client_identity DROP COLUMN IF EXISTS device_type;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_type TEXT DEFAULT 'unknown' NOT NULL;
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_platform TEXT DEFAULT '' NOT NULL;
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_os_version TEXT DEFAULT '' NOT NULL;
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_client TEXT DEFAULT '' NOT NULL;
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_client_version TEXT DEFAULT '' NOT NULL;
+
+-- existing identities were matched by raw device info
+ALTER TABLE client_identity ADD COLUMN IF NOT EXISTS device_key TEXT;
+UPDATE client_identity SET device_key = lower(device_info) WHERE device_key IS NULL;
+ALTER TABLE client_identity ALTER COLUMN device_key SET NOT NULL;
+
+COMMIT;
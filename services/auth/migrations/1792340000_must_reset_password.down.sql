@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "user" DROP COLUMN IF EXISTS must_reset_password;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS must_reset_password BOOL DEFAULT false NOT NULL;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Devices on which user chose to skip 2FA. Only hash of issued device token is stored
+CREATE TABLE IF NOT EXISTS trusted_device (
+  id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id INT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  token_hash TEXT NOT NULL UNIQUE,
+  label TEXT DEFAULT '' NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  last_used_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS trusted_device_user_id_idx ON trusted_device(user_id);
+
+COMMIT;
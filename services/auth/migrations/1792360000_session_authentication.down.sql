@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE user_session DROP COLUMN IF EXISTS authenticated_at;
+ALTER TABLE user_session DROP COLUMN IF EXISTS auth_method;
+
+COMMIT;
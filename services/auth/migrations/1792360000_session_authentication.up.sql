@@ -0,0 +1,7 @@
+BEGIN;
+
+-- sessions created before the column was introduced have to re-authenticate before sensitive actions
+ALTER TABLE user_session ADD COLUMN IF NOT EXISTS authenticated_at TIMESTAMPTZ DEFAULT '0001-01-01 00:00:00+00' NOT NULL;
+ALTER TABLE user_session ADD COLUMN IF NOT EXISTS auth_method TEXT DEFAULT '' NOT NULL;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE user_session DROP COLUMN IF EXISTS public_key;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE user_session ADD COLUMN IF NOT EXISTS public_key TEXT DEFAULT '' NOT NULL;
+
+COMMIT;
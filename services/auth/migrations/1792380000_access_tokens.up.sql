@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Named non-interactive credentials for bots and integrations. Only hash of issued token is stored
+CREATE TABLE IF NOT EXISTS access_token (
+  id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  user_id INT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  prefix TEXT NOT NULL,
+  token_hash TEXT NOT NULL UNIQUE,
+  scopes TEXT[] DEFAULT '{}' NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  last_used_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS access_token_user_id_idx ON access_token(user_id);
+
+COMMIT;
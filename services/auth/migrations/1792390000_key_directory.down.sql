@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "user" ALTER COLUMN private_key DROP DEFAULT;
+
+DROP TABLE IF EXISTS one_time_prekey;
+DROP TABLE IF EXISTS signed_prekey;
+DROP TABLE IF EXISTS identity_key;
+
+COMMIT;
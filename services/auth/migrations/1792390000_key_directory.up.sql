@@ -0,0 +1,37 @@
+BEGIN;
+
+-- Public keys of user's devices for end-to-end encryption. Private keys never leave clients
+CREATE TABLE IF NOT EXISTS identity_key (
+  user_id INT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  device_id TEXT NOT NULL,
+  public_key TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (user_id, device_id)
+);
+
+-- Medium-term prekey signed by device's identity key, replaced on rotation
+CREATE TABLE IF NOT EXISTS signed_prekey (
+  user_id INT NOT NULL,
+  device_id TEXT NOT NULL,
+  key_id INT NOT NULL,
+  public_key TEXT NOT NULL,
+  signature TEXT NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (user_id, device_id),
+  FOREIGN KEY (user_id, device_id) REFERENCES identity_key(user_id, device_id) ON DELETE CASCADE
+);
+
+-- Single-use prekeys, each one is deleted as soon as it is handed out in a bundle
+CREATE TABLE IF NOT EXISTS one_time_prekey (
+  user_id INT NOT NULL,
+  device_id TEXT NOT NULL,
+  key_id INT NOT NULL,
+  public_key TEXT NOT NULL,
+  PRIMARY KEY (user_id, device_id, key_id),
+  FOREIGN KEY (user_id, device_id) REFERENCES identity_key(user_id, device_id) ON DELETE CASCADE
+);
+
+-- Server-generated keys are kept only to decrypt legacy TOTP secrets, new users don't get one
+ALTER TABLE "user" ALTER COLUMN private_key SET DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS deleted_user;
+
+DROP INDEX IF EXISTS user_deletion_scheduled_at_idx;
+ALTER TABLE "user" DROP COLUMN IF EXISTS deletion_reminder_sent_at;
+ALTER TABLE "user" DROP COLUMN IF EXISTS deletion_scheduled_at;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Account is erased once deletion_scheduled_at passes unless user cancels it before
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMPTZ;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS deletion_reminder_sent_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS user_deletion_scheduled_at_idx ON "user"(deletion_scheduled_at)
+  WHERE deletion_scheduled_at IS NOT NULL;
+
+-- Ids of erased accounts. Row is written along with erasure and
+-- works as an outbox for "user deleted" event until it is published
+CREATE TABLE IF NOT EXISTS deleted_user (
+  user_id INT PRIMARY KEY,
+  deleted_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  event_published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS deleted_user_unpublished_idx ON deleted_user(user_id)
+  WHERE event_published_at IS NULL;
+
+COMMIT;
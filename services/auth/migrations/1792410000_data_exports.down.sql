@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS data_export_part;
+DROP TABLE IF EXISTS data_export;
+
+COMMIT;
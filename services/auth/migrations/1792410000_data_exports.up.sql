@@ -0,0 +1,30 @@
+BEGIN;
+
+-- Archive with user's personal data. Parts sent by other services are collected
+-- until collect_until, then archive is assembled and may be downloaded until expires_at
+CREATE TABLE IF NOT EXISTS data_export (
+  id VARCHAR(64) PRIMARY KEY,
+  user_id INT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  status VARCHAR(16) DEFAULT 'pending' NOT NULL,
+  requested_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  collect_until TIMESTAMPTZ NOT NULL,
+  completed_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ,
+  file_name TEXT DEFAULT '' NOT NULL
+);
+
+-- User may have only one export being collected at a time
+CREATE UNIQUE INDEX IF NOT EXISTS data_export_pending_user_idx ON data_export(user_id)
+  WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS data_export_status_idx ON data_export(status);
+
+-- Data other services collected for export, kept until archive is assembled
+CREATE TABLE IF NOT EXISTS data_export_part (
+  export_id VARCHAR(64) NOT NULL REFERENCES data_export(id) ON DELETE CASCADE,
+  service VARCHAR(64) NOT NULL,
+  data JSONB NOT NULL,
+  received_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (export_id, service)
+);
+
+COMMIT;
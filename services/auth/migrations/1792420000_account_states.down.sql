@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS is_active BOOL DEFAULT true NOT NULL;
+UPDATE "user" SET is_active = false WHERE state NOT IN ('active', 'pending_deletion');
+
+ALTER TABLE "user" DROP COLUMN IF EXISTS state_until;
+ALTER TABLE "user" DROP COLUMN IF EXISTS state_changed_at;
+ALTER TABLE "user" DROP COLUMN IF EXISTS state_reason;
+ALTER TABLE "user" DROP COLUMN IF EXISTS state;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Lifecycle state of account replaces is_active flag. state_until is set for temporary states
+-- (locked, suspended), account is considered active once it passes
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS state VARCHAR(32) DEFAULT 'active' NOT NULL;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS state_reason TEXT DEFAULT '' NOT NULL;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS state_changed_at TIMESTAMPTZ;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS state_until TIMESTAMPTZ;
+
+UPDATE "user" SET state = 'deactivated', state_changed_at = updated_at WHERE NOT is_active;
+UPDATE "user" SET state = 'pending_deletion', state_changed_at = updated_at
+  WHERE is_active AND deletion_scheduled_at IS NOT NULL;
+
+ALTER TABLE "user" DROP COLUMN IF EXISTS is_active;
+
+COMMIT;
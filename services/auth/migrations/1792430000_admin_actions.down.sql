@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS admin_action_append_only ON admin_action;
+DROP FUNCTION IF EXISTS admin_action_forbid_modification();
+DROP TABLE IF EXISTS admin_action;
+
+ALTER TABLE "user" DROP COLUMN IF EXISTS is_admin;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- Support staff accounts allowed to use admin API
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS is_admin BOOLEAN DEFAULT false NOT NULL;
+
+-- Append-only audit log of actions performed by admins.
+-- Ids are not foreign keys so records outlive erased accounts
+CREATE TABLE IF NOT EXISTS admin_action (
+  id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  admin_id INT NOT NULL,
+  target_user_id INT,
+  action TEXT NOT NULL,
+  details JSONB DEFAULT '{}'::jsonb NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS admin_action_admin_id_idx ON admin_action(admin_id, id DESC);
+CREATE INDEX IF NOT EXISTS admin_action_target_user_id_idx ON admin_action(target_user_id, id DESC);
+
+CREATE OR REPLACE FUNCTION admin_action_forbid_modification() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'admin_action is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER admin_action_append_only
+  BEFORE UPDATE OR DELETE ON admin_action
+  FOR EACH ROW EXECUTE FUNCTION admin_action_forbid_modification();
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS is_admin BOOLEAN DEFAULT false NOT NULL;
+UPDATE "user" SET is_admin = true WHERE id IN (SELECT user_id FROM user_role WHERE role_name = 'admin');
+
+DROP TABLE IF EXISTS user_role;
+DROP TABLE IF EXISTS role_permission;
+DROP TABLE IF EXISTS role;
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+-- Roles group permissions, users are granted permissions only through assigned roles
+CREATE TABLE IF NOT EXISTS role (
+  name VARCHAR(64) PRIMARY KEY,
+  description TEXT DEFAULT '' NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+  role_name VARCHAR(64) REFERENCES role(name) ON DELETE CASCADE,
+  permission VARCHAR(128) NOT NULL,
+  PRIMARY KEY (role_name, permission)
+);
+
+-- granted_by is not a foreign key so assignment outlives erased admin account
+CREATE TABLE IF NOT EXISTS user_role (
+  user_id INT REFERENCES "user"(id) ON DELETE CASCADE,
+  role_name VARCHAR(64) REFERENCES role(name) ON DELETE CASCADE,
+  granted_by INT,
+  granted_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (user_id, role_name)
+);
+
+CREATE INDEX IF NOT EXISTS user_role_role_name_idx ON user_role(role_name);
+
+INSERT INTO role(name, description) VALUES ('admin', 'Support staff with full access to admin API')
+  ON CONFLICT DO NOTHING;
+INSERT INTO role_permission(role_name, permission) VALUES
+  ('admin', 'users:read'),
+  ('admin', 'users:write'),
+  ('admin', 'admin_actions:read'),
+  ('admin', 'roles:read'),
+  ('admin', 'roles:write')
+  ON CONFLICT DO NOTHING;
+
+-- is_admin flag is replaced with admin role
+INSERT INTO user_role(user_id, role_name) SELECT id, 'admin' FROM "user" WHERE is_admin
+  ON CONFLICT DO NOTHING;
+ALTER TABLE "user" DROP COLUMN IF EXISTS is_admin;
+
+COMMIT;
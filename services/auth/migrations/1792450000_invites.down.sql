@@ -0,0 +1,7 @@
+BEGIN;
+
+DELETE FROM role_permission WHERE permission = 'invites:write';
+DROP TABLE IF EXISTS invite_redemption;
+DROP TABLE IF EXISTS invite;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Invite codes restrict sign up during closed betas. Only hash of issued code is stored.
+-- Empty email means invite may be redeemed by anyone who knows the code
+CREATE TABLE IF NOT EXISTS invite (
+  id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+  inviter_id INT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+  prefix TEXT NOT NULL,
+  code_hash TEXT NOT NULL UNIQUE,
+  email TEXT DEFAULT '' NOT NULL,
+  max_uses INT NOT NULL CHECK (max_uses > 0),
+  uses INT DEFAULT 0 NOT NULL CHECK (uses >= 0 AND uses <= max_uses),
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS invite_inviter_id_idx ON invite(inviter_id);
+
+-- Each user signs up with at most one invite
+CREATE TABLE IF NOT EXISTS invite_redemption (
+  invite_id INT NOT NULL REFERENCES invite(id) ON DELETE CASCADE,
+  user_id INT PRIMARY KEY REFERENCES "user"(id) ON DELETE CASCADE,
+  redeemed_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invite_redemption_invite_id_idx ON invite_redemption(invite_id);
+
+INSERT INTO role_permission(role_name, permission) VALUES ('admin', 'invites:write')
+  ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,4 @@
+-- Irreversible: up migration only clears values of "user".private_key and keeps the column,
+-- cleared keys are not stored anywhere else and can not be restored. Rolling back is a no-op,
+-- schema is left as is and users whose keys were cleared keep empty private_key
+SELECT 1;
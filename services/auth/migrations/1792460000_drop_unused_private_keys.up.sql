@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Server-generated private keys are only needed to decrypt legacy TOTP secrets
+-- until they are re-encrypted with master key, keys which protect nothing are dropped
+UPDATE "user" SET private_key = ''
+  WHERE private_key <> ''
+    AND id NOT IN (SELECT user_id FROM two_factor_auth WHERE length(totp_secret) > 0);
+
+COMMIT;
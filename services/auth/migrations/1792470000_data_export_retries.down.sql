@@ -0,0 +1,7 @@
+BEGIN;
+
+UPDATE data_export SET status = 'expired' WHERE status = 'failed';
+ALTER TABLE data_export DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE data_export DROP COLUMN IF EXISTS attempts;
+
+COMMIT;
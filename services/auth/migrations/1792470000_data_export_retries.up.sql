@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Export which failed to assemble is retried with growing delay and
+-- marked as failed once attempts are exhausted, so user may request a new one
+ALTER TABLE data_export ADD COLUMN IF NOT EXISTS attempts INT DEFAULT 0 NOT NULL;
+ALTER TABLE data_export ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+
+COMMIT;
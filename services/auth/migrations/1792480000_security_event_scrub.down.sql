@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION security_event_forbid_modification() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'UPDATE' AND OLD.user_id IS NOT NULL AND NEW.user_id IS NULL
+    AND (NEW.id, NEW.type, NEW.ip_addr, NEW.device_info, NEW.location, NEW.details, NEW.created_at)
+      IS NOT DISTINCT FROM (OLD.id, OLD.type, OLD.ip_addr, OLD.device_info, OLD.location, OLD.details, OLD.created_at)
+  THEN
+    RETURN NEW;
+  END IF;
+  RAISE EXCEPTION 'security_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
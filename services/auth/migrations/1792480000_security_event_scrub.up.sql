@@ -0,0 +1,18 @@
+BEGIN;
+
+-- The only allowed modification is scrubbing event of erased user: it is detached from account
+-- and client metadata and details are cleared, while id, type and time are kept for audit.
+-- Plain detaching by foreign key action is rejected, so events can not keep personal data of erased account
+CREATE OR REPLACE FUNCTION security_event_forbid_modification() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'UPDATE' AND NEW.user_id IS NULL
+    AND NEW.ip_addr IS NULL AND NEW.device_info = '' AND NEW.location = '' AND NEW.details = '{}'::jsonb
+    AND (NEW.id, NEW.type, NEW.created_at) IS NOT DISTINCT FROM (OLD.id, OLD.type, OLD.created_at)
+  THEN
+    RETURN NEW;
+  END IF;
+  RAISE EXCEPTION 'security_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -18,8 +18,8 @@ type Server struct {
 	Port     string
 }
 
-func New(log logger.Interface, port string) *Server {
-	gRPCServer := grpc.NewServer()
+func New(log logger.Interface, port string, opts ...grpc.ServerOption) *Server {
+	gRPCServer := grpc.NewServer(opts...)
 	reflection.Register(gRPCServer)
 	errChan := make(chan error, 1)
 	return &Server{log: log, server: gRPCServer, ServeErr: errChan, Port: port}
@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	WEAK_PASSWORD              CustomErrorReason = "WEAK_PASSWORD"
+	PASSWORD_HAS_PERSONAL_INFO CustomErrorReason = "PASSWORD_HAS_PERSONAL_INFO"
+	BREACHED_PASSWORD          CustomErrorReason = "BREACHED_PASSWORD"
+	TOO_SHORT                  CustomErrorReason = "TOO_SHORT"
+	TOO_LONG                   CustomErrorReason = "TOO_LONG"
+)
+
+// BreachedPasswords is a set of passwords exposed in known data breaches
+type BreachedPasswords interface {
+	Contains(password string) bool
+}
+
+// PasswordPolicy describes requirements new password must satisfy.
+// Zero values disable corresponding checks
+type PasswordPolicy struct {
+	MinLength      int
+	MaxLength      int
+	MinEntropyBits float64
+	Breached       BreachedPasswords
+}
+
+// minPersonalInfoLength is a length starting from which user inputs are searched within password,
+// shorter values produce too many false matches
+const minPersonalInfoLength = 3
+
+// CheckPassword adds violations of policy to field errors.
+// userInputs are values password must not contain e.g username or email
+func (v *Validator) CheckPassword(policy *PasswordPolicy, field string, password string, userInputs ...string) {
+	length := utf8.RuneCountInString(password)
+	if policy.MinLength > 0 && length < policy.MinLength {
+		v.addError(field, fmt.Sprintf("Password should be at least %d characters long", policy.MinLength), string(TOO_SHORT))
+		return
+	}
+	if policy.MaxLength > 0 && length > policy.MaxLength {
+		v.addError(field, fmt.Sprintf("Password should be at most %d characters long", policy.MaxLength), string(TOO_LONG))
+		return
+	}
+
+	lowerPassword := strings.ToLower(password)
+	for _, input := range userInputs {
+		for _, part := range personalInfoParts(input) {
+			if strings.Contains(lowerPassword, part) {
+				v.addError(field, "Password should not contain your username or email", string(PASSWORD_HAS_PERSONAL_INFO))
+				return
+			}
+		}
+	}
+
+	if policy.MinEntropyBits > 0 && EstimatePasswordEntropy(password) < policy.MinEntropyBits {
+		v.addError(
+			field, "Password is too easy to guess. Use a longer password or mix letters, digits and symbols",
+			string(WEAK_PASSWORD),
+		)
+		return
+	}
+	if policy.Breached != nil && policy.Breached.Contains(password) {
+		v.addError(field, "Password has appeared in a data breach. Choose another one", string(BREACHED_PASSWORD))
+	}
+}
+
+// personalInfoParts splits value such as email into lowercased parts long enough to be checked
+func personalInfoParts(value string) []string {
+	value = strings.ToLower(value)
+	if local, _, found := strings.Cut(value, "@"); found {
+		value = local
+	}
+	parts := []string{}
+	if utf8.RuneCountInString(value) >= minPersonalInfoLength {
+		parts = append(parts, value)
+	}
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		if part != value && utf8.RuneCountInString(part) >= minPersonalInfoLength {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// EstimatePasswordEntropy returns rough password strength in bits.
+// Entropy of a character depends on size of character classes used in password.
+// Repeated characters and sequences like "aaaa", "1234" or "dcba" are penalized: only the first character
+// of a run counts fully, the rest of the run adds the logarithm of its length
+func EstimatePasswordEntropy(password string) float64 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol, hasOther bool
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			hasLower = true
+		case r >= 'A' && r <= 'Z':
+			hasUpper = true
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r < utf8.RuneSelf && unicode.IsPrint(r):
+			hasSymbol = true
+		default:
+			hasOther = true
+		}
+	}
+	poolSize := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{hasLower, 26}, {hasUpper, 26}, {hasDigit, 10}, {hasSymbol, 33}, {hasOther, 100}} {
+		if class.used {
+			poolSize += class.size
+		}
+	}
+	bitsPerChar := math.Log2(float64(poolSize))
+
+	entropy := 0.0
+	for i := 0; i < len(runes); {
+		runLength := 1
+		if i+1 < len(runes) {
+			step := runes[i+1] - runes[i]
+			if step >= -1 && step <= 1 {
+				for i+runLength < len(runes) && runes[i+runLength]-runes[i+runLength-1] == step {
+					runLength++
+				}
+			}
+		}
+		entropy += bitsPerChar
+		if runLength > 1 {
+			entropy += math.Log2(float64(runLength))
+		}
+		i += runLength
+	}
+	return entropy
+}
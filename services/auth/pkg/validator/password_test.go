@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type breachedList []string
+
+func (l breachedList) Contains(password string) bool {
+	for _, breached := range l {
+		if breached == password {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEstimatePasswordEntropy(t *testing.T) {
+	assert.Zero(t, EstimatePasswordEntropy(""))
+	assert.Less(t, EstimatePasswordEntropy("aaaaaaaaaaaa"), EstimatePasswordEntropy("aqzmwkxnebrv"))
+	assert.Less(t, EstimatePasswordEntropy("abcdefgh1234"), EstimatePasswordEntropy("hgaeb1fd3c42"))
+	assert.Less(t, EstimatePasswordEntropy("lowercase"), EstimatePasswordEntropy("LowerCase1!"))
+}
+
+func TestCheckPassword(t *testing.T) {
+	policy := &PasswordPolicy{MinLength: 8, MaxLength: 64, MinEntropyBits: 45, Breached: breachedList{"Tr0ub4dor&3"}}
+
+	cases := []struct {
+		name       string
+		password   string
+		userInputs []string
+		reason     CustomErrorReason
+	}{
+		{"valid", "vK7#pqL2xw!m", []string{"john", "john.doe@example.com"}, ""},
+		{"too short", "aB1!", nil, TOO_SHORT},
+		{"too long", string(make([]byte, 65)), nil, TOO_LONG},
+		{"contains username", "xX_JohnDoe_Xx42!", []string{"johndoe"}, PASSWORD_HAS_PERSONAL_INFO},
+		{"contains email part", "doe#Secret9!Q", []string{"john.doe@example.com"}, PASSWORD_HAS_PERSONAL_INFO},
+		{"weak", "12345678", nil, WEAK_PASSWORD},
+		{"breached", "Tr0ub4dor&3", nil, BREACHED_PASSWORD},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validate := New()
+			validate.CheckPassword(policy, "password", tc.password, tc.userInputs...)
+			if tc.reason == "" {
+				assert.Empty(t, validate.Errors)
+				return
+			}
+			if assert.Len(t, validate.Errors, 1) {
+				assert.Equal(t, "password", validate.Errors[0].Field)
+				assert.Equal(t, string(tc.reason), validate.Errors[0].Reason)
+			}
+		})
+	}
+}
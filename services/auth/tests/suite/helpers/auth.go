@@ -22,7 +22,7 @@ func MockUser() *entity.User {
 		),
 		PhotoUrl:   gofakeit.URL(),
 		AboutMe:    gofakeit.Sentence(10),
-		IsActive:   true,
+		State:      entity.ACCOUNT_STATE_ACTIVE,
 		Email:      gofakeit.Email(),
 		Password:   []byte(RandomPassword()),
 		PrivateKey: gofakeit.BitcoinPrivateKey(),
@@ -46,6 +46,83 @@ func MockAuthSession() *entity.AuthSession {
 		IpAddr:     gofakeit.IPv4Address(),
 		Location:   gofakeit.City(),
 		DeviceInfo: gofakeit.UserAgent(),
+		PublicKey:  gofakeit.LetterN(64),
+		Device: entity.Device{
+			Type:          entity.DEVICE_TYPE_DESKTOP,
+			Platform:      gofakeit.RandomString([]string{"Windows", "macOS", "Linux"}),
+			OsVersion:     gofakeit.AppVersion(),
+			Client:        gofakeit.RandomString([]string{"Chrome", "Firefox", "Safari"}),
+			ClientVersion: gofakeit.AppVersion(),
+		},
+	}
+}
+
+func MockSecurityEvent() *entity.SecurityEvent {
+	return &entity.SecurityEvent{
+		Type:       entity.SECURITY_EVENT_SIGN_IN_SUCCEEDED,
+		IpAddr:     gofakeit.IPv4Address(),
+		Location:   gofakeit.City(),
+		DeviceInfo: gofakeit.UserAgent(),
+		Details:    map[string]string{"session_id": gofakeit.UUID()},
+	}
+}
+
+func MockTrustedDevice() *entity.TrustedDevice {
+	return &entity.TrustedDevice{
+		TokenHash: gofakeit.LetterN(64),
+		Label:     gofakeit.AppName(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func MockAccessToken() *entity.AccessToken {
+	return &entity.AccessToken{
+		Name:      gofakeit.AppName(),
+		Prefix:    "gt_pat_" + gofakeit.LetterN(5),
+		TokenHash: gofakeit.LetterN(64),
+		Scopes:    []entity.AccessTokenScope{entity.ACCESS_TOKEN_SCOPE_PROFILE_READ},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func MockInvite() *entity.Invite {
+	return &entity.Invite{
+		Prefix:    "gt_inv_" + gofakeit.LetterN(4),
+		CodeHash:  gofakeit.LetterN(64),
+		MaxUses:   gofakeit.Number(1, 10),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func MockIdentityKey() *entity.IdentityKey {
+	return &entity.IdentityKey{
+		DeviceId:  gofakeit.UUID(),
+		PublicKey: gofakeit.LetterN(59),
+	}
+}
+
+func MockSignedPrekey() *entity.SignedPrekey {
+	return &entity.SignedPrekey{
+		KeyId:     gofakeit.Number(1, 1000),
+		PublicKey: gofakeit.LetterN(43),
+		Signature: gofakeit.LetterN(86),
+	}
+}
+
+func MockLoginConfirmation() *entity.LoginConfirmation {
+	return &entity.LoginConfirmation{
+		SessionId: gofakeit.UUID(),
+		UserId:    gofakeit.Number(1, 1000),
+		Nonce:     gofakeit.LetterN(16),
+	}
+}
+
+func MockMagicLink() *entity.MagicLink {
+	return &entity.MagicLink{
+		ClientId:  gofakeit.UUID(),
+		UserId:    gofakeit.Number(1, 1000),
+		TokenHash: gofakeit.LetterN(64),
+		Code:      []byte(gofakeit.Numerify("######")),
 	}
 }
 
@@ -60,11 +137,19 @@ func MockLoginToken() *entity.QRCodeLoginToken {
 	return &entity.QRCodeLoginToken{
 		ClientId:   gofakeit.UUID(),
 		Value:      gofakeit.UUID(),
+		WatchId:    gofakeit.UUID(),
 		IpAddr:     gofakeit.IPv4Address(),
 		DeviceInfo: gofakeit.UserAgent(),
 	}
 }
 
+func MockTelegramLink() *entity.TelegramLink {
+	return &entity.TelegramLink{
+		Code:   gofakeit.LetterN(16),
+		UserId: gofakeit.Number(1, 1000),
+	}
+}
+
 func MockPasskeySession() *entity.PasskeyRegistrationSession {
 	return &entity.PasskeyRegistrationSession{
 		UserId:    gofakeit.Number(1, 1000),
@@ -16,5 +16,14 @@ type (
 		SendVerifyEmailNotice(ctx context.Context, to string, data notifications.EmailVerifyNotice, lang notifications.Language) error
 		SendConfirmEmailTwoFaNotice(ctx context.Context, to string, data notifications.EmailTwoFaNotice, lang notifications.Language) error
 		SendConfirmedTwoFaNotice(ctx context.Context, to string, data notifications.TwoFaConfirmedNotice, lang notifications.Language) error
+		SendLoginChallengeNotice(ctx context.Context, to string, data notifications.LoginChallengeNotice, lang notifications.Language) error
+		SendPasswordResetNotice(ctx context.Context, to string, data notifications.PasswordResetNotice, lang notifications.Language) error
+		SendSessionEvictedNotice(ctx context.Context, to string, data notifications.SessionEvictedNotice, lang notifications.Language) error
+		SendDeletionScheduledNotice(ctx context.Context, to string, data notifications.AccountDeletionNotice, lang notifications.Language) error
+		SendDeletionReminderNotice(ctx context.Context, to string, data notifications.AccountDeletionNotice, lang notifications.Language) error
+		SendDataExportReadyNotice(ctx context.Context, to string, data notifications.DataExportReadyNotice, lang notifications.Language) error
+		SendAccountStateChangedNotice(ctx context.Context, to string, data notifications.AccountStateNotice, lang notifications.Language) error
+		SendAccountReactivationNotice(ctx context.Context, to string, data notifications.AccountReactivationNotice, lang notifications.Language) error
+		SendMagicLinkNotice(ctx context.Context, to string, data notifications.MagicLinkNotice, lang notifications.Language) error
 	}
 )
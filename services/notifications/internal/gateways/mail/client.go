@@ -89,3 +89,30 @@ func (c *SmtpMailClient) SendConfirmEmailTwoFaNotice(ctx context.Context, to str
 func (c *SmtpMailClient) SendConfirmedTwoFaNotice(ctx context.Context, to string, data notifications.TwoFaConfirmedNotice, lang notifications.Language) error {
 	return send(c, data, to, "two_fa_confirmed.html", getEmailSubject(notifications.EMAIL_TYPE_TWO_FA_CONFIRMED, lang))
 }
+func (c *SmtpMailClient) SendLoginChallengeNotice(ctx context.Context, to string, data notifications.LoginChallengeNotice, lang notifications.Language) error {
+	return send(c, data, to, "login_challenge.html", getEmailSubject(notifications.EMAIL_TYPE_LOGIN_CHALLENGE, lang))
+}
+func (c *SmtpMailClient) SendPasswordResetNotice(ctx context.Context, to string, data notifications.PasswordResetNotice, lang notifications.Language) error {
+	return send(c, data, to, "password_reset.html", getEmailSubject(notifications.EMAIL_TYPE_PASSWORD_RESET, lang))
+}
+func (c *SmtpMailClient) SendSessionEvictedNotice(ctx context.Context, to string, data notifications.SessionEvictedNotice, lang notifications.Language) error {
+	return send(c, data, to, "session_evicted.html", getEmailSubject(notifications.EMAIL_TYPE_SESSION_EVICTED, lang))
+}
+func (c *SmtpMailClient) SendDeletionScheduledNotice(ctx context.Context, to string, data notifications.AccountDeletionNotice, lang notifications.Language) error {
+	return send(c, data, to, "deletion_scheduled.html", getEmailSubject(notifications.EMAIL_TYPE_DELETION_SCHEDULED, lang))
+}
+func (c *SmtpMailClient) SendDeletionReminderNotice(ctx context.Context, to string, data notifications.AccountDeletionNotice, lang notifications.Language) error {
+	return send(c, data, to, "deletion_reminder.html", getEmailSubject(notifications.EMAIL_TYPE_DELETION_REMINDER, lang))
+}
+func (c *SmtpMailClient) SendDataExportReadyNotice(ctx context.Context, to string, data notifications.DataExportReadyNotice, lang notifications.Language) error {
+	return send(c, data, to, "data_export_ready.html", getEmailSubject(notifications.EMAIL_TYPE_DATA_EXPORT_READY, lang))
+}
+func (c *SmtpMailClient) SendAccountStateChangedNotice(ctx context.Context, to string, data notifications.AccountStateNotice, lang notifications.Language) error {
+	return send(c, data, to, "account_state_changed.html", getEmailSubject(notifications.EMAIL_TYPE_ACCOUNT_STATE, lang))
+}
+func (c *SmtpMailClient) SendAccountReactivationNotice(ctx context.Context, to string, data notifications.AccountReactivationNotice, lang notifications.Language) error {
+	return send(c, data, to, "account_reactivate.html", getEmailSubject(notifications.EMAIL_TYPE_ACCOUNT_REACTIVATE, lang))
+}
+func (c *SmtpMailClient) SendMagicLinkNotice(ctx context.Context, to string, data notifications.MagicLinkNotice, lang notifications.Language) error {
+	return send(c, data, to, "magic_link.html", getEmailSubject(notifications.EMAIL_TYPE_MAGIC_LINK, lang))
+}
@@ -13,6 +13,15 @@ func getEmailSubject(typ notifications.EmailType, lang notifications.Language) s
 			notifications.EMAIL_TYPE_LOGIN_NEW_DEVICE:    "New login from a new device",
 			notifications.EMAIL_TYPE_EMAIL_TWO_FA:        "Your two-factor authentication code",
 			notifications.EMAIL_TYPE_TWO_FA_CONFIRMED:    "Two-factor authentication enabled",
+			notifications.EMAIL_TYPE_LOGIN_CHALLENGE:     "Confirm it's you signing in",
+			notifications.EMAIL_TYPE_PASSWORD_RESET:      "Reset your password",
+			notifications.EMAIL_TYPE_SESSION_EVICTED:     "You were signed out on one of your devices",
+			notifications.EMAIL_TYPE_DELETION_SCHEDULED:  "Your account is scheduled for deletion",
+			notifications.EMAIL_TYPE_DELETION_REMINDER:   "Your account will be deleted soon",
+			notifications.EMAIL_TYPE_DATA_EXPORT_READY:   "Your data export is ready",
+			notifications.EMAIL_TYPE_ACCOUNT_STATE:       "Your account status has changed",
+			notifications.EMAIL_TYPE_ACCOUNT_REACTIVATE:  "Reactivate your account",
+			notifications.EMAIL_TYPE_MAGIC_LINK:          "Your sign in link",
 		},
 
 		notifications.LANGUAGE_RU: {
@@ -22,6 +31,15 @@ func getEmailSubject(typ notifications.EmailType, lang notifications.Language) s
 			notifications.EMAIL_TYPE_LOGIN_NEW_DEVICE:    "Вход с нового устройства",
 			notifications.EMAIL_TYPE_EMAIL_TWO_FA:        "Код двухфакторной аутентификации",
 			notifications.EMAIL_TYPE_TWO_FA_CONFIRMED:    "Двухфакторная аутентификация включена",
+			notifications.EMAIL_TYPE_LOGIN_CHALLENGE:     "Подтвердите вход в аккаунт",
+			notifications.EMAIL_TYPE_PASSWORD_RESET:      "Сброс пароля",
+			notifications.EMAIL_TYPE_SESSION_EVICTED:     "Выполнен выход на одном из ваших устройств",
+			notifications.EMAIL_TYPE_DELETION_SCHEDULED:  "Ваш аккаунт будет удалён",
+			notifications.EMAIL_TYPE_DELETION_REMINDER:   "Ваш аккаунт скоро будет удалён",
+			notifications.EMAIL_TYPE_DATA_EXPORT_READY:   "Архив с вашими данными готов",
+			notifications.EMAIL_TYPE_ACCOUNT_STATE:       "Статус вашего аккаунта изменён",
+			notifications.EMAIL_TYPE_ACCOUNT_REACTIVATE:  "Восстановление доступа к аккаунту",
+			notifications.EMAIL_TYPE_MAGIC_LINK:          "Ссылка для входа в аккаунт",
 		},
 	}
 
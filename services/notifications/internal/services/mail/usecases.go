@@ -51,6 +51,63 @@ func (s *Service) SendMail(ctx context.Context, email notifications.EmailMessage
 			return fmt.Errorf("mail - Service.SendMail - two fa confirmed - json.Unmarshal: %w", err)
 		}
 		return s.mailClient.SendConfirmedTwoFaNotice(ctx, email.To, data, email.Language)
+
+	case notifications.EMAIL_TYPE_LOGIN_CHALLENGE:
+		var data notifications.LoginChallengeNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - login challenge - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendLoginChallengeNotice(ctx, email.To, data, email.Language)
+
+	case notifications.EMAIL_TYPE_PASSWORD_RESET:
+		var data notifications.PasswordResetNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - password reset - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendPasswordResetNotice(ctx, email.To, data, email.Language)
+
+	case notifications.EMAIL_TYPE_SESSION_EVICTED:
+		var data notifications.SessionEvictedNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - session evicted - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendSessionEvictedNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_DELETION_SCHEDULED:
+		var data notifications.AccountDeletionNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - deletion scheduled - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendDeletionScheduledNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_DELETION_REMINDER:
+		var data notifications.AccountDeletionNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - deletion reminder - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendDeletionReminderNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_DATA_EXPORT_READY:
+		var data notifications.DataExportReadyNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - data export ready - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendDataExportReadyNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_ACCOUNT_STATE:
+		var data notifications.AccountStateNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - account state changed - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendAccountStateChangedNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_ACCOUNT_REACTIVATE:
+		var data notifications.AccountReactivationNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - account reactivate - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendAccountReactivationNotice(ctx, email.To, data, email.Language)
+	case notifications.EMAIL_TYPE_MAGIC_LINK:
+		var data notifications.MagicLinkNotice
+		if err := json.Unmarshal(email.Data, &data); err != nil {
+			return fmt.Errorf("mail - Service.SendMail - magic link - json.Unmarshal: %w", err)
+		}
+		return s.mailClient.SendMagicLinkNotice(ctx, email.To, data, email.Language)
 	}
 
 	s.log.Error("mail - service.SendMail - unknown email type", "type", email.Type)